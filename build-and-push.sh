@@ -44,6 +44,12 @@ docker buildx build \
   -t ${DOCKER_USERNAME}/mawjood-discovery:latest \
   --push .
 
+docker buildx build \
+  --platform linux/amd64,linux/arm64 \
+  -f Dockerfile.providers \
+  -t ${DOCKER_USERNAME}/mawjood-providers:latest \
+  --push .
+
 echo -e "${GREEN}✅ Multi-platform images built and pushed successfully${NC}"
 
 echo -e "${GREEN}✅ Images pushed successfully${NC}"
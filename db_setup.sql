@@ -18,15 +18,37 @@ CREATE TABLE IF NOT EXISTS contents (
     platform_name VARCHAR(255),
     created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
-    deleted_at TIMESTAMPTZ NULL -- For soft delete functionality
+    deleted_at TIMESTAMPTZ NULL, -- For soft delete functionality
+    -- Search forms of title, description and platform name (lower-cased, Arabic
+    -- diacritics and letter variants folded), written by the CMS service
+    title_normalized VARCHAR(255),
+    description_normalized TEXT,
+    platform_name_normalized VARCHAR(255)
 );
 
 -- Create the tags table to store unique tags
 CREATE TABLE IF NOT EXISTS tags (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-    name VARCHAR(100) NOT NULL UNIQUE
+    name VARCHAR(100) NOT NULL UNIQUE,
+    normalized_name VARCHAR(100)
 );
 
+-- Normalized search columns for databases created before they were added
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS title_normalized VARCHAR(255);
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS description_normalized TEXT;
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS platform_name_normalized VARCHAR(255);
+ALTER TABLE tags ADD COLUMN IF NOT EXISTS normalized_name VARCHAR(100);
+
+-- Search text of contents not filed as Arabic was once stored without Arabic
+-- folding. Clear rows and tags that still hold letters the fold removes, so the
+-- CMS service reindexes them on startup.
+UPDATE contents SET title_normalized = NULL, description_normalized = NULL, platform_name_normalized = NULL
+WHERE title_normalized ~ '[أإآٱةىـ\x{064B}-\x{065F}\x{0670}]'
+   OR description_normalized ~ '[أإآٱةىـ\x{064B}-\x{065F}\x{0670}]'
+   OR platform_name_normalized ~ '[أإآٱةىـ\x{064B}-\x{065F}\x{0670}]';
+UPDATE tags SET normalized_name = NULL
+WHERE normalized_name ~ '[أإآٱةىـ\x{064B}-\x{065F}\x{0670}]';
+
 -- Create the content_tags join table to associate content with tags
 CREATE TABLE IF NOT EXISTS content_tags (
     content_id UUID NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
@@ -34,6 +56,39 @@ CREATE TABLE IF NOT EXISTS content_tags (
     PRIMARY KEY (content_id, tag_id)
 );
 
+-- Create the content_events table to record what users open and play
+CREATE TABLE IF NOT EXISTS content_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    content_id UUID NOT NULL REFERENCES contents(id) ON DELETE CASCADE,
+    event_type VARCHAR(20) NOT NULL, -- 'view', 'play_start', 'play_complete' or 'share'
+    session_id VARCHAR(128) NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    -- Start of the 30-minute window occurred_at falls in
+    dedupe_bucket TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    -- Each event is counted once per session per 30-minute window
+    UNIQUE (session_id, content_id, event_type, dedupe_bucket)
+);
+
+-- Events were once counted only once per session for good. Key them by window
+-- instead, so a session's repeats are counted again after 30 minutes.
+ALTER TABLE content_events ADD COLUMN IF NOT EXISTS dedupe_bucket TIMESTAMPTZ;
+UPDATE content_events SET dedupe_bucket = to_timestamp(floor(extract(epoch FROM occurred_at) / 1800) * 1800)
+WHERE dedupe_bucket IS NULL;
+ALTER TABLE content_events ALTER COLUMN dedupe_bucket SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS content_events_session_id_content_id_event_type_dedupe_bucket_key ON content_events (session_id, content_id, event_type, dedupe_bucket);
+DROP INDEX IF EXISTS content_events@content_events_session_id_content_id_event_type_key CASCADE;
+
+-- Create the search_queries table to log what users search for
+CREATE TABLE IF NOT EXISTS search_queries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    query VARCHAR(500) NOT NULL, -- normalized query text
+    result_count INT NOT NULL,
+    latency_ms INT NOT NULL,
+    page_depth INT NOT NULL, -- 1 for the first page of results
+    searched_at TIMESTAMPTZ NOT NULL
+);
+
 -- Create indexes for efficient querying
 -- Index for searching content by title
 CREATE INDEX IF NOT EXISTS idx_contents_title ON contents (title);
@@ -45,6 +100,15 @@ CREATE INVERTED INDEX IF NOT EXISTS idx_contents_description_search ON contents
 -- Index for finding tags by name
 CREATE INDEX IF NOT EXISTS idx_tags_name ON tags (name);
 
+-- Trigram indexes on the normalized search columns
+CREATE INVERTED INDEX IF NOT EXISTS idx_contents_title_normalized_search ON contents (title_normalized gin_trgm_ops);
+CREATE INVERTED INDEX IF NOT EXISTS idx_contents_description_normalized_search ON contents (description_normalized gin_trgm_ops);
+CREATE INVERTED INDEX IF NOT EXISTS idx_contents_platform_name_normalized_search ON contents (platform_name_normalized gin_trgm_ops);
+
+-- Trigram indexes backing prefix suggestions on tag and platform names
+CREATE INVERTED INDEX IF NOT EXISTS idx_tags_normalized_name_search ON tags (normalized_name gin_trgm_ops);
+CREATE INVERTED INDEX IF NOT EXISTS idx_contents_platform_name_search ON contents (platform_name gin_trgm_ops);
+
 -- Indexes on the join table for efficient lookups in both directions
 CREATE INDEX IF NOT EXISTS idx_content_tags_tag_id ON content_tags (tag_id);
 CREATE INDEX IF NOT EXISTS idx_content_tags_content_id ON content_tags (content_id);
@@ -67,6 +131,12 @@ CREATE INDEX IF NOT EXISTS idx_contents_deleted_at ON contents (deleted_at);
 -- Partial index for active (non-deleted) content for better performance
 CREATE INDEX IF NOT EXISTS idx_contents_active ON contents (created_at DESC) WHERE deleted_at IS NULL;
 
+-- Index for trending windows, covering the columns the ranking reads
+CREATE INDEX IF NOT EXISTS idx_content_events_occurred_at ON content_events (occurred_at DESC) STORING (content_id, event_type);
+
+-- Index for search analytics over a time range
+CREATE INDEX IF NOT EXISTS idx_search_queries_searched_at ON search_queries (searched_at DESC) STORING (query, result_count, latency_ms, page_depth);
+
 -- Insert seed data for tags
 INSERT INTO tags (name) VALUES 
     ('technology'),
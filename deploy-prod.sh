@@ -35,13 +35,16 @@ echo -e "${YELLOW}📦 Pulling Docker images from registry...${NC}"
 # Pull pre-built images from registry (much faster than building on server)
 docker pull ${DOCKER_USERNAME}/mawjood-cms:latest
 docker pull ${DOCKER_USERNAME}/mawjood-discovery:latest
+docker pull ${DOCKER_USERNAME}/mawjood-providers:latest
 
 echo -e "${GREEN}✅ Docker images pulled successfully${NC}"
 echo -e "${YELLOW}💡 To build and push new images, run locally:${NC}"
 echo -e "   docker build -f Dockerfile.cms -t ${DOCKER_USERNAME}/mawjood-cms:latest ."
 echo -e "   docker build -f Dockerfile.discovery -t ${DOCKER_USERNAME}/mawjood-discovery:latest ."
+echo -e "   docker build -f Dockerfile.providers -t ${DOCKER_USERNAME}/mawjood-providers:latest ."
 echo -e "   docker push ${DOCKER_USERNAME}/mawjood-cms:latest"
 echo -e "   docker push ${DOCKER_USERNAME}/mawjood-discovery:latest"
+echo -e "   docker push ${DOCKER_USERNAME}/mawjood-providers:latest"
 
 echo -e "${YELLOW}🐳 Starting Docker services...${NC}"
 
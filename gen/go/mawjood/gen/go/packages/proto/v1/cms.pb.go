@@ -13,7 +13,6 @@ import (
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	unsafe "unsafe"
 )
@@ -30,42 +29,58 @@ var File_cms_proto protoreflect.FileDescriptor
 const file_cms_proto_rawDesc = "" +
 	"\n" +
 	"\tcms.proto\x12\n" +
-	"mawjood.v1\x1a\x0emessages.proto\x1a\x1bgoogle/protobuf/empty.proto2\x87\x03\n" +
+	"mawjood.v1\x1a\x0emessages.proto2\xaf\x06\n" +
 	"\n" +
 	"CMSService\x12F\n" +
 	"\rCreateContent\x12 .mawjood.v1.CreateContentRequest\x1a\x13.mawjood.v1.Content\x12F\n" +
-	"\rUpdateContent\x12 .mawjood.v1.UpdateContentRequest\x1a\x13.mawjood.v1.Content\x12I\n" +
-	"\rDeleteContent\x12 .mawjood.v1.DeleteContentRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\rUpdateContent\x12 .mawjood.v1.UpdateContentRequest\x1a\x13.mawjood.v1.Content\x12T\n" +
+	"\rDeleteContent\x12 .mawjood.v1.DeleteContentRequest\x1a!.mawjood.v1.DeleteContentResponse\x12Q\n" +
 	"\fListContents\x12\x1f.mawjood.v1.ListContentsRequest\x1a .mawjood.v1.ListContentsResponse\x12K\n" +
-	"\x12ImportFromExternal\x12\x19.mawjood.v1.ImportRequest\x1a\x1a.mawjood.v1.ImportResponseB\"Z mawjood/gen/go/packages/proto/v1b\x06proto3"
+	"\x12ImportFromExternal\x12\x19.mawjood.v1.ImportRequest\x1a\x1a.mawjood.v1.ImportResponse\x12_\n" +
+	"\x14ListTopSearchQueries\x12\".mawjood.v1.SearchAnalyticsRequest\x1a#.mawjood.v1.SearchAnalyticsResponse\x12f\n" +
+	"\x1bListZeroResultSearchQueries\x12\".mawjood.v1.SearchAnalyticsRequest\x1a#.mawjood.v1.SearchAnalyticsResponse\x12d\n" +
+	"\x19ListTrendingSearchQueries\x12\".mawjood.v1.SearchAnalyticsRequest\x1a#.mawjood.v1.SearchAnalyticsResponse\x12l\n" +
+	"\x15ExportSearchAnalytics\x12(.mawjood.v1.ExportSearchAnalyticsRequest\x1a).mawjood.v1.ExportSearchAnalyticsResponseB\"Z mawjood/gen/go/packages/proto/v1b\x06proto3"
 
 var file_cms_proto_goTypes = []any{
-	(*CreateContentRequest)(nil), // 0: mawjood.v1.CreateContentRequest
-	(*UpdateContentRequest)(nil), // 1: mawjood.v1.UpdateContentRequest
-	(*DeleteContentRequest)(nil), // 2: mawjood.v1.DeleteContentRequest
-	(*ListContentsRequest)(nil),  // 3: mawjood.v1.ListContentsRequest
-	(*ImportRequest)(nil),        // 4: mawjood.v1.ImportRequest
-	(*Content)(nil),              // 5: mawjood.v1.Content
-	(*emptypb.Empty)(nil),        // 6: google.protobuf.Empty
-	(*ListContentsResponse)(nil), // 7: mawjood.v1.ListContentsResponse
-	(*ImportResponse)(nil),       // 8: mawjood.v1.ImportResponse
+	(*CreateContentRequest)(nil),          // 0: mawjood.v1.CreateContentRequest
+	(*UpdateContentRequest)(nil),          // 1: mawjood.v1.UpdateContentRequest
+	(*DeleteContentRequest)(nil),          // 2: mawjood.v1.DeleteContentRequest
+	(*ListContentsRequest)(nil),           // 3: mawjood.v1.ListContentsRequest
+	(*ImportRequest)(nil),                 // 4: mawjood.v1.ImportRequest
+	(*SearchAnalyticsRequest)(nil),        // 5: mawjood.v1.SearchAnalyticsRequest
+	(*ExportSearchAnalyticsRequest)(nil),  // 6: mawjood.v1.ExportSearchAnalyticsRequest
+	(*Content)(nil),                       // 7: mawjood.v1.Content
+	(*DeleteContentResponse)(nil),         // 8: mawjood.v1.DeleteContentResponse
+	(*ListContentsResponse)(nil),          // 9: mawjood.v1.ListContentsResponse
+	(*ImportResponse)(nil),                // 10: mawjood.v1.ImportResponse
+	(*SearchAnalyticsResponse)(nil),       // 11: mawjood.v1.SearchAnalyticsResponse
+	(*ExportSearchAnalyticsResponse)(nil), // 12: mawjood.v1.ExportSearchAnalyticsResponse
 }
 var file_cms_proto_depIdxs = []int32{
-	0, // 0: mawjood.v1.CMSService.CreateContent:input_type -> mawjood.v1.CreateContentRequest
-	1, // 1: mawjood.v1.CMSService.UpdateContent:input_type -> mawjood.v1.UpdateContentRequest
-	2, // 2: mawjood.v1.CMSService.DeleteContent:input_type -> mawjood.v1.DeleteContentRequest
-	3, // 3: mawjood.v1.CMSService.ListContents:input_type -> mawjood.v1.ListContentsRequest
-	4, // 4: mawjood.v1.CMSService.ImportFromExternal:input_type -> mawjood.v1.ImportRequest
-	5, // 5: mawjood.v1.CMSService.CreateContent:output_type -> mawjood.v1.Content
-	5, // 6: mawjood.v1.CMSService.UpdateContent:output_type -> mawjood.v1.Content
-	6, // 7: mawjood.v1.CMSService.DeleteContent:output_type -> google.protobuf.Empty
-	7, // 8: mawjood.v1.CMSService.ListContents:output_type -> mawjood.v1.ListContentsResponse
-	8, // 9: mawjood.v1.CMSService.ImportFromExternal:output_type -> mawjood.v1.ImportResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: mawjood.v1.CMSService.CreateContent:input_type -> mawjood.v1.CreateContentRequest
+	1,  // 1: mawjood.v1.CMSService.UpdateContent:input_type -> mawjood.v1.UpdateContentRequest
+	2,  // 2: mawjood.v1.CMSService.DeleteContent:input_type -> mawjood.v1.DeleteContentRequest
+	3,  // 3: mawjood.v1.CMSService.ListContents:input_type -> mawjood.v1.ListContentsRequest
+	4,  // 4: mawjood.v1.CMSService.ImportFromExternal:input_type -> mawjood.v1.ImportRequest
+	5,  // 5: mawjood.v1.CMSService.ListTopSearchQueries:input_type -> mawjood.v1.SearchAnalyticsRequest
+	5,  // 6: mawjood.v1.CMSService.ListZeroResultSearchQueries:input_type -> mawjood.v1.SearchAnalyticsRequest
+	5,  // 7: mawjood.v1.CMSService.ListTrendingSearchQueries:input_type -> mawjood.v1.SearchAnalyticsRequest
+	6,  // 8: mawjood.v1.CMSService.ExportSearchAnalytics:input_type -> mawjood.v1.ExportSearchAnalyticsRequest
+	7,  // 9: mawjood.v1.CMSService.CreateContent:output_type -> mawjood.v1.Content
+	7,  // 10: mawjood.v1.CMSService.UpdateContent:output_type -> mawjood.v1.Content
+	8,  // 11: mawjood.v1.CMSService.DeleteContent:output_type -> mawjood.v1.DeleteContentResponse
+	9,  // 12: mawjood.v1.CMSService.ListContents:output_type -> mawjood.v1.ListContentsResponse
+	10, // 13: mawjood.v1.CMSService.ImportFromExternal:output_type -> mawjood.v1.ImportResponse
+	11, // 14: mawjood.v1.CMSService.ListTopSearchQueries:output_type -> mawjood.v1.SearchAnalyticsResponse
+	11, // 15: mawjood.v1.CMSService.ListZeroResultSearchQueries:output_type -> mawjood.v1.SearchAnalyticsResponse
+	11, // 16: mawjood.v1.CMSService.ListTrendingSearchQueries:output_type -> mawjood.v1.SearchAnalyticsResponse
+	12, // 17: mawjood.v1.CMSService.ExportSearchAnalytics:output_type -> mawjood.v1.ExportSearchAnalyticsResponse
+	9,  // [9:18] is the sub-list for method output_type
+	0,  // [0:9] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_cms_proto_init() }
@@ -106,9 +121,13 @@ const _ = grpc.SupportPackageIsVersion6
 type CMSServiceClient interface {
 	CreateContent(ctx context.Context, in *CreateContentRequest, opts ...grpc.CallOption) (*Content, error)
 	UpdateContent(ctx context.Context, in *UpdateContentRequest, opts ...grpc.CallOption) (*Content, error)
-	DeleteContent(ctx context.Context, in *DeleteContentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteContent(ctx context.Context, in *DeleteContentRequest, opts ...grpc.CallOption) (*DeleteContentResponse, error)
 	ListContents(ctx context.Context, in *ListContentsRequest, opts ...grpc.CallOption) (*ListContentsResponse, error)
 	ImportFromExternal(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	ListTopSearchQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*SearchAnalyticsResponse, error)
+	ListZeroResultSearchQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*SearchAnalyticsResponse, error)
+	ListTrendingSearchQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*SearchAnalyticsResponse, error)
+	ExportSearchAnalytics(ctx context.Context, in *ExportSearchAnalyticsRequest, opts ...grpc.CallOption) (*ExportSearchAnalyticsResponse, error)
 }
 
 type cMSServiceClient struct {
@@ -137,8 +156,8 @@ func (c *cMSServiceClient) UpdateContent(ctx context.Context, in *UpdateContentR
 	return out, nil
 }
 
-func (c *cMSServiceClient) DeleteContent(ctx context.Context, in *DeleteContentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *cMSServiceClient) DeleteContent(ctx context.Context, in *DeleteContentRequest, opts ...grpc.CallOption) (*DeleteContentResponse, error) {
+	out := new(DeleteContentResponse)
 	err := c.cc.Invoke(ctx, "/mawjood.v1.CMSService/DeleteContent", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -164,13 +183,53 @@ func (c *cMSServiceClient) ImportFromExternal(ctx context.Context, in *ImportReq
 	return out, nil
 }
 
+func (c *cMSServiceClient) ListTopSearchQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*SearchAnalyticsResponse, error) {
+	out := new(SearchAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.CMSService/ListTopSearchQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cMSServiceClient) ListZeroResultSearchQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*SearchAnalyticsResponse, error) {
+	out := new(SearchAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.CMSService/ListZeroResultSearchQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cMSServiceClient) ListTrendingSearchQueries(ctx context.Context, in *SearchAnalyticsRequest, opts ...grpc.CallOption) (*SearchAnalyticsResponse, error) {
+	out := new(SearchAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.CMSService/ListTrendingSearchQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cMSServiceClient) ExportSearchAnalytics(ctx context.Context, in *ExportSearchAnalyticsRequest, opts ...grpc.CallOption) (*ExportSearchAnalyticsResponse, error) {
+	out := new(ExportSearchAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.CMSService/ExportSearchAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CMSServiceServer is the server API for CMSService service.
 type CMSServiceServer interface {
 	CreateContent(context.Context, *CreateContentRequest) (*Content, error)
 	UpdateContent(context.Context, *UpdateContentRequest) (*Content, error)
-	DeleteContent(context.Context, *DeleteContentRequest) (*emptypb.Empty, error)
+	DeleteContent(context.Context, *DeleteContentRequest) (*DeleteContentResponse, error)
 	ListContents(context.Context, *ListContentsRequest) (*ListContentsResponse, error)
 	ImportFromExternal(context.Context, *ImportRequest) (*ImportResponse, error)
+	ListTopSearchQueries(context.Context, *SearchAnalyticsRequest) (*SearchAnalyticsResponse, error)
+	ListZeroResultSearchQueries(context.Context, *SearchAnalyticsRequest) (*SearchAnalyticsResponse, error)
+	ListTrendingSearchQueries(context.Context, *SearchAnalyticsRequest) (*SearchAnalyticsResponse, error)
+	ExportSearchAnalytics(context.Context, *ExportSearchAnalyticsRequest) (*ExportSearchAnalyticsResponse, error)
 }
 
 // UnimplementedCMSServiceServer can be embedded to have forward compatible implementations.
@@ -183,7 +242,7 @@ func (*UnimplementedCMSServiceServer) CreateContent(context.Context, *CreateCont
 func (*UnimplementedCMSServiceServer) UpdateContent(context.Context, *UpdateContentRequest) (*Content, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateContent not implemented")
 }
-func (*UnimplementedCMSServiceServer) DeleteContent(context.Context, *DeleteContentRequest) (*emptypb.Empty, error) {
+func (*UnimplementedCMSServiceServer) DeleteContent(context.Context, *DeleteContentRequest) (*DeleteContentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteContent not implemented")
 }
 func (*UnimplementedCMSServiceServer) ListContents(context.Context, *ListContentsRequest) (*ListContentsResponse, error) {
@@ -192,6 +251,18 @@ func (*UnimplementedCMSServiceServer) ListContents(context.Context, *ListContent
 func (*UnimplementedCMSServiceServer) ImportFromExternal(context.Context, *ImportRequest) (*ImportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportFromExternal not implemented")
 }
+func (*UnimplementedCMSServiceServer) ListTopSearchQueries(context.Context, *SearchAnalyticsRequest) (*SearchAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopSearchQueries not implemented")
+}
+func (*UnimplementedCMSServiceServer) ListZeroResultSearchQueries(context.Context, *SearchAnalyticsRequest) (*SearchAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZeroResultSearchQueries not implemented")
+}
+func (*UnimplementedCMSServiceServer) ListTrendingSearchQueries(context.Context, *SearchAnalyticsRequest) (*SearchAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingSearchQueries not implemented")
+}
+func (*UnimplementedCMSServiceServer) ExportSearchAnalytics(context.Context, *ExportSearchAnalyticsRequest) (*ExportSearchAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSearchAnalytics not implemented")
+}
 
 func RegisterCMSServiceServer(s *grpc.Server, srv CMSServiceServer) {
 	s.RegisterService(&_CMSService_serviceDesc, srv)
@@ -287,6 +358,78 @@ func _CMSService_ImportFromExternal_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CMSService_ListTopSearchQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CMSServiceServer).ListTopSearchQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.CMSService/ListTopSearchQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CMSServiceServer).ListTopSearchQueries(ctx, req.(*SearchAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CMSService_ListZeroResultSearchQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CMSServiceServer).ListZeroResultSearchQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.CMSService/ListZeroResultSearchQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CMSServiceServer).ListZeroResultSearchQueries(ctx, req.(*SearchAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CMSService_ListTrendingSearchQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CMSServiceServer).ListTrendingSearchQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.CMSService/ListTrendingSearchQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CMSServiceServer).ListTrendingSearchQueries(ctx, req.(*SearchAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CMSService_ExportSearchAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSearchAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CMSServiceServer).ExportSearchAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.CMSService/ExportSearchAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CMSServiceServer).ExportSearchAnalytics(ctx, req.(*ExportSearchAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CMSService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mawjood.v1.CMSService",
 	HandlerType: (*CMSServiceServer)(nil),
@@ -311,6 +454,22 @@ var _CMSService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportFromExternal",
 			Handler:    _CMSService_ImportFromExternal_Handler,
 		},
+		{
+			MethodName: "ListTopSearchQueries",
+			Handler:    _CMSService_ListTopSearchQueries_Handler,
+		},
+		{
+			MethodName: "ListZeroResultSearchQueries",
+			Handler:    _CMSService_ListZeroResultSearchQueries_Handler,
+		},
+		{
+			MethodName: "ListTrendingSearchQueries",
+			Handler:    _CMSService_ListTrendingSearchQueries_Handler,
+		},
+		{
+			MethodName: "ExportSearchAnalytics",
+			Handler:    _CMSService_ExportSearchAnalytics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cms.proto",
@@ -251,6 +251,8 @@ func (m *Content) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for ConsistencyToken
+
 	if len(errors) > 0 {
 		return ContentMultiError(errors)
 	}
@@ -650,6 +652,17 @@ func (m *GetContentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetMinConsistency()) > 64 {
+		err := GetContentRequestValidationError{
+			field:  "MinConsistency",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return GetContentRequestMultiError(errors)
 	}
@@ -738,66 +751,32 @@ var _ interface {
 	ErrorName() string
 } = GetContentRequestValidationError{}
 
-// Validate checks the field values on UpdateContentRequest with the rules
+// Validate checks the field values on BatchGetContentsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateContentRequest) Validate() error {
+func (m *BatchGetContentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateContentRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BatchGetContentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateContentRequestMultiError, or nil if none found.
-func (m *UpdateContentRequest) ValidateAll() error {
+// BatchGetContentsRequestMultiError, or nil if none found.
+func (m *BatchGetContentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateContentRequest) validate(all bool) error {
+func (m *BatchGetContentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetId()); err != nil {
-		err = UpdateContentRequestValidationError{
-			field:  "Id",
-			reason: "value must be a valid UUID",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 255 {
-		err := UpdateContentRequestValidationError{
-			field:  "Title",
-			reason: "value length must be between 1 and 255 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDescription()) > 5000 {
-		err := UpdateContentRequestValidationError{
-			field:  "Description",
-			reason: "value length must be at most 5000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetTags()) > 50 {
-		err := UpdateContentRequestValidationError{
-			field:  "Tags",
-			reason: "value must contain no more than 50 item(s)",
+	if l := len(m.GetIds()); l < 1 || l > 100 {
+		err := BatchGetContentsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 100 items, inclusive",
 		}
 		if !all {
 			return err
@@ -805,13 +784,14 @@ func (m *UpdateContentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetTags() {
+	for idx, item := range m.GetIds() {
 		_, _ = idx, item
 
-		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
-			err := UpdateContentRequestValidationError{
-				field:  fmt.Sprintf("Tags[%v]", idx),
-				reason: "value length must be between 1 and 100 runes, inclusive",
+		if err := m._validateUuid(item); err != nil {
+			err = BatchGetContentsRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
 			}
 			if !all {
 				return err
@@ -821,108 +801,10 @@ func (m *UpdateContentRequest) validate(all bool) error {
 
 	}
 
-	if l := utf8.RuneCountInString(m.GetLanguage()); l < 2 || l > 10 {
-		err := UpdateContentRequestValidationError{
-			field:  "Language",
-			reason: "value length must be between 2 and 10 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_UpdateContentRequest_Language_Pattern.MatchString(m.GetLanguage()) {
-		err := UpdateContentRequestValidationError{
-			field:  "Language",
-			reason: "value does not match regex pattern \"^[a-z]{2,3}(-[A-Z]{2})?$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if val := m.GetDurationSeconds(); val < 0 || val > 86400 {
-		err := UpdateContentRequestValidationError{
-			field:  "DurationSeconds",
-			reason: "value must be inside range [0, 86400]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_UpdateContentRequest_PublishedAt_Pattern.MatchString(m.GetPublishedAt()) {
-		err := UpdateContentRequestValidationError{
-			field:  "PublishedAt",
-			reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := _UpdateContentRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
-		err := UpdateContentRequestValidationError{
-			field:  "ContentType",
-			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
-		err := UpdateContentRequestValidationError{
-			field:  "ContentType",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetUrl()); l < 1 || l > 2048 {
-		err := UpdateContentRequestValidationError{
-			field:  "Url",
-			reason: "value length must be between 1 and 2048 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if uri, err := url.Parse(m.GetUrl()); err != nil {
-		err = UpdateContentRequestValidationError{
-			field:  "Url",
-			reason: "value must be a valid URI",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	} else if !uri.IsAbs() {
-		err := UpdateContentRequestValidationError{
-			field:  "Url",
-			reason: "value must be absolute",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetPlatformName()); l < 1 || l > 100 {
-		err := UpdateContentRequestValidationError{
-			field:  "PlatformName",
-			reason: "value length must be between 1 and 100 runes, inclusive",
+	if utf8.RuneCountInString(m.GetMinConsistency()) > 64 {
+		err := BatchGetContentsRequestValidationError{
+			field:  "MinConsistency",
+			reason: "value length must be at most 64 runes",
 		}
 		if !all {
 			return err
@@ -931,13 +813,13 @@ func (m *UpdateContentRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateContentRequestMultiError(errors)
+		return BatchGetContentsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *UpdateContentRequest) _validateUuid(uuid string) error {
+func (m *BatchGetContentsRequest) _validateUuid(uuid string) error {
 	if matched := _messages_uuidPattern.MatchString(uuid); !matched {
 		return errors.New("invalid uuid format")
 	}
@@ -945,13 +827,13 @@ func (m *UpdateContentRequest) _validateUuid(uuid string) error {
 	return nil
 }
 
-// UpdateContentRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateContentRequest.ValidateAll() if the designated
+// BatchGetContentsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetContentsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UpdateContentRequestMultiError []error
+type BatchGetContentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateContentRequestMultiError) Error() string {
+func (m BatchGetContentsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -960,11 +842,11 @@ func (m UpdateContentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateContentRequestMultiError) AllErrors() []error { return m }
+func (m BatchGetContentsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateContentRequestValidationError is the validation error returned by
-// UpdateContentRequest.Validate if the designated constraints aren't met.
-type UpdateContentRequestValidationError struct {
+// BatchGetContentsRequestValidationError is the validation error returned by
+// BatchGetContentsRequest.Validate if the designated constraints aren't met.
+type BatchGetContentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -972,24 +854,24 @@ type UpdateContentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateContentRequestValidationError) Field() string { return e.field }
+func (e BatchGetContentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateContentRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetContentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateContentRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetContentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateContentRequestValidationError) Key() bool { return e.key }
+func (e BatchGetContentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateContentRequestValidationError) ErrorName() string {
-	return "UpdateContentRequestValidationError"
+func (e BatchGetContentsRequestValidationError) ErrorName() string {
+	return "BatchGetContentsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateContentRequestValidationError) Error() string {
+func (e BatchGetContentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1001,14 +883,14 @@ func (e UpdateContentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateContentRequest.%s: %s%s",
+		"invalid %sBatchGetContentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateContentRequestValidationError{}
+var _ error = BatchGetContentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1016,72 +898,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateContentRequestValidationError{}
-
-var _UpdateContentRequest_Language_Pattern = regexp.MustCompile("^[a-z]{2,3}(-[A-Z]{2})?$")
-
-var _UpdateContentRequest_PublishedAt_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$")
-
-var _UpdateContentRequest_ContentType_NotInLookup = map[ContentType]struct{}{
-	0: {},
-}
+} = BatchGetContentsRequestValidationError{}
 
-// Validate checks the field values on DeleteContentRequest with the rules
+// Validate checks the field values on BatchGetContentsResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteContentRequest) Validate() error {
+func (m *BatchGetContentsResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteContentRequest with the rules
+// ValidateAll checks the field values on BatchGetContentsResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteContentRequestMultiError, or nil if none found.
-func (m *DeleteContentRequest) ValidateAll() error {
+// BatchGetContentsResultMultiError, or nil if none found.
+func (m *BatchGetContentsResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteContentRequest) validate(all bool) error {
+func (m *BatchGetContentsResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateUuid(m.GetId()); err != nil {
-		err = DeleteContentRequestValidationError{
-			field:  "Id",
-			reason: "value must be a valid UUID",
-			cause:  err,
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchGetContentsResultValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchGetContentsResultValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchGetContentsResultValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return DeleteContentRequestMultiError(errors)
 	}
 
-	return nil
-}
+	// no validation rules for NotFound
 
-func (m *DeleteContentRequest) _validateUuid(uuid string) error {
-	if matched := _messages_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+	if len(errors) > 0 {
+		return BatchGetContentsResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteContentRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteContentRequest.ValidateAll() if the designated
+// BatchGetContentsResultMultiError is an error wrapping multiple validation
+// errors returned by BatchGetContentsResult.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteContentRequestMultiError []error
+type BatchGetContentsResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteContentRequestMultiError) Error() string {
+func (m BatchGetContentsResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1090,11 +977,11 @@ func (m DeleteContentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteContentRequestMultiError) AllErrors() []error { return m }
+func (m BatchGetContentsResultMultiError) AllErrors() []error { return m }
 
-// DeleteContentRequestValidationError is the validation error returned by
-// DeleteContentRequest.Validate if the designated constraints aren't met.
-type DeleteContentRequestValidationError struct {
+// BatchGetContentsResultValidationError is the validation error returned by
+// BatchGetContentsResult.Validate if the designated constraints aren't met.
+type BatchGetContentsResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1102,24 +989,24 @@ type DeleteContentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteContentRequestValidationError) Field() string { return e.field }
+func (e BatchGetContentsResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteContentRequestValidationError) Reason() string { return e.reason }
+func (e BatchGetContentsResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteContentRequestValidationError) Cause() error { return e.cause }
+func (e BatchGetContentsResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteContentRequestValidationError) Key() bool { return e.key }
+func (e BatchGetContentsResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteContentRequestValidationError) ErrorName() string {
-	return "DeleteContentRequestValidationError"
+func (e BatchGetContentsResultValidationError) ErrorName() string {
+	return "BatchGetContentsResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteContentRequestValidationError) Error() string {
+func (e BatchGetContentsResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1131,14 +1018,14 @@ func (e DeleteContentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteContentRequest.%s: %s%s",
+		"invalid %sBatchGetContentsResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteContentRequestValidationError{}
+var _ error = BatchGetContentsResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -1146,34 +1033,5686 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteContentRequestValidationError{}
+} = BatchGetContentsResultValidationError{}
 
-// Validate checks the field values on ListContentsRequest with the rules
+// Validate checks the field values on BatchGetContentsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListContentsRequest) Validate() error {
+func (m *BatchGetContentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetContentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetContentsResponseMultiError, or nil if none found.
+func (m *BatchGetContentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetContentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetResults()) > 100 {
+		err := BatchGetContentsResponseValidationError{
+			field:  "Results",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetContentsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetContentsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetContentsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetContentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetContentsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetContentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetContentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetContentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetContentsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetContentsResponseValidationError is the validation error returned by
+// BatchGetContentsResponse.Validate if the designated constraints aren't met.
+type BatchGetContentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetContentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetContentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetContentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetContentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetContentsResponseValidationError) ErrorName() string {
+	return "BatchGetContentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetContentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetContentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetContentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetContentsResponseValidationError{}
+
+// Validate checks the field values on UpdateContentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateContentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateContentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateContentRequestMultiError, or nil if none found.
+func (m *UpdateContentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateContentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateContentRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 255 {
+		err := UpdateContentRequestValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 5000 {
+		err := UpdateContentRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 5000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 50 {
+		err := UpdateContentRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := UpdateContentRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetLanguage()); l < 2 || l > 10 {
+		err := UpdateContentRequestValidationError{
+			field:  "Language",
+			reason: "value length must be between 2 and 10 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateContentRequest_Language_Pattern.MatchString(m.GetLanguage()) {
+		err := UpdateContentRequestValidationError{
+			field:  "Language",
+			reason: "value does not match regex pattern \"^[a-z]{2,3}(-[A-Z]{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDurationSeconds(); val < 0 || val > 86400 {
+		err := UpdateContentRequestValidationError{
+			field:  "DurationSeconds",
+			reason: "value must be inside range [0, 86400]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateContentRequest_PublishedAt_Pattern.MatchString(m.GetPublishedAt()) {
+		err := UpdateContentRequestValidationError{
+			field:  "PublishedAt",
+			reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateContentRequest_ContentType_NotInLookup[m.GetContentType()]; ok {
+		err := UpdateContentRequestValidationError{
+			field:  "ContentType",
+			reason: "value must not be in list [CONTENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContentType_name[int32(m.GetContentType())]; !ok {
+		err := UpdateContentRequestValidationError{
+			field:  "ContentType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetUrl()); l < 1 || l > 2048 {
+		err := UpdateContentRequestValidationError{
+			field:  "Url",
+			reason: "value length must be between 1 and 2048 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = UpdateContentRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := UpdateContentRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPlatformName()); l < 1 || l > 100 {
+		err := UpdateContentRequestValidationError{
+			field:  "PlatformName",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateContentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateContentRequest) _validateUuid(uuid string) error {
+	if matched := _messages_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateContentRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateContentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateContentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateContentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateContentRequestMultiError) AllErrors() []error { return m }
+
+// UpdateContentRequestValidationError is the validation error returned by
+// UpdateContentRequest.Validate if the designated constraints aren't met.
+type UpdateContentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateContentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateContentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateContentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateContentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateContentRequestValidationError) ErrorName() string {
+	return "UpdateContentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateContentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateContentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateContentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateContentRequestValidationError{}
+
+var _UpdateContentRequest_Language_Pattern = regexp.MustCompile("^[a-z]{2,3}(-[A-Z]{2})?$")
+
+var _UpdateContentRequest_PublishedAt_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$")
+
+var _UpdateContentRequest_ContentType_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on DeleteContentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteContentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteContentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteContentRequestMultiError, or nil if none found.
+func (m *DeleteContentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteContentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteContentRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteContentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteContentRequest) _validateUuid(uuid string) error {
+	if matched := _messages_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteContentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteContentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteContentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteContentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteContentRequestMultiError) AllErrors() []error { return m }
+
+// DeleteContentRequestValidationError is the validation error returned by
+// DeleteContentRequest.Validate if the designated constraints aren't met.
+type DeleteContentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteContentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteContentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteContentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteContentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteContentRequestValidationError) ErrorName() string {
+	return "DeleteContentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteContentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteContentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteContentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteContentRequestValidationError{}
+
+// Validate checks the field values on DeleteContentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteContentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteContentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteContentResponseMultiError, or nil if none found.
+func (m *DeleteContentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteContentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConsistencyToken
+
+	if len(errors) > 0 {
+		return DeleteContentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteContentResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteContentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteContentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteContentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteContentResponseMultiError) AllErrors() []error { return m }
+
+// DeleteContentResponseValidationError is the validation error returned by
+// DeleteContentResponse.Validate if the designated constraints aren't met.
+type DeleteContentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteContentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteContentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteContentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteContentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteContentResponseValidationError) ErrorName() string {
+	return "DeleteContentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteContentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteContentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteContentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteContentResponseValidationError{}
+
+// Validate checks the field values on ListContentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListContentsRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on ListContentsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListContentsRequestMultiError, or nil if none found.
-func (m *ListContentsRequest) ValidateAll() error {
+// ListContentsRequestMultiError, or nil if none found.
+func (m *ListContentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListContentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListContentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := ListContentsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOrderBy()) > 100 {
+		err := ListContentsRequestValidationError{
+			field:  "OrderBy",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFilter()) > 1024 {
+		err := ListContentsRequestValidationError{
+			field:  "Filter",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMinConsistency()) > 64 {
+		err := ListContentsRequestValidationError{
+			field:  "MinConsistency",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListContentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListContentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListContentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListContentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListContentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListContentsRequestMultiError) AllErrors() []error { return m }
+
+// ListContentsRequestValidationError is the validation error returned by
+// ListContentsRequest.Validate if the designated constraints aren't met.
+type ListContentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListContentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListContentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListContentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListContentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListContentsRequestValidationError) ErrorName() string {
+	return "ListContentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListContentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListContentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListContentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListContentsRequestValidationError{}
+
+// Validate checks the field values on ListContentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListContentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListContentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListContentsResponseMultiError, or nil if none found.
+func (m *ListContentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListContentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetContents()) > 100 {
+		err := ListContentsResponseValidationError{
+			field:  "Contents",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListContentsResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListContentsResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListContentsResponseValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetNextPageToken()) > 1024 {
+		err := ListContentsResponseValidationError{
+			field:  "NextPageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListContentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListContentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListContentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListContentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListContentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListContentsResponseMultiError) AllErrors() []error { return m }
+
+// ListContentsResponseValidationError is the validation error returned by
+// ListContentsResponse.Validate if the designated constraints aren't met.
+type ListContentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListContentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListContentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListContentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListContentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListContentsResponseValidationError) ErrorName() string {
+	return "ListContentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListContentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListContentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListContentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListContentsResponseValidationError{}
+
+// Validate checks the field values on SearchFilters with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchFilters) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchFilters with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchFiltersMultiError, or
+// nil if none found.
+func (m *SearchFilters) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchFilters) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetContentTypes()) > 10 {
+		err := SearchFiltersValidationError{
+			field:  "ContentTypes",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetContentTypes() {
+		_, _ = idx, item
+
+		if _, ok := _SearchFilters_ContentTypes_NotInLookup[item]; ok {
+			err := SearchFiltersValidationError{
+				field:  fmt.Sprintf("ContentTypes[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := ContentType_name[int32(item)]; !ok {
+			err := SearchFiltersValidationError{
+				field:  fmt.Sprintf("ContentTypes[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetLanguages()) > 20 {
+		err := SearchFiltersValidationError{
+			field:  "Languages",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetLanguages() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 2 || l > 10 {
+			err := SearchFiltersValidationError{
+				field:  fmt.Sprintf("Languages[%v]", idx),
+				reason: "value length must be between 2 and 10 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_SearchFilters_Languages_Pattern.MatchString(item) {
+			err := SearchFiltersValidationError{
+				field:  fmt.Sprintf("Languages[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-z]{2,3}(-[A-Z]{2})?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetTags()) > 50 {
+		err := SearchFiltersValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := SearchFiltersValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetPlatformNames()) > 20 {
+		err := SearchFiltersValidationError{
+			field:  "PlatformNames",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPlatformNames() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := SearchFiltersValidationError{
+				field:  fmt.Sprintf("PlatformNames[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetMinDurationSeconds(); val < 0 || val > 86400 {
+		err := SearchFiltersValidationError{
+			field:  "MinDurationSeconds",
+			reason: "value must be inside range [0, 86400]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMaxDurationSeconds(); val < 0 || val > 86400 {
+		err := SearchFiltersValidationError{
+			field:  "MaxDurationSeconds",
+			reason: "value must be inside range [0, 86400]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPublishedAfter() != "" {
+
+		if !_SearchFilters_PublishedAfter_Pattern.MatchString(m.GetPublishedAfter()) {
+			err := SearchFiltersValidationError{
+				field:  "PublishedAfter",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPublishedBefore() != "" {
+
+		if !_SearchFilters_PublishedBefore_Pattern.MatchString(m.GetPublishedBefore()) {
+			err := SearchFiltersValidationError{
+				field:  "PublishedBefore",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchFiltersMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchFiltersMultiError is an error wrapping multiple validation errors
+// returned by SearchFilters.ValidateAll() if the designated constraints
+// aren't met.
+type SearchFiltersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchFiltersMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchFiltersMultiError) AllErrors() []error { return m }
+
+// SearchFiltersValidationError is the validation error returned by
+// SearchFilters.Validate if the designated constraints aren't met.
+type SearchFiltersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchFiltersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchFiltersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchFiltersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchFiltersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchFiltersValidationError) ErrorName() string { return "SearchFiltersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchFiltersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchFilters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchFiltersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchFiltersValidationError{}
+
+var _SearchFilters_ContentTypes_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+var _SearchFilters_Languages_Pattern = regexp.MustCompile("^[a-z]{2,3}(-[A-Z]{2})?$")
+
+var _SearchFilters_PublishedAfter_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$")
+
+var _SearchFilters_PublishedBefore_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$")
+
+// Validate checks the field values on SearchContentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchContentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchContentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchContentsRequestMultiError, or nil if none found.
+func (m *SearchContentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchContentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 500 {
+		err := SearchContentsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := SearchContentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := SearchContentsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchContentsRequestValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchContentsRequestValidationError{
+					field:  "Filters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchContentsRequestValidationError{
+				field:  "Filters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeFacets
+
+	if utf8.RuneCountInString(m.GetMinConsistency()) > 64 {
+		err := SearchContentsRequestValidationError{
+			field:  "MinConsistency",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchContentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchContentsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchContentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchContentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchContentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchContentsRequestMultiError) AllErrors() []error { return m }
+
+// SearchContentsRequestValidationError is the validation error returned by
+// SearchContentsRequest.Validate if the designated constraints aren't met.
+type SearchContentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchContentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchContentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchContentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchContentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchContentsRequestValidationError) ErrorName() string {
+	return "SearchContentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchContentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchContentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchContentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchContentsRequestValidationError{}
+
+// Validate checks the field values on FacetBucket with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FacetBucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FacetBucket with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FacetBucketMultiError, or
+// nil if none found.
+func (m *FacetBucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FacetBucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return FacetBucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// FacetBucketMultiError is an error wrapping multiple validation errors
+// returned by FacetBucket.ValidateAll() if the designated constraints aren't met.
+type FacetBucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FacetBucketMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FacetBucketMultiError) AllErrors() []error { return m }
+
+// FacetBucketValidationError is the validation error returned by
+// FacetBucket.Validate if the designated constraints aren't met.
+type FacetBucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FacetBucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FacetBucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FacetBucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FacetBucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FacetBucketValidationError) ErrorName() string { return "FacetBucketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FacetBucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFacetBucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FacetBucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FacetBucketValidationError{}
+
+// Validate checks the field values on SearchFacets with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchFacets) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchFacets with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchFacetsMultiError, or
+// nil if none found.
+func (m *SearchFacets) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchFacets) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetContentTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("ContentTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("ContentTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFacetsValidationError{
+					field:  fmt.Sprintf("ContentTypes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetLanguages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFacetsValidationError{
+					field:  fmt.Sprintf("Languages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPlatformNames() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("PlatformNames[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("PlatformNames[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFacetsValidationError{
+					field:  fmt.Sprintf("PlatformNames[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFacetsValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDurations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Durations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchFacetsValidationError{
+						field:  fmt.Sprintf("Durations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchFacetsValidationError{
+					field:  fmt.Sprintf("Durations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchFacetsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchFacetsMultiError is an error wrapping multiple validation errors
+// returned by SearchFacets.ValidateAll() if the designated constraints aren't met.
+type SearchFacetsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchFacetsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchFacetsMultiError) AllErrors() []error { return m }
+
+// SearchFacetsValidationError is the validation error returned by
+// SearchFacets.Validate if the designated constraints aren't met.
+type SearchFacetsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchFacetsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchFacetsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchFacetsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchFacetsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchFacetsValidationError) ErrorName() string { return "SearchFacetsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchFacetsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchFacets.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchFacetsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchFacetsValidationError{}
+
+// Validate checks the field values on TextRange with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TextRange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TextRange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TextRangeMultiError, or nil
+// if none found.
+func (m *TextRange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TextRange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return TextRangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TextRangeMultiError is an error wrapping multiple validation errors returned
+// by TextRange.ValidateAll() if the designated constraints aren't met.
+type TextRangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TextRangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TextRangeMultiError) AllErrors() []error { return m }
+
+// TextRangeValidationError is the validation error returned by
+// TextRange.Validate if the designated constraints aren't met.
+type TextRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TextRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TextRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TextRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TextRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TextRangeValidationError) ErrorName() string { return "TextRangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TextRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTextRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TextRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TextRangeValidationError{}
+
+// Validate checks the field values on SearchMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchMatchMultiError, or
+// nil if none found.
+func (m *SearchMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentId
+
+	// no validation rules for Score
+
+	// no validation rules for HighlightedTitle
+
+	// no validation rules for Snippet
+
+	for idx, item := range m.GetSnippetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMatchValidationError{
+						field:  fmt.Sprintf("SnippetHighlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMatchValidationError{
+						field:  fmt.Sprintf("SnippetHighlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMatchValidationError{
+					field:  fmt.Sprintf("SnippetHighlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMatchMultiError is an error wrapping multiple validation errors
+// returned by SearchMatch.ValidateAll() if the designated constraints aren't met.
+type SearchMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMatchMultiError) AllErrors() []error { return m }
+
+// SearchMatchValidationError is the validation error returned by
+// SearchMatch.Validate if the designated constraints aren't met.
+type SearchMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMatchValidationError) ErrorName() string { return "SearchMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMatchValidationError{}
+
+// Validate checks the field values on SearchContentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchContentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchContentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchContentsResponseMultiError, or nil if none found.
+func (m *SearchContentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchContentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetContents()) > 100 {
+		err := SearchContentsResponseValidationError{
+			field:  "Contents",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchContentsResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchContentsResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchContentsResponseValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetNextPageToken()) > 1024 {
+		err := SearchContentsResponseValidationError{
+			field:  "NextPageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFacets()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchContentsResponseValidationError{
+					field:  "Facets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchContentsResponseValidationError{
+					field:  "Facets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFacets()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchContentsResponseValidationError{
+				field:  "Facets",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetMatches()) > 100 {
+		err := SearchContentsResponseValidationError{
+			field:  "Matches",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchContentsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchContentsResponseValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchContentsResponseValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchContentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchContentsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchContentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchContentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchContentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchContentsResponseMultiError) AllErrors() []error { return m }
+
+// SearchContentsResponseValidationError is the validation error returned by
+// SearchContentsResponse.Validate if the designated constraints aren't met.
+type SearchContentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchContentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchContentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchContentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchContentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchContentsResponseValidationError) ErrorName() string {
+	return "SearchContentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchContentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchContentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchContentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchContentsResponseValidationError{}
+
+// Validate checks the field values on SuggestRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SuggestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SuggestRequestMultiError,
+// or nil if none found.
+func (m *SuggestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPrefix()); l < 1 || l > 100 {
+		err := SuggestRequestValidationError{
+			field:  "Prefix",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 20 {
+		err := SuggestRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 20]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := SuggestOrder_name[int32(m.GetOrder())]; !ok {
+		err := SuggestRequestValidationError{
+			field:  "Order",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMinConsistency()) > 64 {
+		err := SuggestRequestValidationError{
+			field:  "MinConsistency",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SuggestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestRequestMultiError is an error wrapping multiple validation errors
+// returned by SuggestRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SuggestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestRequestMultiError) AllErrors() []error { return m }
+
+// SuggestRequestValidationError is the validation error returned by
+// SuggestRequest.Validate if the designated constraints aren't met.
+type SuggestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestRequestValidationError) ErrorName() string { return "SuggestRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SuggestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestRequestValidationError{}
+
+// Validate checks the field values on Suggestion with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Suggestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Suggestion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SuggestionMultiError, or
+// nil if none found.
+func (m *Suggestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Suggestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	// no validation rules for Type
+
+	// no validation rules for ContentId
+
+	// no validation rules for ContentCount
+
+	if len(errors) > 0 {
+		return SuggestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestionMultiError is an error wrapping multiple validation errors
+// returned by Suggestion.ValidateAll() if the designated constraints aren't met.
+type SuggestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestionMultiError) AllErrors() []error { return m }
+
+// SuggestionValidationError is the validation error returned by
+// Suggestion.Validate if the designated constraints aren't met.
+type SuggestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestionValidationError) ErrorName() string { return "SuggestionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SuggestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestionValidationError{}
+
+// Validate checks the field values on SuggestResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SuggestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuggestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuggestResponseMultiError, or nil if none found.
+func (m *SuggestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuggestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetSuggestions()) > 20 {
+		err := SuggestResponseValidationError{
+			field:  "Suggestions",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSuggestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SuggestResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SuggestResponseValidationError{
+						field:  fmt.Sprintf("Suggestions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SuggestResponseValidationError{
+					field:  fmt.Sprintf("Suggestions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SuggestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuggestResponseMultiError is an error wrapping multiple validation errors
+// returned by SuggestResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SuggestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuggestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuggestResponseMultiError) AllErrors() []error { return m }
+
+// SuggestResponseValidationError is the validation error returned by
+// SuggestResponse.Validate if the designated constraints aren't met.
+type SuggestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuggestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuggestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuggestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuggestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuggestResponseValidationError) ErrorName() string { return "SuggestResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SuggestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuggestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuggestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuggestResponseValidationError{}
+
+// Validate checks the field values on GetRelatedContentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRelatedContentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRelatedContentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRelatedContentsRequestMultiError, or nil if none found.
+func (m *GetRelatedContentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRelatedContentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetRelatedContentsRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := GetRelatedContentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := GetRelatedContentsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SamePlatform
+
+	if utf8.RuneCountInString(m.GetMinConsistency()) > 64 {
+		err := GetRelatedContentsRequestValidationError{
+			field:  "MinConsistency",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRelatedContentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetRelatedContentsRequest) _validateUuid(uuid string) error {
+	if matched := _messages_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetRelatedContentsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRelatedContentsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetRelatedContentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRelatedContentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRelatedContentsRequestMultiError) AllErrors() []error { return m }
+
+// GetRelatedContentsRequestValidationError is the validation error returned by
+// GetRelatedContentsRequest.Validate if the designated constraints aren't met.
+type GetRelatedContentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelatedContentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelatedContentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelatedContentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelatedContentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelatedContentsRequestValidationError) ErrorName() string {
+	return "GetRelatedContentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelatedContentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelatedContentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelatedContentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelatedContentsRequestValidationError{}
+
+// Validate checks the field values on GetRelatedContentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRelatedContentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRelatedContentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRelatedContentsResponseMultiError, or nil if none found.
+func (m *GetRelatedContentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRelatedContentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetContents()) > 100 {
+		err := GetRelatedContentsResponseValidationError{
+			field:  "Contents",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRelatedContentsResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRelatedContentsResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRelatedContentsResponseValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetNextPageToken()) > 1024 {
+		err := GetRelatedContentsResponseValidationError{
+			field:  "NextPageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRelatedContentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRelatedContentsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetRelatedContentsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetRelatedContentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRelatedContentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRelatedContentsResponseMultiError) AllErrors() []error { return m }
+
+// GetRelatedContentsResponseValidationError is the validation error returned
+// by GetRelatedContentsResponse.Validate if the designated constraints aren't met.
+type GetRelatedContentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelatedContentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelatedContentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelatedContentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelatedContentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelatedContentsResponseValidationError) ErrorName() string {
+	return "GetRelatedContentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelatedContentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelatedContentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelatedContentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelatedContentsResponseValidationError{}
+
+// Validate checks the field values on ListTagsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsRequestMultiError, or nil if none found.
+func (m *ListTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListTagsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := ListTagsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TagOrder_name[int32(m.GetOrder())]; !ok {
+		err := ListTagsRequestValidationError{
+			field:  "Order",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPrefix()) > 100 {
+		err := ListTagsRequestValidationError{
+			field:  "Prefix",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMinConsistency()) > 64 {
+		err := ListTagsRequestValidationError{
+			field:  "MinConsistency",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTagsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsRequestMultiError) AllErrors() []error { return m }
+
+// ListTagsRequestValidationError is the validation error returned by
+// ListTagsRequest.Validate if the designated constraints aren't met.
+type ListTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsRequestValidationError) ErrorName() string { return "ListTagsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsRequestValidationError{}
+
+// Validate checks the field values on Tag with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Tag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tag with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TagMultiError, or nil if none found.
+func (m *Tag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ContentCount
+
+	if len(errors) > 0 {
+		return TagMultiError(errors)
+	}
+
+	return nil
+}
+
+// TagMultiError is an error wrapping multiple validation errors returned by
+// Tag.ValidateAll() if the designated constraints aren't met.
+type TagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TagMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TagMultiError) AllErrors() []error { return m }
+
+// TagValidationError is the validation error returned by Tag.Validate if the
+// designated constraints aren't met.
+type TagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TagValidationError) ErrorName() string { return "TagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TagValidationError{}
+
+// Validate checks the field values on ListTagsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTagsResponseMultiError, or nil if none found.
+func (m *ListTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTags()) > 100 {
+		err := ListTagsResponseValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTagsResponseValidationError{
+						field:  fmt.Sprintf("Tags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTagsResponseValidationError{
+					field:  fmt.Sprintf("Tags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetNextPageToken()) > 1024 {
+		err := ListTagsResponseValidationError{
+			field:  "NextPageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTagsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTagsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTagsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTagsResponseMultiError) AllErrors() []error { return m }
+
+// ListTagsResponseValidationError is the validation error returned by
+// ListTagsResponse.Validate if the designated constraints aren't met.
+type ListTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTagsResponseValidationError) ErrorName() string { return "ListTagsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTagsResponseValidationError{}
+
+// Validate checks the field values on ListContentsByTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListContentsByTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListContentsByTagRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListContentsByTagRequestMultiError, or nil if none found.
+func (m *ListContentsByTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListContentsByTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTag()); l < 1 || l > 100 {
+		err := ListContentsByTagRequestValidationError{
+			field:  "Tag",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListContentsByTagRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := ListContentsByTagRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMinConsistency()) > 64 {
+		err := ListContentsByTagRequestValidationError{
+			field:  "MinConsistency",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListContentsByTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListContentsByTagRequestMultiError is an error wrapping multiple validation
+// errors returned by ListContentsByTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListContentsByTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListContentsByTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListContentsByTagRequestMultiError) AllErrors() []error { return m }
+
+// ListContentsByTagRequestValidationError is the validation error returned by
+// ListContentsByTagRequest.Validate if the designated constraints aren't met.
+type ListContentsByTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListContentsByTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListContentsByTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListContentsByTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListContentsByTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListContentsByTagRequestValidationError) ErrorName() string {
+	return "ListContentsByTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListContentsByTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListContentsByTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListContentsByTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListContentsByTagRequestValidationError{}
+
+// Validate checks the field values on ListContentsByTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListContentsByTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListContentsByTagResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListContentsByTagResponseMultiError, or nil if none found.
+func (m *ListContentsByTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListContentsByTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetContents()) > 100 {
+		err := ListContentsByTagResponseValidationError{
+			field:  "Contents",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListContentsByTagResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListContentsByTagResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListContentsByTagResponseValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetNextPageToken()) > 1024 {
+		err := ListContentsByTagResponseValidationError{
+			field:  "NextPageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListContentsByTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListContentsByTagResponseMultiError is an error wrapping multiple validation
+// errors returned by ListContentsByTagResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListContentsByTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListContentsByTagResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListContentsByTagResponseMultiError) AllErrors() []error { return m }
+
+// ListContentsByTagResponseValidationError is the validation error returned by
+// ListContentsByTagResponse.Validate if the designated constraints aren't met.
+type ListContentsByTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListContentsByTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListContentsByTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListContentsByTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListContentsByTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListContentsByTagResponseValidationError) ErrorName() string {
+	return "ListContentsByTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListContentsByTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListContentsByTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListContentsByTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListContentsByTagResponseValidationError{}
+
+// Validate checks the field values on RecordEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordEventRequestMultiError, or nil if none found.
+func (m *RecordEventRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordEventRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetContentId()); err != nil {
+		err = RecordEventRequestValidationError{
+			field:  "ContentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _RecordEventRequest_Type_NotInLookup[m.GetType()]; ok {
+		err := RecordEventRequestValidationError{
+			field:  "Type",
+			reason: "value must not be in list [EVENT_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := EventType_name[int32(m.GetType())]; !ok {
+		err := RecordEventRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSessionId()); l < 1 || l > 128 {
+		err := RecordEventRequestValidationError{
+			field:  "SessionId",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOccurredAt() != "" {
+
+		if !_RecordEventRequest_OccurredAt_Pattern.MatchString(m.GetOccurredAt()) {
+			err := RecordEventRequestValidationError{
+				field:  "OccurredAt",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecordEventRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RecordEventRequest) _validateUuid(uuid string) error {
+	if matched := _messages_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RecordEventRequestMultiError is an error wrapping multiple validation errors
+// returned by RecordEventRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RecordEventRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordEventRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordEventRequestMultiError) AllErrors() []error { return m }
+
+// RecordEventRequestValidationError is the validation error returned by
+// RecordEventRequest.Validate if the designated constraints aren't met.
+type RecordEventRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordEventRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordEventRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordEventRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordEventRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordEventRequestValidationError) ErrorName() string {
+	return "RecordEventRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordEventRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordEventRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordEventRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordEventRequestValidationError{}
+
+var _RecordEventRequest_Type_NotInLookup = map[EventType]struct{}{
+	0: {},
+}
+
+var _RecordEventRequest_OccurredAt_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$")
+
+// Validate checks the field values on RecordEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordEventResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordEventResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordEventResponseMultiError, or nil if none found.
+func (m *RecordEventResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordEventResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Duplicate
+
+	if len(errors) > 0 {
+		return RecordEventResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordEventResponseMultiError is an error wrapping multiple validation
+// errors returned by RecordEventResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RecordEventResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordEventResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordEventResponseMultiError) AllErrors() []error { return m }
+
+// RecordEventResponseValidationError is the validation error returned by
+// RecordEventResponse.Validate if the designated constraints aren't met.
+type RecordEventResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordEventResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordEventResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordEventResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordEventResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordEventResponseValidationError) ErrorName() string {
+	return "RecordEventResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordEventResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordEventResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordEventResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordEventResponseValidationError{}
+
+// Validate checks the field values on ListTrendingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrendingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrendingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrendingRequestMultiError, or nil if none found.
+func (m *ListTrendingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrendingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := TrendingWindow_name[int32(m.GetWindow())]; !ok {
+		err := ListTrendingRequestValidationError{
+			field:  "Window",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListTrendingRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := ListTrendingRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLanguages()) > 20 {
+		err := ListTrendingRequestValidationError{
+			field:  "Languages",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetLanguages() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 2 || l > 10 {
+			err := ListTrendingRequestValidationError{
+				field:  fmt.Sprintf("Languages[%v]", idx),
+				reason: "value length must be between 2 and 10 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_ListTrendingRequest_Languages_Pattern.MatchString(item) {
+			err := ListTrendingRequestValidationError{
+				field:  fmt.Sprintf("Languages[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-z]{2,3}(-[A-Z]{2})?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetContentTypes()) > 10 {
+		err := ListTrendingRequestValidationError{
+			field:  "ContentTypes",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetContentTypes() {
+		_, _ = idx, item
+
+		if _, ok := _ListTrendingRequest_ContentTypes_NotInLookup[item]; ok {
+			err := ListTrendingRequestValidationError{
+				field:  fmt.Sprintf("ContentTypes[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := ContentType_name[int32(item)]; !ok {
+			err := ListTrendingRequestValidationError{
+				field:  fmt.Sprintf("ContentTypes[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetMinConsistency()) > 64 {
+		err := ListTrendingRequestValidationError{
+			field:  "MinConsistency",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTrendingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrendingRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTrendingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTrendingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrendingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrendingRequestMultiError) AllErrors() []error { return m }
+
+// ListTrendingRequestValidationError is the validation error returned by
+// ListTrendingRequest.Validate if the designated constraints aren't met.
+type ListTrendingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrendingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrendingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrendingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrendingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrendingRequestValidationError) ErrorName() string {
+	return "ListTrendingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrendingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrendingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrendingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrendingRequestValidationError{}
+
+var _ListTrendingRequest_Languages_Pattern = regexp.MustCompile("^[a-z]{2,3}(-[A-Z]{2})?$")
+
+var _ListTrendingRequest_ContentTypes_NotInLookup = map[ContentType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on TrendingStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TrendingStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TrendingStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TrendingStatsMultiError, or
+// nil if none found.
+func (m *TrendingStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TrendingStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentId
+
+	// no validation rules for Score
+
+	// no validation rules for EventCount
+
+	if len(errors) > 0 {
+		return TrendingStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TrendingStatsMultiError is an error wrapping multiple validation errors
+// returned by TrendingStats.ValidateAll() if the designated constraints
+// aren't met.
+type TrendingStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TrendingStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TrendingStatsMultiError) AllErrors() []error { return m }
+
+// TrendingStatsValidationError is the validation error returned by
+// TrendingStats.Validate if the designated constraints aren't met.
+type TrendingStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrendingStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrendingStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrendingStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrendingStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrendingStatsValidationError) ErrorName() string { return "TrendingStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TrendingStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrendingStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrendingStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrendingStatsValidationError{}
+
+// Validate checks the field values on ListTrendingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrendingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrendingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrendingResponseMultiError, or nil if none found.
+func (m *ListTrendingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrendingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetContents()) > 100 {
+		err := ListTrendingResponseValidationError{
+			field:  "Contents",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTrendingResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTrendingResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTrendingResponseValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetNextPageToken()) > 1024 {
+		err := ListTrendingResponseValidationError{
+			field:  "NextPageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetStats()) > 100 {
+		err := ListTrendingResponseValidationError{
+			field:  "Stats",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetStats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTrendingResponseValidationError{
+						field:  fmt.Sprintf("Stats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTrendingResponseValidationError{
+						field:  fmt.Sprintf("Stats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTrendingResponseValidationError{
+					field:  fmt.Sprintf("Stats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTrendingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrendingResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTrendingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTrendingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrendingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrendingResponseMultiError) AllErrors() []error { return m }
+
+// ListTrendingResponseValidationError is the validation error returned by
+// ListTrendingResponse.Validate if the designated constraints aren't met.
+type ListTrendingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrendingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrendingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrendingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrendingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrendingResponseValidationError) ErrorName() string {
+	return "ListTrendingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrendingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrendingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrendingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrendingResponseValidationError{}
+
+// Validate checks the field values on SearchAnalyticsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchAnalyticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAnalyticsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchAnalyticsRequestMultiError, or nil if none found.
+func (m *SearchAnalyticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAnalyticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStartTime() != "" {
+
+		if !_SearchAnalyticsRequest_StartTime_Pattern.MatchString(m.GetStartTime()) {
+			err := SearchAnalyticsRequestValidationError{
+				field:  "StartTime",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndTime() != "" {
+
+		if !_SearchAnalyticsRequest_EndTime_Pattern.MatchString(m.GetEndTime()) {
+			err := SearchAnalyticsRequestValidationError{
+				field:  "EndTime",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := SearchAnalyticsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchAnalyticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAnalyticsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchAnalyticsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchAnalyticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAnalyticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAnalyticsRequestMultiError) AllErrors() []error { return m }
+
+// SearchAnalyticsRequestValidationError is the validation error returned by
+// SearchAnalyticsRequest.Validate if the designated constraints aren't met.
+type SearchAnalyticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAnalyticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAnalyticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAnalyticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAnalyticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAnalyticsRequestValidationError) ErrorName() string {
+	return "SearchAnalyticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAnalyticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAnalyticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAnalyticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAnalyticsRequestValidationError{}
+
+var _SearchAnalyticsRequest_StartTime_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$")
+
+var _SearchAnalyticsRequest_EndTime_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$")
+
+// Validate checks the field values on QueryStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueryStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueryStatsMultiError, or
+// nil if none found.
+func (m *QueryStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Query
+
+	// no validation rules for Searches
+
+	// no validation rules for ZeroResultSearches
+
+	// no validation rules for AvgResultCount
+
+	// no validation rules for AvgLatencyMs
+
+	// no validation rules for AvgPageDepth
+
+	// no validation rules for PreviousSearches
+
+	// no validation rules for Growth
+
+	if len(errors) > 0 {
+		return QueryStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryStatsMultiError is an error wrapping multiple validation errors
+// returned by QueryStats.ValidateAll() if the designated constraints aren't met.
+type QueryStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryStatsMultiError) AllErrors() []error { return m }
+
+// QueryStatsValidationError is the validation error returned by
+// QueryStats.Validate if the designated constraints aren't met.
+type QueryStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryStatsValidationError) ErrorName() string { return "QueryStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryStatsValidationError{}
+
+// Validate checks the field values on SearchAnalyticsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchAnalyticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAnalyticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchAnalyticsResponseMultiError, or nil if none found.
+func (m *SearchAnalyticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAnalyticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetQueries()) > 1000 {
+		err := SearchAnalyticsResponseValidationError{
+			field:  "Queries",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetQueries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchAnalyticsResponseValidationError{
+					field:  fmt.Sprintf("Queries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchAnalyticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAnalyticsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchAnalyticsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchAnalyticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAnalyticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAnalyticsResponseMultiError) AllErrors() []error { return m }
+
+// SearchAnalyticsResponseValidationError is the validation error returned by
+// SearchAnalyticsResponse.Validate if the designated constraints aren't met.
+type SearchAnalyticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAnalyticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAnalyticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAnalyticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAnalyticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAnalyticsResponseValidationError) ErrorName() string {
+	return "SearchAnalyticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAnalyticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAnalyticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAnalyticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAnalyticsResponseValidationError{}
+
+// Validate checks the field values on ExportSearchAnalyticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportSearchAnalyticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportSearchAnalyticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportSearchAnalyticsRequestMultiError, or nil if none found.
+func (m *ExportSearchAnalyticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportSearchAnalyticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ExportSearchAnalyticsRequest_Report_NotInLookup[m.GetReport()]; ok {
+		err := ExportSearchAnalyticsRequestValidationError{
+			field:  "Report",
+			reason: "value must not be in list [SEARCH_REPORT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := SearchReport_name[int32(m.GetReport())]; !ok {
+		err := ExportSearchAnalyticsRequestValidationError{
+			field:  "Report",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() != "" {
+
+		if !_ExportSearchAnalyticsRequest_StartTime_Pattern.MatchString(m.GetStartTime()) {
+			err := ExportSearchAnalyticsRequestValidationError{
+				field:  "StartTime",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndTime() != "" {
+
+		if !_ExportSearchAnalyticsRequest_EndTime_Pattern.MatchString(m.GetEndTime()) {
+			err := ExportSearchAnalyticsRequestValidationError{
+				field:  "EndTime",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := ExportSearchAnalyticsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportSearchAnalyticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportSearchAnalyticsRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportSearchAnalyticsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ExportSearchAnalyticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportSearchAnalyticsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportSearchAnalyticsRequestMultiError) AllErrors() []error { return m }
+
+// ExportSearchAnalyticsRequestValidationError is the validation error returned
+// by ExportSearchAnalyticsRequest.Validate if the designated constraints
+// aren't met.
+type ExportSearchAnalyticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportSearchAnalyticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportSearchAnalyticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportSearchAnalyticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportSearchAnalyticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportSearchAnalyticsRequestValidationError) ErrorName() string {
+	return "ExportSearchAnalyticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportSearchAnalyticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportSearchAnalyticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportSearchAnalyticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportSearchAnalyticsRequestValidationError{}
+
+var _ExportSearchAnalyticsRequest_Report_NotInLookup = map[SearchReport]struct{}{
+	0: {},
+}
+
+var _ExportSearchAnalyticsRequest_StartTime_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$")
+
+var _ExportSearchAnalyticsRequest_EndTime_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$")
+
+// Validate checks the field values on ExportSearchAnalyticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportSearchAnalyticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportSearchAnalyticsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportSearchAnalyticsResponseMultiError, or nil if none found.
+func (m *ExportSearchAnalyticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportSearchAnalyticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Filename
+
+	// no validation rules for Csv
+
+	if len(errors) > 0 {
+		return ExportSearchAnalyticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportSearchAnalyticsResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportSearchAnalyticsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ExportSearchAnalyticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportSearchAnalyticsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportSearchAnalyticsResponseMultiError) AllErrors() []error { return m }
+
+// ExportSearchAnalyticsResponseValidationError is the validation error
+// returned by ExportSearchAnalyticsResponse.Validate if the designated
+// constraints aren't met.
+type ExportSearchAnalyticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportSearchAnalyticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportSearchAnalyticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportSearchAnalyticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportSearchAnalyticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportSearchAnalyticsResponseValidationError) ErrorName() string {
+	return "ExportSearchAnalyticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportSearchAnalyticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportSearchAnalyticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportSearchAnalyticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportSearchAnalyticsResponseValidationError{}
+
+// Validate checks the field values on ImportRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportRequestMultiError, or
+// nil if none found.
+func (m *ImportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUrl()); l < 1 || l > 2048 {
+		err := ImportRequestValidationError{
+			field:  "Url",
+			reason: "value length must be between 1 and 2048 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = ImportRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := ImportRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AllItems
+
+	if len(errors) > 0 {
+		return ImportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRequestMultiError) AllErrors() []error { return m }
+
+// ImportRequestValidationError is the validation error returned by
+// ImportRequest.Validate if the designated constraints aren't met.
+type ImportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRequestValidationError) ErrorName() string { return "ImportRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRequestValidationError{}
+
+// Validate checks the field values on ImportResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportResponseMultiError,
+// or nil if none found.
+func (m *ImportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetContent() == nil {
+		err := ImportResponseValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportResponseValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportResponseValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportResponseValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetContents()) > 200 {
+		err := ImportResponseValidationError{
+			field:  "Contents",
+			reason: "value must contain no more than 200 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportResponseValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportResponseMultiError is an error wrapping multiple validation errors
+// returned by ImportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ImportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportResponseMultiError) AllErrors() []error { return m }
+
+// ImportResponseValidationError is the validation error returned by
+// ImportResponse.Validate if the designated constraints aren't met.
+type ImportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportResponseValidationError) ErrorName() string { return "ImportResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportResponseValidationError{}
+
+// Validate checks the field values on ProviderInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProviderInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProviderInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProviderInfoMultiError, or
+// nil if none found.
+func (m *ProviderInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProviderInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for SupportsAllItems
+
+	if len(errors) > 0 {
+		return ProviderInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProviderInfoMultiError is an error wrapping multiple validation errors
+// returned by ProviderInfo.ValidateAll() if the designated constraints aren't met.
+type ProviderInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProviderInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProviderInfoMultiError) AllErrors() []error { return m }
+
+// ProviderInfoValidationError is the validation error returned by
+// ProviderInfo.Validate if the designated constraints aren't met.
+type ProviderInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProviderInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProviderInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProviderInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProviderInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProviderInfoValidationError) ErrorName() string { return "ProviderInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProviderInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProviderInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProviderInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProviderInfoValidationError{}
+
+// Validate checks the field values on ResolveURLRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResolveURLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveURLRequestMultiError, or nil if none found.
+func (m *ResolveURLRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListContentsRequest) validate(all bool) error {
+func (m *ResolveURLRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if val := m.GetPageSize(); val < 1 || val > 100 {
-		err := ListContentsRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be inside range [1, 100]",
+	if l := utf8.RuneCountInString(m.GetUrl()); l < 1 || l > 2048 {
+		err := ResolveURLRequestValidationError{
+			field:  "Url",
+			reason: "value length must be between 1 and 2048 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1181,10 +6720,20 @@ func (m *ListContentsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
-		err := ListContentsRequestValidationError{
-			field:  "PageToken",
-			reason: "value length must be at most 1024 runes",
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = ResolveURLRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := ResolveURLRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
 		}
 		if !all {
 			return err
@@ -1193,19 +6742,19 @@ func (m *ListContentsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListContentsRequestMultiError(errors)
+		return ResolveURLRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListContentsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListContentsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListContentsRequestMultiError []error
+// ResolveURLRequestMultiError is an error wrapping multiple validation errors
+// returned by ResolveURLRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResolveURLRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListContentsRequestMultiError) Error() string {
+func (m ResolveURLRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1214,11 +6763,11 @@ func (m ListContentsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListContentsRequestMultiError) AllErrors() []error { return m }
+func (m ResolveURLRequestMultiError) AllErrors() []error { return m }
 
-// ListContentsRequestValidationError is the validation error returned by
-// ListContentsRequest.Validate if the designated constraints aren't met.
-type ListContentsRequestValidationError struct {
+// ResolveURLRequestValidationError is the validation error returned by
+// ResolveURLRequest.Validate if the designated constraints aren't met.
+type ResolveURLRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1226,24 +6775,24 @@ type ListContentsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListContentsRequestValidationError) Field() string { return e.field }
+func (e ResolveURLRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListContentsRequestValidationError) Reason() string { return e.reason }
+func (e ResolveURLRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListContentsRequestValidationError) Cause() error { return e.cause }
+func (e ResolveURLRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListContentsRequestValidationError) Key() bool { return e.key }
+func (e ResolveURLRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListContentsRequestValidationError) ErrorName() string {
-	return "ListContentsRequestValidationError"
+func (e ResolveURLRequestValidationError) ErrorName() string {
+	return "ResolveURLRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListContentsRequestValidationError) Error() string {
+func (e ResolveURLRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1255,14 +6804,14 @@ func (e ListContentsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListContentsRequest.%s: %s%s",
+		"invalid %sResolveURLRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListContentsRequestValidationError{}
+var _ error = ResolveURLRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1270,100 +6819,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListContentsRequestValidationError{}
+} = ResolveURLRequestValidationError{}
 
-// Validate checks the field values on ListContentsResponse with the rules
+// Validate checks the field values on ResolveURLResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListContentsResponse) Validate() error {
+func (m *ResolveURLResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListContentsResponse with the rules
+// ValidateAll checks the field values on ResolveURLResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListContentsResponseMultiError, or nil if none found.
-func (m *ListContentsResponse) ValidateAll() error {
+// ResolveURLResponseMultiError, or nil if none found.
+func (m *ResolveURLResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListContentsResponse) validate(all bool) error {
+func (m *ResolveURLResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetContents()) > 100 {
-		err := ListContentsResponseValidationError{
-			field:  "Contents",
-			reason: "value must contain no more than 100 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetContents() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListContentsResponseValidationError{
-						field:  fmt.Sprintf("Contents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListContentsResponseValidationError{
-						field:  fmt.Sprintf("Contents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetProvider()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveURLResponseValidationError{
+					field:  "Provider",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListContentsResponseValidationError{
-					field:  fmt.Sprintf("Contents[%v]", idx),
+				errors = append(errors, ResolveURLResponseValidationError{
+					field:  "Provider",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	}
-
-	if utf8.RuneCountInString(m.GetNextPageToken()) > 1024 {
-		err := ListContentsResponseValidationError{
-			field:  "NextPageToken",
-			reason: "value length must be at most 1024 runes",
-		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetProvider()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveURLResponseValidationError{
+				field:  "Provider",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for Url
+
 	if len(errors) > 0 {
-		return ListContentsResponseMultiError(errors)
+		return ResolveURLResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListContentsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListContentsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListContentsResponseMultiError []error
+// ResolveURLResponseMultiError is an error wrapping multiple validation errors
+// returned by ResolveURLResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ResolveURLResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListContentsResponseMultiError) Error() string {
+func (m ResolveURLResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1372,11 +6896,11 @@ func (m ListContentsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListContentsResponseMultiError) AllErrors() []error { return m }
+func (m ResolveURLResponseMultiError) AllErrors() []error { return m }
 
-// ListContentsResponseValidationError is the validation error returned by
-// ListContentsResponse.Validate if the designated constraints aren't met.
-type ListContentsResponseValidationError struct {
+// ResolveURLResponseValidationError is the validation error returned by
+// ResolveURLResponse.Validate if the designated constraints aren't met.
+type ResolveURLResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1384,24 +6908,24 @@ type ListContentsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListContentsResponseValidationError) Field() string { return e.field }
+func (e ResolveURLResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListContentsResponseValidationError) Reason() string { return e.reason }
+func (e ResolveURLResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListContentsResponseValidationError) Cause() error { return e.cause }
+func (e ResolveURLResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListContentsResponseValidationError) Key() bool { return e.key }
+func (e ResolveURLResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListContentsResponseValidationError) ErrorName() string {
-	return "ListContentsResponseValidationError"
+func (e ResolveURLResponseValidationError) ErrorName() string {
+	return "ResolveURLResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListContentsResponseValidationError) Error() string {
+func (e ResolveURLResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1413,14 +6937,14 @@ func (e ListContentsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListContentsResponse.%s: %s%s",
+		"invalid %sResolveURLResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListContentsResponseValidationError{}
+var _ error = ResolveURLResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1428,77 +6952,180 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListContentsResponseValidationError{}
+} = ResolveURLResponseValidationError{}
 
-// Validate checks the field values on SearchContentsRequest with the rules
+// Validate checks the field values on ListProvidersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchContentsRequest) Validate() error {
+func (m *ListProvidersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchContentsRequest with the rules
+// ValidateAll checks the field values on ListProvidersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchContentsRequestMultiError, or nil if none found.
-func (m *SearchContentsRequest) ValidateAll() error {
+// ListProvidersRequestMultiError, or nil if none found.
+func (m *ListProvidersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchContentsRequest) validate(all bool) error {
+func (m *ListProvidersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 500 {
-		err := SearchContentsRequestValidationError{
-			field:  "Query",
-			reason: "value length must be between 1 and 500 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return ListProvidersRequestMultiError(errors)
 	}
 
-	if val := m.GetPageSize(); val < 1 || val > 100 {
-		err := SearchContentsRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be inside range [1, 100]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	return nil
+}
+
+// ListProvidersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListProvidersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProvidersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProvidersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
-		err := SearchContentsRequestValidationError{
-			field:  "PageToken",
-			reason: "value length must be at most 1024 runes",
-		}
-		if !all {
-			return err
+// AllErrors returns a list of validation violation errors.
+func (m ListProvidersRequestMultiError) AllErrors() []error { return m }
+
+// ListProvidersRequestValidationError is the validation error returned by
+// ListProvidersRequest.Validate if the designated constraints aren't met.
+type ListProvidersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListProvidersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListProvidersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListProvidersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListProvidersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListProvidersRequestValidationError) ErrorName() string {
+	return "ListProvidersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListProvidersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListProvidersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListProvidersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListProvidersRequestValidationError{}
+
+// Validate checks the field values on ListProvidersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListProvidersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListProvidersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListProvidersResponseMultiError, or nil if none found.
+func (m *ListProvidersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListProvidersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProviders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProvidersResponseValidationError{
+						field:  fmt.Sprintf("Providers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProvidersResponseValidationError{
+						field:  fmt.Sprintf("Providers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProvidersResponseValidationError{
+					field:  fmt.Sprintf("Providers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return SearchContentsRequestMultiError(errors)
+		return ListProvidersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchContentsRequestMultiError is an error wrapping multiple validation
-// errors returned by SearchContentsRequest.ValidateAll() if the designated
+// ListProvidersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListProvidersResponse.ValidateAll() if the designated
 // constraints aren't met.
-type SearchContentsRequestMultiError []error
+type ListProvidersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchContentsRequestMultiError) Error() string {
+func (m ListProvidersResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1507,11 +7134,11 @@ func (m SearchContentsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchContentsRequestMultiError) AllErrors() []error { return m }
+func (m ListProvidersResponseMultiError) AllErrors() []error { return m }
 
-// SearchContentsRequestValidationError is the validation error returned by
-// SearchContentsRequest.Validate if the designated constraints aren't met.
-type SearchContentsRequestValidationError struct {
+// ListProvidersResponseValidationError is the validation error returned by
+// ListProvidersResponse.Validate if the designated constraints aren't met.
+type ListProvidersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1519,24 +7146,24 @@ type SearchContentsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchContentsRequestValidationError) Field() string { return e.field }
+func (e ListProvidersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchContentsRequestValidationError) Reason() string { return e.reason }
+func (e ListProvidersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchContentsRequestValidationError) Cause() error { return e.cause }
+func (e ListProvidersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchContentsRequestValidationError) Key() bool { return e.key }
+func (e ListProvidersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchContentsRequestValidationError) ErrorName() string {
-	return "SearchContentsRequestValidationError"
+func (e ListProvidersResponseValidationError) ErrorName() string {
+	return "ListProvidersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchContentsRequestValidationError) Error() string {
+func (e ListProvidersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1548,14 +7175,14 @@ func (e SearchContentsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchContentsRequest.%s: %s%s",
+		"invalid %sListProvidersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchContentsRequestValidationError{}
+var _ error = ListProvidersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1563,34 +7190,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchContentsRequestValidationError{}
+} = ListProvidersResponseValidationError{}
 
-// Validate checks the field values on SearchContentsResponse with the rules
+// Validate checks the field values on PreviewImportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchContentsResponse) Validate() error {
+func (m *PreviewImportRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchContentsResponse with the rules
+// ValidateAll checks the field values on PreviewImportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SearchContentsResponseMultiError, or nil if none found.
-func (m *SearchContentsResponse) ValidateAll() error {
+// PreviewImportRequestMultiError, or nil if none found.
+func (m *PreviewImportRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchContentsResponse) validate(all bool) error {
+func (m *PreviewImportRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetContents()) > 100 {
-		err := SearchContentsResponseValidationError{
-			field:  "Contents",
-			reason: "value must contain no more than 100 item(s)",
+	if l := utf8.RuneCountInString(m.GetUrl()); l < 1 || l > 2048 {
+		err := PreviewImportRequestValidationError{
+			field:  "Url",
+			reason: "value length must be between 1 and 2048 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1598,44 +7225,33 @@ func (m *SearchContentsResponse) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetContents() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchContentsResponseValidationError{
-						field:  fmt.Sprintf("Contents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchContentsResponseValidationError{
-						field:  fmt.Sprintf("Contents[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SearchContentsResponseValidationError{
-					field:  fmt.Sprintf("Contents[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = PreviewImportRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := PreviewImportRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetNextPageToken()) > 1024 {
-		err := SearchContentsResponseValidationError{
-			field:  "NextPageToken",
-			reason: "value length must be at most 1024 runes",
+	// no validation rules for AllItems
+
+	if val := m.GetMaxItems(); val < 0 || val > 1000 {
+		err := PreviewImportRequestValidationError{
+			field:  "MaxItems",
+			reason: "value must be inside range [0, 1000]",
 		}
 		if !all {
 			return err
@@ -1644,19 +7260,19 @@ func (m *SearchContentsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SearchContentsResponseMultiError(errors)
+		return PreviewImportRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchContentsResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchContentsResponse.ValidateAll() if the designated
+// PreviewImportRequestMultiError is an error wrapping multiple validation
+// errors returned by PreviewImportRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SearchContentsResponseMultiError []error
+type PreviewImportRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchContentsResponseMultiError) Error() string {
+func (m PreviewImportRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1665,11 +7281,11 @@ func (m SearchContentsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchContentsResponseMultiError) AllErrors() []error { return m }
+func (m PreviewImportRequestMultiError) AllErrors() []error { return m }
 
-// SearchContentsResponseValidationError is the validation error returned by
-// SearchContentsResponse.Validate if the designated constraints aren't met.
-type SearchContentsResponseValidationError struct {
+// PreviewImportRequestValidationError is the validation error returned by
+// PreviewImportRequest.Validate if the designated constraints aren't met.
+type PreviewImportRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1677,24 +7293,24 @@ type SearchContentsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchContentsResponseValidationError) Field() string { return e.field }
+func (e PreviewImportRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchContentsResponseValidationError) Reason() string { return e.reason }
+func (e PreviewImportRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchContentsResponseValidationError) Cause() error { return e.cause }
+func (e PreviewImportRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchContentsResponseValidationError) Key() bool { return e.key }
+func (e PreviewImportRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchContentsResponseValidationError) ErrorName() string {
-	return "SearchContentsResponseValidationError"
+func (e PreviewImportRequestValidationError) ErrorName() string {
+	return "PreviewImportRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchContentsResponseValidationError) Error() string {
+func (e PreviewImportRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1706,14 +7322,14 @@ func (e SearchContentsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchContentsResponse.%s: %s%s",
+		"invalid %sPreviewImportRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchContentsResponseValidationError{}
+var _ error = PreviewImportRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1721,76 +7337,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchContentsResponseValidationError{}
+} = PreviewImportRequestValidationError{}
 
-// Validate checks the field values on ImportRequest with the rules defined in
+// Validate checks the field values on ImportPreview with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ImportRequest) Validate() error {
+func (m *ImportPreview) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ImportRequest with the rules defined
+// ValidateAll checks the field values on ImportPreview with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ImportRequestMultiError, or
+// result is a list of violation errors wrapped in ImportPreviewMultiError, or
 // nil if none found.
-func (m *ImportRequest) ValidateAll() error {
+func (m *ImportPreview) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ImportRequest) validate(all bool) error {
+func (m *ImportPreview) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetUrl()); l < 1 || l > 2048 {
-		err := ImportRequestValidationError{
-			field:  "Url",
-			reason: "value length must be between 1 and 2048 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Title
 
-	if uri, err := url.Parse(m.GetUrl()); err != nil {
-		err = ImportRequestValidationError{
-			field:  "Url",
-			reason: "value must be a valid URI",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	} else if !uri.IsAbs() {
-		err := ImportRequestValidationError{
-			field:  "Url",
-			reason: "value must be absolute",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Description
+
+	// no validation rules for Language
+
+	// no validation rules for DurationSeconds
+
+	// no validation rules for PublishedAt
+
+	// no validation rules for ContentType
+
+	// no validation rules for Url
+
+	// no validation rules for PlatformName
+
+	// no validation rules for Author
+
+	// no validation rules for ThumbnailUrl
 
 	if len(errors) > 0 {
-		return ImportRequestMultiError(errors)
+		return ImportPreviewMultiError(errors)
 	}
 
 	return nil
 }
 
-// ImportRequestMultiError is an error wrapping multiple validation errors
-// returned by ImportRequest.ValidateAll() if the designated constraints
+// ImportPreviewMultiError is an error wrapping multiple validation errors
+// returned by ImportPreview.ValidateAll() if the designated constraints
 // aren't met.
-type ImportRequestMultiError []error
+type ImportPreviewMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ImportRequestMultiError) Error() string {
+func (m ImportPreviewMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1799,11 +7403,11 @@ func (m ImportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ImportRequestMultiError) AllErrors() []error { return m }
+func (m ImportPreviewMultiError) AllErrors() []error { return m }
 
-// ImportRequestValidationError is the validation error returned by
-// ImportRequest.Validate if the designated constraints aren't met.
-type ImportRequestValidationError struct {
+// ImportPreviewValidationError is the validation error returned by
+// ImportPreview.Validate if the designated constraints aren't met.
+type ImportPreviewValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1811,22 +7415,22 @@ type ImportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ImportRequestValidationError) Field() string { return e.field }
+func (e ImportPreviewValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ImportRequestValidationError) Reason() string { return e.reason }
+func (e ImportPreviewValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ImportRequestValidationError) Cause() error { return e.cause }
+func (e ImportPreviewValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ImportRequestValidationError) Key() bool { return e.key }
+func (e ImportPreviewValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ImportRequestValidationError) ErrorName() string { return "ImportRequestValidationError" }
+func (e ImportPreviewValidationError) ErrorName() string { return "ImportPreviewValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ImportRequestValidationError) Error() string {
+func (e ImportPreviewValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1838,14 +7442,14 @@ func (e ImportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sImportRequest.%s: %s%s",
+		"invalid %sImportPreview.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ImportRequestValidationError{}
+var _ error = ImportPreviewValidationError{}
 
 var _ interface {
 	Field() string
@@ -1853,84 +7457,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ImportRequestValidationError{}
+} = ImportPreviewValidationError{}
 
-// Validate checks the field values on ImportResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ImportResponse) Validate() error {
+// Validate checks the field values on PreviewImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewImportResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ImportResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ImportResponseMultiError,
-// or nil if none found.
-func (m *ImportResponse) ValidateAll() error {
+// ValidateAll checks the field values on PreviewImportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewImportResponseMultiError, or nil if none found.
+func (m *PreviewImportResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ImportResponse) validate(all bool) error {
+func (m *PreviewImportResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetContent() == nil {
-		err := ImportResponseValidationError{
-			field:  "Content",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Provider
 
-	if all {
-		switch v := interface{}(m.GetContent()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ImportResponseValidationError{
-					field:  "Content",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewImportResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewImportResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ImportResponseValidationError{
-					field:  "Content",
+				return PreviewImportResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ImportResponseValidationError{
-				field:  "Content",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalItems
+
 	if len(errors) > 0 {
-		return ImportResponseMultiError(errors)
+		return PreviewImportResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ImportResponseMultiError is an error wrapping multiple validation errors
-// returned by ImportResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ImportResponseMultiError []error
+// PreviewImportResponseMultiError is an error wrapping multiple validation
+// errors returned by PreviewImportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewImportResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ImportResponseMultiError) Error() string {
+func (m PreviewImportResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1939,11 +7541,11 @@ func (m ImportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ImportResponseMultiError) AllErrors() []error { return m }
+func (m PreviewImportResponseMultiError) AllErrors() []error { return m }
 
-// ImportResponseValidationError is the validation error returned by
-// ImportResponse.Validate if the designated constraints aren't met.
-type ImportResponseValidationError struct {
+// PreviewImportResponseValidationError is the validation error returned by
+// PreviewImportResponse.Validate if the designated constraints aren't met.
+type PreviewImportResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1951,22 +7553,24 @@ type ImportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ImportResponseValidationError) Field() string { return e.field }
+func (e PreviewImportResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ImportResponseValidationError) Reason() string { return e.reason }
+func (e PreviewImportResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ImportResponseValidationError) Cause() error { return e.cause }
+func (e PreviewImportResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ImportResponseValidationError) Key() bool { return e.key }
+func (e PreviewImportResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ImportResponseValidationError) ErrorName() string { return "ImportResponseValidationError" }
+func (e PreviewImportResponseValidationError) ErrorName() string {
+	return "PreviewImportResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ImportResponseValidationError) Error() string {
+func (e PreviewImportResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1978,14 +7582,14 @@ func (e ImportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sImportResponse.%s: %s%s",
+		"invalid %sPreviewImportResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ImportResponseValidationError{}
+var _ error = PreviewImportResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1993,4 +7597,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ImportResponseValidationError{}
+} = PreviewImportResponseValidationError{}
@@ -29,33 +29,68 @@ var File_discovery_proto protoreflect.FileDescriptor
 const file_discovery_proto_rawDesc = "" +
 	"\n" +
 	"\x0fdiscovery.proto\x12\n" +
-	"mawjood.v1\x1a\x0emessages.proto2\x80\x02\n" +
+	"mawjood.v1\x1a\x0emessages.proto2\xd4\x06\n" +
 	"\x10DiscoveryService\x12W\n" +
 	"\x0eSearchContents\x12!.mawjood.v1.SearchContentsRequest\x1a\".mawjood.v1.SearchContentsResponse\x12Q\n" +
 	"\fListContents\x12\x1f.mawjood.v1.ListContentsRequest\x1a .mawjood.v1.ListContentsResponse\x12@\n" +
 	"\n" +
-	"GetContent\x12\x1d.mawjood.v1.GetContentRequest\x1a\x13.mawjood.v1.ContentB\"Z mawjood/gen/go/packages/proto/v1b\x06proto3"
+	"GetContent\x12\x1d.mawjood.v1.GetContentRequest\x1a\x13.mawjood.v1.Content\x12]\n" +
+	"\x10BatchGetContents\x12#.mawjood.v1.BatchGetContentsRequest\x1a$.mawjood.v1.BatchGetContentsResponse\x12B\n" +
+	"\aSuggest\x12\x1a.mawjood.v1.SuggestRequest\x1a\x1b.mawjood.v1.SuggestResponse\x12c\n" +
+	"\x12GetRelatedContents\x12%.mawjood.v1.GetRelatedContentsRequest\x1a&.mawjood.v1.GetRelatedContentsResponse\x12E\n" +
+	"\bListTags\x12\x1b.mawjood.v1.ListTagsRequest\x1a\x1c.mawjood.v1.ListTagsResponse\x12`\n" +
+	"\x11ListContentsByTag\x12$.mawjood.v1.ListContentsByTagRequest\x1a%.mawjood.v1.ListContentsByTagResponse\x12N\n" +
+	"\vRecordEvent\x12\x1e.mawjood.v1.RecordEventRequest\x1a\x1f.mawjood.v1.RecordEventResponse\x12Q\n" +
+	"\fListTrending\x12\x1f.mawjood.v1.ListTrendingRequest\x1a .mawjood.v1.ListTrendingResponseB\"Z mawjood/gen/go/packages/proto/v1b\x06proto3"
 
 var file_discovery_proto_goTypes = []any{
-	(*SearchContentsRequest)(nil),  // 0: mawjood.v1.SearchContentsRequest
-	(*ListContentsRequest)(nil),    // 1: mawjood.v1.ListContentsRequest
-	(*GetContentRequest)(nil),      // 2: mawjood.v1.GetContentRequest
-	(*SearchContentsResponse)(nil), // 3: mawjood.v1.SearchContentsResponse
-	(*ListContentsResponse)(nil),   // 4: mawjood.v1.ListContentsResponse
-	(*Content)(nil),                // 5: mawjood.v1.Content
+	(*SearchContentsRequest)(nil),      // 0: mawjood.v1.SearchContentsRequest
+	(*ListContentsRequest)(nil),        // 1: mawjood.v1.ListContentsRequest
+	(*GetContentRequest)(nil),          // 2: mawjood.v1.GetContentRequest
+	(*BatchGetContentsRequest)(nil),    // 3: mawjood.v1.BatchGetContentsRequest
+	(*SuggestRequest)(nil),             // 4: mawjood.v1.SuggestRequest
+	(*GetRelatedContentsRequest)(nil),  // 5: mawjood.v1.GetRelatedContentsRequest
+	(*ListTagsRequest)(nil),            // 6: mawjood.v1.ListTagsRequest
+	(*ListContentsByTagRequest)(nil),   // 7: mawjood.v1.ListContentsByTagRequest
+	(*RecordEventRequest)(nil),         // 8: mawjood.v1.RecordEventRequest
+	(*ListTrendingRequest)(nil),        // 9: mawjood.v1.ListTrendingRequest
+	(*SearchContentsResponse)(nil),     // 10: mawjood.v1.SearchContentsResponse
+	(*ListContentsResponse)(nil),       // 11: mawjood.v1.ListContentsResponse
+	(*Content)(nil),                    // 12: mawjood.v1.Content
+	(*BatchGetContentsResponse)(nil),   // 13: mawjood.v1.BatchGetContentsResponse
+	(*SuggestResponse)(nil),            // 14: mawjood.v1.SuggestResponse
+	(*GetRelatedContentsResponse)(nil), // 15: mawjood.v1.GetRelatedContentsResponse
+	(*ListTagsResponse)(nil),           // 16: mawjood.v1.ListTagsResponse
+	(*ListContentsByTagResponse)(nil),  // 17: mawjood.v1.ListContentsByTagResponse
+	(*RecordEventResponse)(nil),        // 18: mawjood.v1.RecordEventResponse
+	(*ListTrendingResponse)(nil),       // 19: mawjood.v1.ListTrendingResponse
 }
 var file_discovery_proto_depIdxs = []int32{
-	0, // 0: mawjood.v1.DiscoveryService.SearchContents:input_type -> mawjood.v1.SearchContentsRequest
-	1, // 1: mawjood.v1.DiscoveryService.ListContents:input_type -> mawjood.v1.ListContentsRequest
-	2, // 2: mawjood.v1.DiscoveryService.GetContent:input_type -> mawjood.v1.GetContentRequest
-	3, // 3: mawjood.v1.DiscoveryService.SearchContents:output_type -> mawjood.v1.SearchContentsResponse
-	4, // 4: mawjood.v1.DiscoveryService.ListContents:output_type -> mawjood.v1.ListContentsResponse
-	5, // 5: mawjood.v1.DiscoveryService.GetContent:output_type -> mawjood.v1.Content
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: mawjood.v1.DiscoveryService.SearchContents:input_type -> mawjood.v1.SearchContentsRequest
+	1,  // 1: mawjood.v1.DiscoveryService.ListContents:input_type -> mawjood.v1.ListContentsRequest
+	2,  // 2: mawjood.v1.DiscoveryService.GetContent:input_type -> mawjood.v1.GetContentRequest
+	3,  // 3: mawjood.v1.DiscoveryService.BatchGetContents:input_type -> mawjood.v1.BatchGetContentsRequest
+	4,  // 4: mawjood.v1.DiscoveryService.Suggest:input_type -> mawjood.v1.SuggestRequest
+	5,  // 5: mawjood.v1.DiscoveryService.GetRelatedContents:input_type -> mawjood.v1.GetRelatedContentsRequest
+	6,  // 6: mawjood.v1.DiscoveryService.ListTags:input_type -> mawjood.v1.ListTagsRequest
+	7,  // 7: mawjood.v1.DiscoveryService.ListContentsByTag:input_type -> mawjood.v1.ListContentsByTagRequest
+	8,  // 8: mawjood.v1.DiscoveryService.RecordEvent:input_type -> mawjood.v1.RecordEventRequest
+	9,  // 9: mawjood.v1.DiscoveryService.ListTrending:input_type -> mawjood.v1.ListTrendingRequest
+	10, // 10: mawjood.v1.DiscoveryService.SearchContents:output_type -> mawjood.v1.SearchContentsResponse
+	11, // 11: mawjood.v1.DiscoveryService.ListContents:output_type -> mawjood.v1.ListContentsResponse
+	12, // 12: mawjood.v1.DiscoveryService.GetContent:output_type -> mawjood.v1.Content
+	13, // 13: mawjood.v1.DiscoveryService.BatchGetContents:output_type -> mawjood.v1.BatchGetContentsResponse
+	14, // 14: mawjood.v1.DiscoveryService.Suggest:output_type -> mawjood.v1.SuggestResponse
+	15, // 15: mawjood.v1.DiscoveryService.GetRelatedContents:output_type -> mawjood.v1.GetRelatedContentsResponse
+	16, // 16: mawjood.v1.DiscoveryService.ListTags:output_type -> mawjood.v1.ListTagsResponse
+	17, // 17: mawjood.v1.DiscoveryService.ListContentsByTag:output_type -> mawjood.v1.ListContentsByTagResponse
+	18, // 18: mawjood.v1.DiscoveryService.RecordEvent:output_type -> mawjood.v1.RecordEventResponse
+	19, // 19: mawjood.v1.DiscoveryService.ListTrending:output_type -> mawjood.v1.ListTrendingResponse
+	10, // [10:20] is the sub-list for method output_type
+	0,  // [0:10] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_discovery_proto_init() }
@@ -97,6 +132,13 @@ type DiscoveryServiceClient interface {
 	SearchContents(ctx context.Context, in *SearchContentsRequest, opts ...grpc.CallOption) (*SearchContentsResponse, error)
 	ListContents(ctx context.Context, in *ListContentsRequest, opts ...grpc.CallOption) (*ListContentsResponse, error)
 	GetContent(ctx context.Context, in *GetContentRequest, opts ...grpc.CallOption) (*Content, error)
+	BatchGetContents(ctx context.Context, in *BatchGetContentsRequest, opts ...grpc.CallOption) (*BatchGetContentsResponse, error)
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	GetRelatedContents(ctx context.Context, in *GetRelatedContentsRequest, opts ...grpc.CallOption) (*GetRelatedContentsResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	ListContentsByTag(ctx context.Context, in *ListContentsByTagRequest, opts ...grpc.CallOption) (*ListContentsByTagResponse, error)
+	RecordEvent(ctx context.Context, in *RecordEventRequest, opts ...grpc.CallOption) (*RecordEventResponse, error)
+	ListTrending(ctx context.Context, in *ListTrendingRequest, opts ...grpc.CallOption) (*ListTrendingResponse, error)
 }
 
 type discoveryServiceClient struct {
@@ -134,11 +176,81 @@ func (c *discoveryServiceClient) GetContent(ctx context.Context, in *GetContentR
 	return out, nil
 }
 
+func (c *discoveryServiceClient) BatchGetContents(ctx context.Context, in *BatchGetContentsRequest, opts ...grpc.CallOption) (*BatchGetContentsResponse, error) {
+	out := new(BatchGetContentsResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.DiscoveryService/BatchGetContents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.DiscoveryService/Suggest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) GetRelatedContents(ctx context.Context, in *GetRelatedContentsRequest, opts ...grpc.CallOption) (*GetRelatedContentsResponse, error) {
+	out := new(GetRelatedContentsResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.DiscoveryService/GetRelatedContents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.DiscoveryService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) ListContentsByTag(ctx context.Context, in *ListContentsByTagRequest, opts ...grpc.CallOption) (*ListContentsByTagResponse, error) {
+	out := new(ListContentsByTagResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.DiscoveryService/ListContentsByTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) RecordEvent(ctx context.Context, in *RecordEventRequest, opts ...grpc.CallOption) (*RecordEventResponse, error) {
+	out := new(RecordEventResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.DiscoveryService/RecordEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryServiceClient) ListTrending(ctx context.Context, in *ListTrendingRequest, opts ...grpc.CallOption) (*ListTrendingResponse, error) {
+	out := new(ListTrendingResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.DiscoveryService/ListTrending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscoveryServiceServer is the server API for DiscoveryService service.
 type DiscoveryServiceServer interface {
 	SearchContents(context.Context, *SearchContentsRequest) (*SearchContentsResponse, error)
 	ListContents(context.Context, *ListContentsRequest) (*ListContentsResponse, error)
 	GetContent(context.Context, *GetContentRequest) (*Content, error)
+	BatchGetContents(context.Context, *BatchGetContentsRequest) (*BatchGetContentsResponse, error)
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
+	GetRelatedContents(context.Context, *GetRelatedContentsRequest) (*GetRelatedContentsResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	ListContentsByTag(context.Context, *ListContentsByTagRequest) (*ListContentsByTagResponse, error)
+	RecordEvent(context.Context, *RecordEventRequest) (*RecordEventResponse, error)
+	ListTrending(context.Context, *ListTrendingRequest) (*ListTrendingResponse, error)
 }
 
 // UnimplementedDiscoveryServiceServer can be embedded to have forward compatible implementations.
@@ -154,6 +266,27 @@ func (*UnimplementedDiscoveryServiceServer) ListContents(context.Context, *ListC
 func (*UnimplementedDiscoveryServiceServer) GetContent(context.Context, *GetContentRequest) (*Content, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContent not implemented")
 }
+func (*UnimplementedDiscoveryServiceServer) BatchGetContents(context.Context, *BatchGetContentsRequest) (*BatchGetContentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetContents not implemented")
+}
+func (*UnimplementedDiscoveryServiceServer) Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (*UnimplementedDiscoveryServiceServer) GetRelatedContents(context.Context, *GetRelatedContentsRequest) (*GetRelatedContentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedContents not implemented")
+}
+func (*UnimplementedDiscoveryServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (*UnimplementedDiscoveryServiceServer) ListContentsByTag(context.Context, *ListContentsByTagRequest) (*ListContentsByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContentsByTag not implemented")
+}
+func (*UnimplementedDiscoveryServiceServer) RecordEvent(context.Context, *RecordEventRequest) (*RecordEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordEvent not implemented")
+}
+func (*UnimplementedDiscoveryServiceServer) ListTrending(context.Context, *ListTrendingRequest) (*ListTrendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrending not implemented")
+}
 
 func RegisterDiscoveryServiceServer(s *grpc.Server, srv DiscoveryServiceServer) {
 	s.RegisterService(&_DiscoveryService_serviceDesc, srv)
@@ -213,6 +346,132 @@ func _DiscoveryService_GetContent_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscoveryService_BatchGetContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetContentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).BatchGetContents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.DiscoveryService/BatchGetContents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).BatchGetContents(ctx, req.(*BatchGetContentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.DiscoveryService/Suggest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_GetRelatedContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedContentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).GetRelatedContents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.DiscoveryService/GetRelatedContents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).GetRelatedContents(ctx, req.(*GetRelatedContentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.DiscoveryService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_ListContentsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContentsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).ListContentsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.DiscoveryService/ListContentsByTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).ListContentsByTag(ctx, req.(*ListContentsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_RecordEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).RecordEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.DiscoveryService/RecordEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).RecordEvent(ctx, req.(*RecordEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryService_ListTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryServiceServer).ListTrending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.DiscoveryService/ListTrending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryServiceServer).ListTrending(ctx, req.(*ListTrendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DiscoveryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mawjood.v1.DiscoveryService",
 	HandlerType: (*DiscoveryServiceServer)(nil),
@@ -229,6 +488,34 @@ var _DiscoveryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetContent",
 			Handler:    _DiscoveryService_GetContent_Handler,
 		},
+		{
+			MethodName: "BatchGetContents",
+			Handler:    _DiscoveryService_BatchGetContents_Handler,
+		},
+		{
+			MethodName: "Suggest",
+			Handler:    _DiscoveryService_Suggest_Handler,
+		},
+		{
+			MethodName: "GetRelatedContents",
+			Handler:    _DiscoveryService_GetRelatedContents_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _DiscoveryService_ListTags_Handler,
+		},
+		{
+			MethodName: "ListContentsByTag",
+			Handler:    _DiscoveryService_ListContentsByTag_Handler,
+		},
+		{
+			MethodName: "RecordEvent",
+			Handler:    _DiscoveryService_RecordEvent_Handler,
+		},
+		{
+			MethodName: "ListTrending",
+			Handler:    _DiscoveryService_ListTrending_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "discovery.proto",
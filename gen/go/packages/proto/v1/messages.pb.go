@@ -71,6 +71,310 @@ func (ContentType) EnumDescriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{0}
 }
 
+type SuggestionType int32
+
+const (
+	SuggestionType_SUGGESTION_TYPE_UNSPECIFIED SuggestionType = 0
+	SuggestionType_SUGGESTION_TYPE_TITLE       SuggestionType = 1
+	SuggestionType_SUGGESTION_TYPE_TAG         SuggestionType = 2
+	SuggestionType_SUGGESTION_TYPE_PLATFORM    SuggestionType = 3
+)
+
+// Enum value maps for SuggestionType.
+var (
+	SuggestionType_name = map[int32]string{
+		0: "SUGGESTION_TYPE_UNSPECIFIED",
+		1: "SUGGESTION_TYPE_TITLE",
+		2: "SUGGESTION_TYPE_TAG",
+		3: "SUGGESTION_TYPE_PLATFORM",
+	}
+	SuggestionType_value = map[string]int32{
+		"SUGGESTION_TYPE_UNSPECIFIED": 0,
+		"SUGGESTION_TYPE_TITLE":       1,
+		"SUGGESTION_TYPE_TAG":         2,
+		"SUGGESTION_TYPE_PLATFORM":    3,
+	}
+)
+
+func (x SuggestionType) Enum() *SuggestionType {
+	p := new(SuggestionType)
+	*p = x
+	return p
+}
+
+func (x SuggestionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuggestionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (SuggestionType) Type() protoreflect.EnumType {
+	return &file_messages_proto_enumTypes[1]
+}
+
+func (x SuggestionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuggestionType.Descriptor instead.
+func (SuggestionType) EnumDescriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{1}
+}
+
+type SuggestOrder int32
+
+const (
+	// Most used tags and platforms first, then the most recent.
+	SuggestOrder_SUGGEST_ORDER_POPULARITY SuggestOrder = 0
+	// Most recently published first.
+	SuggestOrder_SUGGEST_ORDER_RECENCY SuggestOrder = 1
+)
+
+// Enum value maps for SuggestOrder.
+var (
+	SuggestOrder_name = map[int32]string{
+		0: "SUGGEST_ORDER_POPULARITY",
+		1: "SUGGEST_ORDER_RECENCY",
+	}
+	SuggestOrder_value = map[string]int32{
+		"SUGGEST_ORDER_POPULARITY": 0,
+		"SUGGEST_ORDER_RECENCY":    1,
+	}
+)
+
+func (x SuggestOrder) Enum() *SuggestOrder {
+	p := new(SuggestOrder)
+	*p = x
+	return p
+}
+
+func (x SuggestOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SuggestOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (SuggestOrder) Type() protoreflect.EnumType {
+	return &file_messages_proto_enumTypes[2]
+}
+
+func (x SuggestOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SuggestOrder.Descriptor instead.
+func (SuggestOrder) EnumDescriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{2}
+}
+
+type TagOrder int32
+
+const (
+	// Most used tags first, then by name.
+	TagOrder_TAG_ORDER_CONTENT_COUNT TagOrder = 0
+	// Alphabetical by name.
+	TagOrder_TAG_ORDER_NAME TagOrder = 1
+)
+
+// Enum value maps for TagOrder.
+var (
+	TagOrder_name = map[int32]string{
+		0: "TAG_ORDER_CONTENT_COUNT",
+		1: "TAG_ORDER_NAME",
+	}
+	TagOrder_value = map[string]int32{
+		"TAG_ORDER_CONTENT_COUNT": 0,
+		"TAG_ORDER_NAME":          1,
+	}
+)
+
+func (x TagOrder) Enum() *TagOrder {
+	p := new(TagOrder)
+	*p = x
+	return p
+}
+
+func (x TagOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_proto_enumTypes[3].Descriptor()
+}
+
+func (TagOrder) Type() protoreflect.EnumType {
+	return &file_messages_proto_enumTypes[3]
+}
+
+func (x TagOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagOrder.Descriptor instead.
+func (TagOrder) EnumDescriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{3}
+}
+
+type EventType int32
+
+const (
+	EventType_EVENT_TYPE_UNSPECIFIED   EventType = 0
+	EventType_EVENT_TYPE_VIEW          EventType = 1
+	EventType_EVENT_TYPE_PLAY_START    EventType = 2
+	EventType_EVENT_TYPE_PLAY_COMPLETE EventType = 3
+	EventType_EVENT_TYPE_SHARE         EventType = 4
+)
+
+// Enum value maps for EventType.
+var (
+	EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "EVENT_TYPE_VIEW",
+		2: "EVENT_TYPE_PLAY_START",
+		3: "EVENT_TYPE_PLAY_COMPLETE",
+		4: "EVENT_TYPE_SHARE",
+	}
+	EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED":   0,
+		"EVENT_TYPE_VIEW":          1,
+		"EVENT_TYPE_PLAY_START":    2,
+		"EVENT_TYPE_PLAY_COMPLETE": 3,
+		"EVENT_TYPE_SHARE":         4,
+	}
+)
+
+func (x EventType) Enum() *EventType {
+	p := new(EventType)
+	*p = x
+	return p
+}
+
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_proto_enumTypes[4].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_messages_proto_enumTypes[4]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{4}
+}
+
+type TrendingWindow int32
+
+const (
+	TrendingWindow_TRENDING_WINDOW_24H TrendingWindow = 0
+	TrendingWindow_TRENDING_WINDOW_7D  TrendingWindow = 1
+	TrendingWindow_TRENDING_WINDOW_30D TrendingWindow = 2
+)
+
+// Enum value maps for TrendingWindow.
+var (
+	TrendingWindow_name = map[int32]string{
+		0: "TRENDING_WINDOW_24H",
+		1: "TRENDING_WINDOW_7D",
+		2: "TRENDING_WINDOW_30D",
+	}
+	TrendingWindow_value = map[string]int32{
+		"TRENDING_WINDOW_24H": 0,
+		"TRENDING_WINDOW_7D":  1,
+		"TRENDING_WINDOW_30D": 2,
+	}
+)
+
+func (x TrendingWindow) Enum() *TrendingWindow {
+	p := new(TrendingWindow)
+	*p = x
+	return p
+}
+
+func (x TrendingWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrendingWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_proto_enumTypes[5].Descriptor()
+}
+
+func (TrendingWindow) Type() protoreflect.EnumType {
+	return &file_messages_proto_enumTypes[5]
+}
+
+func (x TrendingWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrendingWindow.Descriptor instead.
+func (TrendingWindow) EnumDescriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{5}
+}
+
+type SearchReport int32
+
+const (
+	SearchReport_SEARCH_REPORT_UNSPECIFIED         SearchReport = 0
+	SearchReport_SEARCH_REPORT_TOP_QUERIES         SearchReport = 1
+	SearchReport_SEARCH_REPORT_ZERO_RESULT_QUERIES SearchReport = 2
+	SearchReport_SEARCH_REPORT_TRENDING_QUERIES    SearchReport = 3
+)
+
+// Enum value maps for SearchReport.
+var (
+	SearchReport_name = map[int32]string{
+		0: "SEARCH_REPORT_UNSPECIFIED",
+		1: "SEARCH_REPORT_TOP_QUERIES",
+		2: "SEARCH_REPORT_ZERO_RESULT_QUERIES",
+		3: "SEARCH_REPORT_TRENDING_QUERIES",
+	}
+	SearchReport_value = map[string]int32{
+		"SEARCH_REPORT_UNSPECIFIED":         0,
+		"SEARCH_REPORT_TOP_QUERIES":         1,
+		"SEARCH_REPORT_ZERO_RESULT_QUERIES": 2,
+		"SEARCH_REPORT_TRENDING_QUERIES":    3,
+	}
+)
+
+func (x SearchReport) Enum() *SearchReport {
+	p := new(SearchReport)
+	*p = x
+	return p
+}
+
+func (x SearchReport) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchReport) Descriptor() protoreflect.EnumDescriptor {
+	return file_messages_proto_enumTypes[6].Descriptor()
+}
+
+func (SearchReport) Type() protoreflect.EnumType {
+	return &file_messages_proto_enumTypes[6]
+}
+
+func (x SearchReport) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchReport.Descriptor instead.
+func (SearchReport) EnumDescriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{6}
+}
+
 type Content struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -85,8 +389,11 @@ type Content struct {
 	UpdatedAt       string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Url             string                 `protobuf:"bytes,11,opt,name=url,proto3" json:"url,omitempty"`
 	PlatformName    string                 `protobuf:"bytes,12,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Only set on CMSService write responses. Pass it as min_consistency to
+	// DiscoveryService reads to see this write.
+	ConsistencyToken string `protobuf:"bytes,13,opt,name=consistency_token,json=consistencyToken,proto3" json:"consistency_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Content) Reset() {
@@ -203,6 +510,13 @@ func (x *Content) GetPlatformName() string {
 	return ""
 }
 
+func (x *Content) GetConsistencyToken() string {
+	if x != nil {
+		return x.ConsistencyToken
+	}
+	return ""
+}
+
 type CreateContentRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -312,10 +626,13 @@ func (x *CreateContentRequest) GetPlatformName() string {
 }
 
 type GetContentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Consistency token returned by a CMSService write. The read then reflects
+	// that write even if it would otherwise be served from stale data.
+	MinConsistency string `protobuf:"bytes,2,opt,name=min_consistency,json=minConsistency,proto3" json:"min_consistency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetContentRequest) Reset() {
@@ -355,36 +672,37 @@ func (x *GetContentRequest) GetId() string {
 	return ""
 }
 
-type UpdateContentRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Tags            []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Language        string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
-	DurationSeconds int32                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	PublishedAt     string                 `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	ContentType     ContentType            `protobuf:"varint,8,opt,name=content_type,json=contentType,proto3,enum=mawjood.v1.ContentType" json:"content_type,omitempty"`
-	Url             string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
-	PlatformName    string                 `protobuf:"bytes,10,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetContentRequest) GetMinConsistency() string {
+	if x != nil {
+		return x.MinConsistency
+	}
+	return ""
 }
 
-func (x *UpdateContentRequest) Reset() {
-	*x = UpdateContentRequest{}
+type BatchGetContentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Duplicate ids are allowed and get one result each.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// See GetContentRequest.min_consistency.
+	MinConsistency string `protobuf:"bytes,2,opt,name=min_consistency,json=minConsistency,proto3" json:"min_consistency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchGetContentsRequest) Reset() {
+	*x = BatchGetContentsRequest{}
 	mi := &file_messages_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateContentRequest) String() string {
+func (x *BatchGetContentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateContentRequest) ProtoMessage() {}
+func (*BatchGetContentsRequest) ProtoMessage() {}
 
-func (x *UpdateContentRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchGetContentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_messages_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -396,103 +714,2430 @@ func (x *UpdateContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateContentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateContentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchGetContentsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetContentsRequest) Descriptor() ([]byte, []int) {
 	return file_messages_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateContentRequest) GetId() string {
+func (x *BatchGetContentsRequest) GetIds() []string {
 	if x != nil {
-		return x.Id
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateContentRequest) GetTitle() string {
+func (x *BatchGetContentsRequest) GetMinConsistency() string {
 	if x != nil {
-		return x.Title
+		return x.MinConsistency
 	}
 	return ""
 }
 
-func (x *UpdateContentRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// BatchGetContentsResult is the outcome for one requested id.
+type BatchGetContentsResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unset when not_found is true.
+	Content *Content `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// True when no content with the id exists or it was deleted.
+	NotFound      bool `protobuf:"varint,3,opt,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateContentRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *BatchGetContentsResult) Reset() {
+	*x = BatchGetContentsResult{}
+	mi := &file_messages_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateContentRequest) GetLanguage() string {
-	if x != nil {
-		return x.Language
-	}
-	return ""
+func (x *BatchGetContentsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateContentRequest) GetDurationSeconds() int32 {
+func (*BatchGetContentsResult) ProtoMessage() {}
+
+func (x *BatchGetContentsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[4]
 	if x != nil {
-		return x.DurationSeconds
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateContentRequest) GetPublishedAt() string {
-	if x != nil {
-		return x.PublishedAt
-	}
-	return ""
+// Deprecated: Use BatchGetContentsResult.ProtoReflect.Descriptor instead.
+func (*BatchGetContentsResult) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdateContentRequest) GetContentType() ContentType {
+func (x *BatchGetContentsResult) GetId() string {
 	if x != nil {
-		return x.ContentType
+		return x.Id
 	}
-	return ContentType_CONTENT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *UpdateContentRequest) GetUrl() string {
+func (x *BatchGetContentsResult) GetContent() *Content {
 	if x != nil {
-		return x.Url
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateContentRequest) GetPlatformName() string {
+func (x *BatchGetContentsResult) GetNotFound() bool {
 	if x != nil {
-		return x.PlatformName
+		return x.NotFound
 	}
-	return ""
+	return false
 }
 
-type DeleteContentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type BatchGetContentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One result per requested id, in request order.
+	Results       []*BatchGetContentsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteContentRequest) Reset() {
-	*x = DeleteContentRequest{}
-	mi := &file_messages_proto_msgTypes[4]
+func (x *BatchGetContentsResponse) Reset() {
+	*x = BatchGetContentsResponse{}
+	mi := &file_messages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetContentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetContentsResponse) ProtoMessage() {}
+
+func (x *BatchGetContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetContentsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetContentsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetContentsResponse) GetResults() []*BatchGetContentsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UpdateContentRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Tags            []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Language        string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	PublishedAt     string                 `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	ContentType     ContentType            `protobuf:"varint,8,opt,name=content_type,json=contentType,proto3,enum=mawjood.v1.ContentType" json:"content_type,omitempty"`
+	Url             string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
+	PlatformName    string                 `protobuf:"bytes,10,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateContentRequest) Reset() {
+	*x = UpdateContentRequest{}
+	mi := &file_messages_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContentRequest) ProtoMessage() {}
+
+func (x *UpdateContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContentRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateContentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateContentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateContentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateContentRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateContentRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *UpdateContentRequest) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *UpdateContentRequest) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *UpdateContentRequest) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateContentRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateContentRequest) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+type DeleteContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContentRequest) Reset() {
+	*x = DeleteContentRequest{}
+	mi := &file_messages_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContentRequest) ProtoMessage() {}
+
+func (x *DeleteContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContentRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteContentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteContentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pass as min_consistency to DiscoveryService reads to see the deletion.
+	ConsistencyToken string `protobuf:"bytes,1,opt,name=consistency_token,json=consistencyToken,proto3" json:"consistency_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteContentResponse) Reset() {
+	*x = DeleteContentResponse{}
+	mi := &file_messages_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContentResponse) ProtoMessage() {}
+
+func (x *DeleteContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContentResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteContentResponse) GetConsistencyToken() string {
+	if x != nil {
+		return x.ConsistencyToken
+	}
+	return ""
+}
+
+type ListContentsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Comma separated list of fields with an optional "asc" or "desc" suffix,
+	// e.g. "published_at desc". Defaults to "created_at desc".
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// AIP-160 style filter expression,
+	// e.g. content_type = "podcast" AND language = "ar" AND duration_seconds < 1800
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// See GetContentRequest.min_consistency. Ignored by CMSService, which
+	// always reads the latest data.
+	MinConsistency string `protobuf:"bytes,5,opt,name=min_consistency,json=minConsistency,proto3" json:"min_consistency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListContentsRequest) Reset() {
+	*x = ListContentsRequest{}
+	mi := &file_messages_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentsRequest) ProtoMessage() {}
+
+func (x *ListContentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentsRequest.ProtoReflect.Descriptor instead.
+func (*ListContentsRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListContentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListContentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListContentsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListContentsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListContentsRequest) GetMinConsistency() string {
+	if x != nil {
+		return x.MinConsistency
+	}
+	return ""
+}
+
+type ListContentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      []*Content             `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContentsResponse) Reset() {
+	*x = ListContentsResponse{}
+	mi := &file_messages_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentsResponse) ProtoMessage() {}
+
+func (x *ListContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentsResponse.ProtoReflect.Descriptor instead.
+func (*ListContentsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListContentsResponse) GetContents() []*Content {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *ListContentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchFilters struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ContentTypes       []ContentType          `protobuf:"varint,1,rep,packed,name=content_types,json=contentTypes,proto3,enum=mawjood.v1.ContentType" json:"content_types,omitempty"`
+	Languages          []string               `protobuf:"bytes,2,rep,name=languages,proto3" json:"languages,omitempty"`
+	Tags               []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	PlatformNames      []string               `protobuf:"bytes,4,rep,name=platform_names,json=platformNames,proto3" json:"platform_names,omitempty"`
+	MinDurationSeconds int32                  `protobuf:"varint,5,opt,name=min_duration_seconds,json=minDurationSeconds,proto3" json:"min_duration_seconds,omitempty"`
+	MaxDurationSeconds int32                  `protobuf:"varint,6,opt,name=max_duration_seconds,json=maxDurationSeconds,proto3" json:"max_duration_seconds,omitempty"`
+	PublishedAfter     string                 `protobuf:"bytes,7,opt,name=published_after,json=publishedAfter,proto3" json:"published_after,omitempty"`
+	PublishedBefore    string                 `protobuf:"bytes,8,opt,name=published_before,json=publishedBefore,proto3" json:"published_before,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SearchFilters) Reset() {
+	*x = SearchFilters{}
+	mi := &file_messages_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFilters) ProtoMessage() {}
+
+func (x *SearchFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFilters.ProtoReflect.Descriptor instead.
+func (*SearchFilters) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchFilters) GetContentTypes() []ContentType {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *SearchFilters) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *SearchFilters) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchFilters) GetPlatformNames() []string {
+	if x != nil {
+		return x.PlatformNames
+	}
+	return nil
+}
+
+func (x *SearchFilters) GetMinDurationSeconds() int32 {
+	if x != nil {
+		return x.MinDurationSeconds
+	}
+	return 0
+}
+
+func (x *SearchFilters) GetMaxDurationSeconds() int32 {
+	if x != nil {
+		return x.MaxDurationSeconds
+	}
+	return 0
+}
+
+func (x *SearchFilters) GetPublishedAfter() string {
+	if x != nil {
+		return x.PublishedAfter
+	}
+	return ""
+}
+
+func (x *SearchFilters) GetPublishedBefore() string {
+	if x != nil {
+		return x.PublishedBefore
+	}
+	return ""
+}
+
+type SearchContentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filters       *SearchFilters         `protobuf:"bytes,4,opt,name=filters,proto3" json:"filters,omitempty"`
+	IncludeFacets bool                   `protobuf:"varint,5,opt,name=include_facets,json=includeFacets,proto3" json:"include_facets,omitempty"`
+	// See GetContentRequest.min_consistency.
+	MinConsistency string `protobuf:"bytes,6,opt,name=min_consistency,json=minConsistency,proto3" json:"min_consistency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchContentsRequest) Reset() {
+	*x = SearchContentsRequest{}
+	mi := &file_messages_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContentsRequest) ProtoMessage() {}
+
+func (x *SearchContentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchContentsRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchContentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchContentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchContentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchContentsRequest) GetFilters() *SearchFilters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *SearchContentsRequest) GetIncludeFacets() bool {
+	if x != nil {
+		return x.IncludeFacets
+	}
+	return false
+}
+
+func (x *SearchContentsRequest) GetMinConsistency() string {
+	if x != nil {
+		return x.MinConsistency
+	}
+	return ""
+}
+
+type FacetBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetBucket) Reset() {
+	*x = FacetBucket{}
+	mi := &file_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetBucket) ProtoMessage() {}
+
+func (x *FacetBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetBucket.ProtoReflect.Descriptor instead.
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FacetBucket) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchFacets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentTypes  []*FacetBucket         `protobuf:"bytes,1,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	Languages     []*FacetBucket         `protobuf:"bytes,2,rep,name=languages,proto3" json:"languages,omitempty"`
+	PlatformNames []*FacetBucket         `protobuf:"bytes,3,rep,name=platform_names,json=platformNames,proto3" json:"platform_names,omitempty"`
+	Tags          []*FacetBucket         `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Durations     []*FacetBucket         `protobuf:"bytes,5,rep,name=durations,proto3" json:"durations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFacets) Reset() {
+	*x = SearchFacets{}
+	mi := &file_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacets) ProtoMessage() {}
+
+func (x *SearchFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacets.ProtoReflect.Descriptor instead.
+func (*SearchFacets) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchFacets) GetContentTypes() []*FacetBucket {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetLanguages() []*FacetBucket {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetPlatformNames() []*FacetBucket {
+	if x != nil {
+		return x.PlatformNames
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetTags() []*FacetBucket {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetDurations() []*FacetBucket {
+	if x != nil {
+		return x.Durations
+	}
+	return nil
+}
+
+// TextRange is a half-open [start, end) span counted in Unicode code points.
+type TextRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextRange) Reset() {
+	*x = TextRange{}
+	mi := &file_messages_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextRange) ProtoMessage() {}
+
+func (x *TextRange) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextRange.ProtoReflect.Descriptor instead.
+func (*TextRange) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TextRange) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TextRange) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// SearchMatch explains why a content matched a search.
+type SearchMatch struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ContentId string                 `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Score     float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// One or more of "title", "description", "platform_name" and "tags".
+	MatchedFields []string `protobuf:"bytes,3,rep,name=matched_fields,json=matchedFields,proto3" json:"matched_fields,omitempty"`
+	// HTML-escaped title with query terms wrapped in <em></em>.
+	HighlightedTitle string `protobuf:"bytes,4,opt,name=highlighted_title,json=highlightedTitle,proto3" json:"highlighted_title,omitempty"`
+	// Plain-text excerpt of the description around the first match.
+	Snippet           string       `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	SnippetHighlights []*TextRange `protobuf:"bytes,6,rep,name=snippet_highlights,json=snippetHighlights,proto3" json:"snippet_highlights,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SearchMatch) Reset() {
+	*x = SearchMatch{}
+	mi := &file_messages_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMatch) ProtoMessage() {}
+
+func (x *SearchMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMatch.ProtoReflect.Descriptor instead.
+func (*SearchMatch) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchMatch) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *SearchMatch) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchMatch) GetMatchedFields() []string {
+	if x != nil {
+		return x.MatchedFields
+	}
+	return nil
+}
+
+func (x *SearchMatch) GetHighlightedTitle() string {
+	if x != nil {
+		return x.HighlightedTitle
+	}
+	return ""
+}
+
+func (x *SearchMatch) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchMatch) GetSnippetHighlights() []*TextRange {
+	if x != nil {
+		return x.SnippetHighlights
+	}
+	return nil
+}
+
+type SearchContentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      []*Content             `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Facets        *SearchFacets          `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`
+	// One entry per content, in the same order as contents.
+	Matches       []*SearchMatch `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchContentsResponse) Reset() {
+	*x = SearchContentsResponse{}
+	mi := &file_messages_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchContentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContentsResponse) ProtoMessage() {}
+
+func (x *SearchContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchContentsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchContentsResponse) GetContents() []*Content {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *SearchContentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchContentsResponse) GetFacets() *SearchFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *SearchContentsResponse) GetMatches() []*SearchMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type SuggestRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Prefix string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit  int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Order  SuggestOrder           `protobuf:"varint,3,opt,name=order,proto3,enum=mawjood.v1.SuggestOrder" json:"order,omitempty"`
+	// See GetContentRequest.min_consistency.
+	MinConsistency string `protobuf:"bytes,4,opt,name=min_consistency,json=minConsistency,proto3" json:"min_consistency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
+	mi := &file_messages_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestRequest) ProtoMessage() {}
+
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SuggestRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SuggestRequest) GetOrder() SuggestOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SuggestOrder_SUGGEST_ORDER_POPULARITY
+}
+
+func (x *SuggestRequest) GetMinConsistency() string {
+	if x != nil {
+		return x.MinConsistency
+	}
+	return ""
+}
+
+type Suggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Type  SuggestionType         `protobuf:"varint,2,opt,name=type,proto3,enum=mawjood.v1.SuggestionType" json:"type,omitempty"`
+	// Set for title suggestions only.
+	ContentId string `protobuf:"bytes,3,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Number of contents carrying the tag or platform; 1 for titles.
+	ContentCount  int64 `protobuf:"varint,4,opt,name=content_count,json=contentCount,proto3" json:"content_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Suggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Suggestion) GetType() SuggestionType {
+	if x != nil {
+		return x.Type
+	}
+	return SuggestionType_SUGGESTION_TYPE_UNSPECIFIED
+}
+
+func (x *Suggestion) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *Suggestion) GetContentCount() int64 {
+	if x != nil {
+		return x.ContentCount
+	}
+	return 0
+}
+
+type SuggestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
+	mi := &file_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestResponse) ProtoMessage() {}
+
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SuggestResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type GetRelatedContentsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only return contents from the same platform as the source content.
+	SamePlatform bool `protobuf:"varint,4,opt,name=same_platform,json=samePlatform,proto3" json:"same_platform,omitempty"`
+	// See GetContentRequest.min_consistency.
+	MinConsistency string `protobuf:"bytes,5,opt,name=min_consistency,json=minConsistency,proto3" json:"min_consistency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetRelatedContentsRequest) Reset() {
+	*x = GetRelatedContentsRequest{}
+	mi := &file_messages_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedContentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedContentsRequest) ProtoMessage() {}
+
+func (x *GetRelatedContentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedContentsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedContentsRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetRelatedContentsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetRelatedContentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRelatedContentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetRelatedContentsRequest) GetSamePlatform() bool {
+	if x != nil {
+		return x.SamePlatform
+	}
+	return false
+}
+
+func (x *GetRelatedContentsRequest) GetMinConsistency() string {
+	if x != nil {
+		return x.MinConsistency
+	}
+	return ""
+}
+
+type GetRelatedContentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      []*Content             `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedContentsResponse) Reset() {
+	*x = GetRelatedContentsResponse{}
+	mi := &file_messages_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedContentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedContentsResponse) ProtoMessage() {}
+
+func (x *GetRelatedContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedContentsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedContentsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRelatedContentsResponse) GetContents() []*Content {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *GetRelatedContentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListTagsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Order     TagOrder               `protobuf:"varint,3,opt,name=order,proto3,enum=mawjood.v1.TagOrder" json:"order,omitempty"`
+	// Only return tags whose name starts with prefix.
+	Prefix string `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// See GetContentRequest.min_consistency.
+	MinConsistency string `protobuf:"bytes,5,opt,name=min_consistency,json=minConsistency,proto3" json:"min_consistency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_messages_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTagsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTagsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetOrder() TagOrder {
+	if x != nil {
+		return x.Order
+	}
+	return TagOrder_TAG_ORDER_CONTENT_COUNT
+}
+
+func (x *ListTagsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetMinConsistency() string {
+	if x != nil {
+		return x.MinConsistency
+	}
+	return ""
+}
+
+type Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of non-deleted contents carrying the tag.
+	ContentCount  int64 `protobuf:"varint,3,opt,name=content_count,json=contentCount,proto3" json:"content_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Tag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetContentCount() int64 {
+	if x != nil {
+		return x.ContentCount
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTagsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListContentsByTagRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Tag       string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// See GetContentRequest.min_consistency.
+	MinConsistency string `protobuf:"bytes,4,opt,name=min_consistency,json=minConsistency,proto3" json:"min_consistency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListContentsByTagRequest) Reset() {
+	*x = ListContentsByTagRequest{}
+	mi := &file_messages_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentsByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentsByTagRequest) ProtoMessage() {}
+
+func (x *ListContentsByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentsByTagRequest.ProtoReflect.Descriptor instead.
+func (*ListContentsByTagRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListContentsByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListContentsByTagRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListContentsByTagRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListContentsByTagRequest) GetMinConsistency() string {
+	if x != nil {
+		return x.MinConsistency
+	}
+	return ""
+}
+
+type ListContentsByTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      []*Content             `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContentsByTagResponse) Reset() {
+	*x = ListContentsByTagResponse{}
+	mi := &file_messages_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentsByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentsByTagResponse) ProtoMessage() {}
+
+func (x *ListContentsByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentsByTagResponse.ProtoReflect.Descriptor instead.
+func (*ListContentsByTagResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListContentsByTagResponse) GetContents() []*Content {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *ListContentsByTagResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RecordEventRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ContentId string                 `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Type      EventType              `protobuf:"varint,2,opt,name=type,proto3,enum=mawjood.v1.EventType" json:"type,omitempty"`
+	// Opaque client session identifier. Each event type is counted once per
+	// content and session.
+	SessionId string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// When the event happened. Defaults to the time it was received.
+	OccurredAt    string `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEventRequest) Reset() {
+	*x = RecordEventRequest{}
+	mi := &file_messages_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEventRequest) ProtoMessage() {}
+
+func (x *RecordEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordEventRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RecordEventRequest) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *RecordEventRequest) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *RecordEventRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RecordEventRequest) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type RecordEventResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when the session had already recorded this event for the content.
+	Duplicate     bool `protobuf:"varint,1,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEventResponse) Reset() {
+	*x = RecordEventResponse{}
+	mi := &file_messages_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEventResponse) ProtoMessage() {}
+
+func (x *RecordEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEventResponse.ProtoReflect.Descriptor instead.
+func (*RecordEventResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RecordEventResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+type ListTrendingRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Window       TrendingWindow         `protobuf:"varint,1,opt,name=window,proto3,enum=mawjood.v1.TrendingWindow" json:"window,omitempty"`
+	PageSize     int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken    string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Languages    []string               `protobuf:"bytes,4,rep,name=languages,proto3" json:"languages,omitempty"`
+	ContentTypes []ContentType          `protobuf:"varint,5,rep,packed,name=content_types,json=contentTypes,proto3,enum=mawjood.v1.ContentType" json:"content_types,omitempty"`
+	// See GetContentRequest.min_consistency.
+	MinConsistency string `protobuf:"bytes,6,opt,name=min_consistency,json=minConsistency,proto3" json:"min_consistency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTrendingRequest) Reset() {
+	*x = ListTrendingRequest{}
+	mi := &file_messages_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingRequest) ProtoMessage() {}
+
+func (x *ListTrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListTrendingRequest) GetWindow() TrendingWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TrendingWindow_TRENDING_WINDOW_24H
+}
+
+func (x *ListTrendingRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrendingRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTrendingRequest) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *ListTrendingRequest) GetContentTypes() []ContentType {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *ListTrendingRequest) GetMinConsistency() string {
+	if x != nil {
+		return x.MinConsistency
+	}
+	return ""
+}
+
+// TrendingStats explains the rank of a trending content.
+type TrendingStats struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ContentId string                 `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	// Sum of the event weights, each decayed by its age.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// Number of events in the window.
+	EventCount    int64 `protobuf:"varint,3,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingStats) Reset() {
+	*x = TrendingStats{}
+	mi := &file_messages_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingStats) ProtoMessage() {}
+
+func (x *TrendingStats) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingStats.ProtoReflect.Descriptor instead.
+func (*TrendingStats) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TrendingStats) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *TrendingStats) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TrendingStats) GetEventCount() int64 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+type ListTrendingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      []*Content             `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// One entry per content, in the same order as contents.
+	Stats         []*TrendingStats `protobuf:"bytes,3,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingResponse) Reset() {
+	*x = ListTrendingResponse{}
+	mi := &file_messages_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingResponse) ProtoMessage() {}
+
+func (x *ListTrendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListTrendingResponse) GetContents() []*Content {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *ListTrendingResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTrendingResponse) GetStats() []*TrendingStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// SearchAnalyticsRequest selects the logged searches a report covers.
+type SearchAnalyticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the range, inclusive. Defaults to 7 days before end_time.
+	StartTime string `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End of the range, exclusive. Defaults to now.
+	EndTime string `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Maximum number of queries returned. Defaults to 50.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAnalyticsRequest) Reset() {
+	*x = SearchAnalyticsRequest{}
+	mi := &file_messages_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAnalyticsRequest) ProtoMessage() {}
+
+func (x *SearchAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchAnalyticsRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *SearchAnalyticsRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *SearchAnalyticsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// QueryStats aggregates the logged searches for one normalized query.
+type QueryStats struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Query    string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Searches int64                  `protobuf:"varint,2,opt,name=searches,proto3" json:"searches,omitempty"`
+	// First-page searches that returned no results.
+	ZeroResultSearches int64   `protobuf:"varint,3,opt,name=zero_result_searches,json=zeroResultSearches,proto3" json:"zero_result_searches,omitempty"`
+	AvgResultCount     float64 `protobuf:"fixed64,4,opt,name=avg_result_count,json=avgResultCount,proto3" json:"avg_result_count,omitempty"`
+	AvgLatencyMs       float64 `protobuf:"fixed64,5,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	AvgPageDepth       float64 `protobuf:"fixed64,6,opt,name=avg_page_depth,json=avgPageDepth,proto3" json:"avg_page_depth,omitempty"`
+	// Searches in the period of the same length just before start_time. Only
+	// set by the trending report.
+	PreviousSearches int64 `protobuf:"varint,7,opt,name=previous_searches,json=previousSearches,proto3" json:"previous_searches,omitempty"`
+	// (searches - previous_searches) / max(previous_searches, 1). Only set by
+	// the trending report.
+	Growth        float64 `protobuf:"fixed64,8,opt,name=growth,proto3" json:"growth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryStats) Reset() {
+	*x = QueryStats{}
+	mi := &file_messages_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStats) ProtoMessage() {}
+
+func (x *QueryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryStats.ProtoReflect.Descriptor instead.
+func (*QueryStats) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QueryStats) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *QueryStats) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *QueryStats) GetZeroResultSearches() int64 {
+	if x != nil {
+		return x.ZeroResultSearches
+	}
+	return 0
+}
+
+func (x *QueryStats) GetAvgResultCount() float64 {
+	if x != nil {
+		return x.AvgResultCount
+	}
+	return 0
+}
+
+func (x *QueryStats) GetAvgLatencyMs() float64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *QueryStats) GetAvgPageDepth() float64 {
+	if x != nil {
+		return x.AvgPageDepth
+	}
+	return 0
+}
+
+func (x *QueryStats) GetPreviousSearches() int64 {
+	if x != nil {
+		return x.PreviousSearches
+	}
+	return 0
+}
+
+func (x *QueryStats) GetGrowth() float64 {
+	if x != nil {
+		return x.Growth
+	}
+	return 0
+}
+
+type SearchAnalyticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []*QueryStats          `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAnalyticsResponse) Reset() {
+	*x = SearchAnalyticsResponse{}
+	mi := &file_messages_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAnalyticsResponse) ProtoMessage() {}
+
+func (x *SearchAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchAnalyticsResponse) GetQueries() []*QueryStats {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type ExportSearchAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        SearchReport           `protobuf:"varint,1,opt,name=report,proto3,enum=mawjood.v1.SearchReport" json:"report,omitempty"`
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSearchAnalyticsRequest) Reset() {
+	*x = ExportSearchAnalyticsRequest{}
+	mi := &file_messages_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSearchAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSearchAnalyticsRequest) ProtoMessage() {}
+
+func (x *ExportSearchAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSearchAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*ExportSearchAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportSearchAnalyticsRequest) GetReport() SearchReport {
+	if x != nil {
+		return x.Report
+	}
+	return SearchReport_SEARCH_REPORT_UNSPECIFIED
+}
+
+func (x *ExportSearchAnalyticsRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ExportSearchAnalyticsRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ExportSearchAnalyticsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ExportSearchAnalyticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Suggested file name, e.g. "top-queries-20240108-20240115.csv".
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// The report as CSV with a header row.
+	Csv           []byte `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSearchAnalyticsResponse) Reset() {
+	*x = ExportSearchAnalyticsResponse{}
+	mi := &file_messages_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSearchAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSearchAnalyticsResponse) ProtoMessage() {}
+
+func (x *ExportSearchAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSearchAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*ExportSearchAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportSearchAnalyticsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportSearchAnalyticsResponse) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type ImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// For podcast feeds, a fragment selects one episode by guid, link or title,
+	// e.g. https://feeds.example.com/show.xml#episode-42. Without one the
+	// newest episode is imported.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Import every episode of a feed in one transaction instead of a single
+	// one. Fails with FailedPrecondition for URLs that are not feeds.
+	AllItems      bool `protobuf:"varint,2,opt,name=all_items,json=allItems,proto3" json:"all_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	mi := &file_messages_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ImportRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImportRequest) GetAllItems() bool {
+	if x != nil {
+		return x.AllItems
+	}
+	return false
+}
+
+type ImportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The imported content; for all_items, the newest episode.
+	Content *Content `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Every imported content, newest first. Only set for all_items.
+	Contents []*Content `protobuf:"bytes,2,rep,name=contents,proto3" json:"contents,omitempty"`
+	// Content fields the linked page did not declare, named as in Content, such
+	// as "duration_seconds", for the editor to fill in. They are saved empty,
+	// except content_type, which defaults to podcast. For all_items, the fields
+	// missing from any of the contents.
+	MissingFields []string `protobuf:"bytes,3,rep,name=missing_fields,json=missingFields,proto3" json:"missing_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResponse) Reset() {
+	*x = ImportResponse{}
+	mi := &file_messages_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResponse) ProtoMessage() {}
+
+func (x *ImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ImportResponse) GetContent() *Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportResponse) GetContents() []*Content {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *ImportResponse) GetMissingFields() []string {
+	if x != nil {
+		return x.MissingFields
+	}
+	return nil
+}
+
+// ProviderInfo describes one of the providers links are resolved by.
+type ProviderInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the provider's links can list many contents, such as podcast
+	// feeds and playlists, so PreviewImport accepts all_items for them.
+	SupportsAllItems bool `protobuf:"varint,2,opt,name=supports_all_items,json=supportsAllItems,proto3" json:"supports_all_items,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProviderInfo) Reset() {
+	*x = ProviderInfo{}
+	mi := &file_messages_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteContentRequest) String() string {
+func (x *ProviderInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteContentRequest) ProtoMessage() {}
+func (*ProviderInfo) ProtoMessage() {}
 
-func (x *DeleteContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[4]
+func (x *ProviderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,41 +3148,47 @@ func (x *DeleteContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteContentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteContentRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ProviderInfo.ProtoReflect.Descriptor instead.
+func (*ProviderInfo) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DeleteContentRequest) GetId() string {
+func (x *ProviderInfo) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-type ListContentsRequest struct {
+func (x *ProviderInfo) GetSupportsAllItems() bool {
+	if x != nil {
+		return x.SupportsAllItems
+	}
+	return false
+}
+
+type ResolveURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListContentsRequest) Reset() {
-	*x = ListContentsRequest{}
-	mi := &file_messages_proto_msgTypes[5]
+func (x *ResolveURLRequest) Reset() {
+	*x = ResolveURLRequest{}
+	mi := &file_messages_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContentsRequest) String() string {
+func (x *ResolveURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContentsRequest) ProtoMessage() {}
+func (*ResolveURLRequest) ProtoMessage() {}
 
-func (x *ListContentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[5]
+func (x *ResolveURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,48 +3199,43 @@ func (x *ListContentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContentsRequest.ProtoReflect.Descriptor instead.
-func (*ListContentsRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListContentsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use ResolveURLRequest.ProtoReflect.Descriptor instead.
+func (*ResolveURLRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListContentsRequest) GetPageToken() string {
+func (x *ResolveURLRequest) GetUrl() string {
 	if x != nil {
-		return x.PageToken
+		return x.Url
 	}
 	return ""
 }
 
-type ListContentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Contents      []*Content             `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type ResolveURLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The provider PreviewImport would use for the url.
+	Provider *ProviderInfo `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// The url as the provider sees it, with the host lowercased.
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListContentsResponse) Reset() {
-	*x = ListContentsResponse{}
-	mi := &file_messages_proto_msgTypes[6]
+func (x *ResolveURLResponse) Reset() {
+	*x = ResolveURLResponse{}
+	mi := &file_messages_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContentsResponse) String() string {
+func (x *ResolveURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContentsResponse) ProtoMessage() {}
+func (*ResolveURLResponse) ProtoMessage() {}
 
-func (x *ListContentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[6]
+func (x *ResolveURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,49 +3246,46 @@ func (x *ListContentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContentsResponse.ProtoReflect.Descriptor instead.
-func (*ListContentsResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ResolveURLResponse.ProtoReflect.Descriptor instead.
+func (*ResolveURLResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListContentsResponse) GetContents() []*Content {
+func (x *ResolveURLResponse) GetProvider() *ProviderInfo {
 	if x != nil {
-		return x.Contents
+		return x.Provider
 	}
 	return nil
 }
 
-func (x *ListContentsResponse) GetNextPageToken() string {
+func (x *ResolveURLResponse) GetUrl() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Url
 	}
 	return ""
 }
 
-type SearchContentsRequest struct {
+type ListProvidersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchContentsRequest) Reset() {
-	*x = SearchContentsRequest{}
-	mi := &file_messages_proto_msgTypes[7]
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	mi := &file_messages_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchContentsRequest) String() string {
+func (x *ListProvidersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchContentsRequest) ProtoMessage() {}
+func (*ListProvidersRequest) ProtoMessage() {}
 
-func (x *SearchContentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[7]
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,55 +3296,84 @@ func (x *SearchContentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchContentsRequest.ProtoReflect.Descriptor instead.
-func (*SearchContentsRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SearchContentsRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
+type ListProvidersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// In the order they are tried.
+	Providers     []*ProviderInfo `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchContentsRequest) GetPageSize() int32 {
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	mi := &file_messages_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersResponse) ProtoMessage() {}
+
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[44]
 	if x != nil {
-		return x.PageSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SearchContentsRequest) GetPageToken() string {
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListProvidersResponse) GetProviders() []*ProviderInfo {
 	if x != nil {
-		return x.PageToken
+		return x.Providers
 	}
-	return ""
+	return nil
 }
 
-type SearchContentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Contents      []*Content             `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type PreviewImportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// See ImportRequest.url.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Return every content the url lists. Fails with FailedPrecondition for
+	// urls that are not feeds or playlists.
+	AllItems bool `protobuf:"varint,2,opt,name=all_items,json=allItems,proto3" json:"all_items,omitempty"`
+	// Caps how many items are returned for all_items. Defaults to 200.
+	MaxItems      int32 `protobuf:"varint,3,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchContentsResponse) Reset() {
-	*x = SearchContentsResponse{}
-	mi := &file_messages_proto_msgTypes[8]
+func (x *PreviewImportRequest) Reset() {
+	*x = PreviewImportRequest{}
+	mi := &file_messages_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchContentsResponse) String() string {
+func (x *PreviewImportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchContentsResponse) ProtoMessage() {}
+func (*PreviewImportRequest) ProtoMessage() {}
 
-func (x *SearchContentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[8]
+func (x *PreviewImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,47 +3384,71 @@ func (x *SearchContentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchContentsResponse.ProtoReflect.Descriptor instead.
-func (*SearchContentsResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use PreviewImportRequest.ProtoReflect.Descriptor instead.
+func (*PreviewImportRequest) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SearchContentsResponse) GetContents() []*Content {
+func (x *PreviewImportRequest) GetUrl() string {
 	if x != nil {
-		return x.Contents
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchContentsResponse) GetNextPageToken() string {
+func (x *PreviewImportRequest) GetAllItems() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.AllItems
 	}
-	return ""
+	return false
 }
 
-type ImportRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+func (x *PreviewImportRequest) GetMaxItems() int32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+// ImportPreview is the metadata of one linked content, trimmed to the limits
+// of Content. Fields the platform does not declare are empty and listed in
+// missing_fields.
+type ImportPreview struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Tags            []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Language        string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	PublishedAt     string                 `protobuf:"bytes,6,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	ContentType     ContentType            `protobuf:"varint,7,opt,name=content_type,json=contentType,proto3,enum=mawjood.v1.ContentType" json:"content_type,omitempty"`
+	Url             string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	PlatformName    string                 `protobuf:"bytes,9,opt,name=platform_name,json=platformName,proto3" json:"platform_name,omitempty"`
+	// The channel, show or person that published the content.
+	Author       string `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
+	ThumbnailUrl string `protobuf:"bytes,11,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	// Content fields not declared, named as in Content, such as
+	// "duration_seconds".
+	MissingFields []string `protobuf:"bytes,12,rep,name=missing_fields,json=missingFields,proto3" json:"missing_fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportRequest) Reset() {
-	*x = ImportRequest{}
-	mi := &file_messages_proto_msgTypes[9]
+func (x *ImportPreview) Reset() {
+	*x = ImportPreview{}
+	mi := &file_messages_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportRequest) String() string {
+func (x *ImportPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportRequest) ProtoMessage() {}
+func (*ImportPreview) ProtoMessage() {}
 
-func (x *ImportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[9]
+func (x *ImportPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,40 +3459,124 @@ func (x *ImportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
-func (*ImportRequest) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ImportPreview.ProtoReflect.Descriptor instead.
+func (*ImportPreview) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ImportRequest) GetUrl() string {
+func (x *ImportPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportPreview) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportPreview) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ImportPreview) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ImportPreview) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *ImportPreview) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_UNSPECIFIED
+}
+
+func (x *ImportPreview) GetUrl() string {
 	if x != nil {
 		return x.Url
 	}
 	return ""
 }
 
-type ImportResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       *Content               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+func (x *ImportPreview) GetPlatformName() string {
+	if x != nil {
+		return x.PlatformName
+	}
+	return ""
+}
+
+func (x *ImportPreview) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ImportPreview) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *ImportPreview) GetMissingFields() []string {
+	if x != nil {
+		return x.MissingFields
+	}
+	return nil
+}
+
+type PreviewImportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the provider that resolved the url.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// A single item, or for all_items every listed content up to max_items,
+	// newest first.
+	Items []*ImportPreview `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// How many contents the url lists, which exceeds len(items) when items
+	// were cut at max_items.
+	TotalItems    int32 `protobuf:"varint,3,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportResponse) Reset() {
-	*x = ImportResponse{}
-	mi := &file_messages_proto_msgTypes[10]
+func (x *PreviewImportResponse) Reset() {
+	*x = PreviewImportResponse{}
+	mi := &file_messages_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportResponse) String() string {
+func (x *PreviewImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportResponse) ProtoMessage() {}
+func (*PreviewImportResponse) ProtoMessage() {}
 
-func (x *ImportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_messages_proto_msgTypes[10]
+func (x *PreviewImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_messages_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,24 +3587,38 @@ func (x *ImportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
-func (*ImportResponse) Descriptor() ([]byte, []int) {
-	return file_messages_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PreviewImportResponse.ProtoReflect.Descriptor instead.
+func (*PreviewImportResponse) Descriptor() ([]byte, []int) {
+	return file_messages_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ImportResponse) GetContent() *Content {
+func (x *PreviewImportResponse) GetProvider() string {
 	if x != nil {
-		return x.Content
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PreviewImportResponse) GetItems() []*ImportPreview {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
+func (x *PreviewImportResponse) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
 var File_messages_proto protoreflect.FileDescriptor
 
 const file_messages_proto_rawDesc = "" +
 	"\n" +
 	"\x0emessages.proto\x12\n" +
-	"mawjood.v1\x1a\x17validate/validate.proto\"\xcd\x05\n" +
+	"mawjood.v1\x1a\x17validate/validate.proto\"\xfa\x05\n" +
 	"\aContent\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12 \n" +
 	"\x05title\x18\x02 \x01(\tB\n" +
@@ -845,7 +3639,8 @@ const file_messages_proto_rawDesc = "" +
 	" \x01(\tB?\xfaB<r:28^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$R\tupdatedAt\x12\x1f\n" +
 	"\x03url\x18\v \x01(\tB\r\xfaB\n" +
 	"r\b\x10\x01\x18\x80\x10\x88\x01\x01R\x03url\x12.\n" +
-	"\rplatform_name\x18\f \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\fplatformName\"\x80\x04\n" +
+	"\rplatform_name\x18\f \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\fplatformName\x12+\n" +
+	"\x11consistency_token\x18\r \x01(\tR\x10consistencyToken\"\x80\x04\n" +
 	"\x14CreateContentRequest\x12 \n" +
 	"\x05title\x18\x01 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x05title\x12*\n" +
@@ -860,9 +3655,19 @@ const file_messages_proto_rawDesc = "" +
 	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\vcontentType\x12\x1f\n" +
 	"\x03url\x18\b \x01(\tB\r\xfaB\n" +
 	"r\b\x10\x01\x18\x80\x10\x88\x01\x01R\x03url\x12.\n" +
-	"\rplatform_name\x18\t \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\fplatformName\"-\n" +
+	"\rplatform_name\x18\t \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\fplatformName\"_\n" +
 	"\x11GetContentRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"\x9a\x04\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x120\n" +
+	"\x0fmin_consistency\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18@R\x0eminConsistency\"p\n" +
+	"\x17BatchGetContentsRequest\x12#\n" +
+	"\x03ids\x18\x01 \x03(\tB\x11\xfaB\x0e\x92\x01\v\b\x01\x10d\"\x05r\x03\xb0\x01\x01R\x03ids\x120\n" +
+	"\x0fmin_consistency\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x18@R\x0eminConsistency\"t\n" +
+	"\x16BatchGetContentsResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\acontent\x18\x02 \x01(\v2\x13.mawjood.v1.ContentR\acontent\x12\x1b\n" +
+	"\tnot_found\x18\x03 \x01(\bR\bnotFound\"b\n" +
+	"\x18BatchGetContentsResponse\x12F\n" +
+	"\aresults\x18\x01 \x03(\v2\".mawjood.v1.BatchGetContentsResultB\b\xfaB\x05\x92\x01\x02\x10dR\aresults\"\x9a\x04\n" +
 	"\x14UpdateContentRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12 \n" +
 	"\x05title\x18\x02 \x01(\tB\n" +
@@ -881,32 +3686,249 @@ const file_messages_proto_rawDesc = "" +
 	"\rplatform_name\x18\n" +
 	" \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\fplatformName\"0\n" +
 	"\x14DeleteContentRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"f\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\"D\n" +
+	"\x15DeleteContentResponse\x12+\n" +
+	"\x11consistency_token\x18\x01 \x01(\tR\x10consistencyToken\"\xde\x01\n" +
 	"\x13ListContentsRequest\x12&\n" +
 	"\tpage_size\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSize\x12'\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\"\x83\x01\n" +
+	"page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\x12\"\n" +
+	"\border_by\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18dR\aorderBy\x12 \n" +
+	"\x06filter\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\x06filter\x120\n" +
+	"\x0fmin_consistency\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18@R\x0eminConsistency\"\x83\x01\n" +
 	"\x14ListContentsResponse\x129\n" +
 	"\bcontents\x18\x01 \x03(\v2\x13.mawjood.v1.ContentB\b\xfaB\x05\x92\x01\x02\x10dR\bcontents\x120\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\rnextPageToken\"\x8a\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\rnextPageToken\"\xe3\x04\n" +
+	"\rSearchFilters\x12O\n" +
+	"\rcontent_types\x18\x01 \x03(\x0e2\x17.mawjood.v1.ContentTypeB\x11\xfaB\x0e\x92\x01\v\x10\n" +
+	"\"\a\x82\x01\x04\x10\x01 \x00R\fcontentTypes\x12H\n" +
+	"\tlanguages\x18\x02 \x03(\tB*\xfaB'\x92\x01$\x10\x14\" r\x1e\x10\x02\x18\n" +
+	"2\x18^[a-z]{2,3}(-[A-Z]{2})?$R\tlanguages\x12$\n" +
+	"\x04tags\x18\x03 \x03(\tB\x10\xfaB\r\x92\x01\n" +
+	"\x102\"\x06r\x04\x10\x01\x18dR\x04tags\x127\n" +
+	"\x0eplatform_names\x18\x04 \x03(\tB\x10\xfaB\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18dR\rplatformNames\x12=\n" +
+	"\x14min_duration_seconds\x18\x05 \x01(\x05B\v\xfaB\b\x1a\x06\x18\x80\xa3\x05(\x00R\x12minDurationSeconds\x12=\n" +
+	"\x14max_duration_seconds\x18\x06 \x01(\x05B\v\xfaB\b\x1a\x06\x18\x80\xa3\x05(\x00R\x12maxDurationSeconds\x12k\n" +
+	"\x0fpublished_after\x18\a \x01(\tBB\xfaB?r=28^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$\xd0\x01\x01R\x0epublishedAfter\x12m\n" +
+	"\x10published_before\x18\b \x01(\tBB\xfaB?r=28^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$\xd0\x01\x01R\x0fpublishedBefore\"\x98\x02\n" +
 	"\x15SearchContentsRequest\x12 \n" +
 	"\x05query\x18\x01 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\x01\x18\xf4\x03R\x05query\x12&\n" +
 	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSize\x12'\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\"\x85\x01\n" +
+	"page_token\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\x123\n" +
+	"\afilters\x18\x04 \x01(\v2\x19.mawjood.v1.SearchFiltersR\afilters\x12%\n" +
+	"\x0einclude_facets\x18\x05 \x01(\bR\rincludeFacets\x120\n" +
+	"\x0fmin_consistency\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x18@R\x0eminConsistency\"9\n" +
+	"\vFacetBucket\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xa7\x02\n" +
+	"\fSearchFacets\x12<\n" +
+	"\rcontent_types\x18\x01 \x03(\v2\x17.mawjood.v1.FacetBucketR\fcontentTypes\x125\n" +
+	"\tlanguages\x18\x02 \x03(\v2\x17.mawjood.v1.FacetBucketR\tlanguages\x12>\n" +
+	"\x0eplatform_names\x18\x03 \x03(\v2\x17.mawjood.v1.FacetBucketR\rplatformNames\x12+\n" +
+	"\x04tags\x18\x04 \x03(\v2\x17.mawjood.v1.FacetBucketR\x04tags\x125\n" +
+	"\tdurations\x18\x05 \x03(\v2\x17.mawjood.v1.FacetBucketR\tdurations\"3\n" +
+	"\tTextRange\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"\xf6\x01\n" +
+	"\vSearchMatch\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12%\n" +
+	"\x0ematched_fields\x18\x03 \x03(\tR\rmatchedFields\x12+\n" +
+	"\x11highlighted_title\x18\x04 \x01(\tR\x10highlightedTitle\x12\x18\n" +
+	"\asnippet\x18\x05 \x01(\tR\asnippet\x12D\n" +
+	"\x12snippet_highlights\x18\x06 \x03(\v2\x15.mawjood.v1.TextRangeR\x11snippetHighlights\"\xf4\x01\n" +
 	"\x16SearchContentsResponse\x129\n" +
 	"\bcontents\x18\x01 \x03(\v2\x13.mawjood.v1.ContentB\b\xfaB\x05\x92\x01\x02\x10dR\bcontents\x120\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\rnextPageToken\"0\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\rnextPageToken\x120\n" +
+	"\x06facets\x18\x03 \x01(\v2\x18.mawjood.v1.SearchFacetsR\x06facets\x12;\n" +
+	"\amatches\x18\x04 \x03(\v2\x17.mawjood.v1.SearchMatchB\b\xfaB\x05\x92\x01\x02\x10dR\amatches\"\xc0\x01\n" +
+	"\x0eSuggestRequest\x12!\n" +
+	"\x06prefix\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x06prefix\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x14(\x00R\x05limit\x128\n" +
+	"\x05order\x18\x03 \x01(\x0e2\x18.mawjood.v1.SuggestOrderB\b\xfaB\x05\x82\x01\x02\x10\x01R\x05order\x120\n" +
+	"\x0fmin_consistency\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\x0eminConsistency\"\x94\x01\n" +
+	"\n" +
+	"Suggestion\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.mawjood.v1.SuggestionTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x03 \x01(\tR\tcontentId\x12#\n" +
+	"\rcontent_count\x18\x04 \x01(\x03R\fcontentCount\"U\n" +
+	"\x0fSuggestResponse\x12B\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x16.mawjood.v1.SuggestionB\b\xfaB\x05\x92\x01\x02\x10\x14R\vsuggestions\"\xdd\x01\n" +
+	"\x19GetRelatedContentsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\x12#\n" +
+	"\rsame_platform\x18\x04 \x01(\bR\fsamePlatform\x120\n" +
+	"\x0fmin_consistency\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18@R\x0eminConsistency\"\x89\x01\n" +
+	"\x1aGetRelatedContentsResponse\x129\n" +
+	"\bcontents\x18\x01 \x03(\v2\x13.mawjood.v1.ContentB\b\xfaB\x05\x92\x01\x02\x10dR\bcontents\x120\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\rnextPageToken\"\xeb\x01\n" +
+	"\x0fListTagsRequest\x12&\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\x124\n" +
+	"\x05order\x18\x03 \x01(\x0e2\x14.mawjood.v1.TagOrderB\b\xfaB\x05\x82\x01\x02\x10\x01R\x05order\x12\x1f\n" +
+	"\x06prefix\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18dR\x06prefix\x120\n" +
+	"\x0fmin_consistency\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18@R\x0eminConsistency\"N\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rcontent_count\x18\x03 \x01(\x03R\fcontentCount\"s\n" +
+	"\x10ListTagsResponse\x12-\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0f.mawjood.v1.TagB\b\xfaB\x05\x92\x01\x02\x10dR\x04tags\x120\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\rnextPageToken\"\xba\x01\n" +
+	"\x18ListContentsByTagRequest\x12\x1b\n" +
+	"\x03tag\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x03tag\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\x120\n" +
+	"\x0fmin_consistency\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18@R\x0eminConsistency\"\x88\x01\n" +
+	"\x19ListContentsByTagResponse\x129\n" +
+	"\bcontents\x18\x01 \x03(\v2\x13.mawjood.v1.ContentB\b\xfaB\x05\x92\x01\x02\x10dR\bcontents\x120\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\rnextPageToken\"\x84\x02\n" +
+	"\x12RecordEventRequest\x12'\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tcontentId\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.mawjood.v1.EventTypeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04type\x12)\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\tsessionId\x12c\n" +
+	"\voccurred_at\x18\x04 \x01(\tBB\xfaB?r=28^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$\xd0\x01\x01R\n" +
+	"occurredAt\"3\n" +
+	"\x13RecordEventResponse\x12\x1c\n" +
+	"\tduplicate\x18\x01 \x01(\bR\tduplicate\"\xf1\x02\n" +
+	"\x13ListTrendingRequest\x12<\n" +
+	"\x06window\x18\x01 \x01(\x0e2\x1a.mawjood.v1.TrendingWindowB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06window\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\tpageToken\x12H\n" +
+	"\tlanguages\x18\x04 \x03(\tB*\xfaB'\x92\x01$\x10\x14\" r\x1e\x10\x02\x18\n" +
+	"2\x18^[a-z]{2,3}(-[A-Z]{2})?$R\tlanguages\x12O\n" +
+	"\rcontent_types\x18\x05 \x03(\x0e2\x17.mawjood.v1.ContentTypeB\x11\xfaB\x0e\x92\x01\v\x10\n" +
+	"\"\a\x82\x01\x04\x10\x01 \x00R\fcontentTypes\x120\n" +
+	"\x0fmin_consistency\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x18@R\x0eminConsistency\"e\n" +
+	"\rTrendingStats\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x01 \x01(\tR\tcontentId\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x1f\n" +
+	"\vevent_count\x18\x03 \x01(\x03R\n" +
+	"eventCount\"\xbe\x01\n" +
+	"\x14ListTrendingResponse\x129\n" +
+	"\bcontents\x18\x01 \x03(\v2\x13.mawjood.v1.ContentB\b\xfaB\x05\x92\x01\x02\x10dR\bcontents\x120\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\x80\bR\rnextPageToken\x129\n" +
+	"\x05stats\x18\x03 \x03(\v2\x19.mawjood.v1.TrendingStatsB\b\xfaB\x05\x92\x01\x02\x10dR\x05stats\"\xfc\x01\n" +
+	"\x16SearchAnalyticsRequest\x12a\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\tBB\xfaB?r=28^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$\xd0\x01\x01R\tstartTime\x12]\n" +
+	"\bend_time\x18\x02 \x01(\tBB\xfaB?r=28^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$\xd0\x01\x01R\aendTime\x12 \n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\"\xab\x02\n" +
+	"\n" +
+	"QueryStats\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bsearches\x18\x02 \x01(\x03R\bsearches\x120\n" +
+	"\x14zero_result_searches\x18\x03 \x01(\x03R\x12zeroResultSearches\x12(\n" +
+	"\x10avg_result_count\x18\x04 \x01(\x01R\x0eavgResultCount\x12$\n" +
+	"\x0eavg_latency_ms\x18\x05 \x01(\x01R\favgLatencyMs\x12$\n" +
+	"\x0eavg_page_depth\x18\x06 \x01(\x01R\favgPageDepth\x12+\n" +
+	"\x11previous_searches\x18\a \x01(\x03R\x10previousSearches\x12\x16\n" +
+	"\x06growth\x18\b \x01(\x01R\x06growth\"V\n" +
+	"\x17SearchAnalyticsResponse\x12;\n" +
+	"\aqueries\x18\x01 \x03(\v2\x16.mawjood.v1.QueryStatsB\t\xfaB\x06\x92\x01\x03\x10\xe8\aR\aqueries\"\xc0\x02\n" +
+	"\x1cExportSearchAnalyticsRequest\x12<\n" +
+	"\x06report\x18\x01 \x01(\x0e2\x18.mawjood.v1.SearchReportB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06report\x12a\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tBB\xfaB?r=28^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$\xd0\x01\x01R\tstartTime\x12]\n" +
+	"\bend_time\x18\x03 \x01(\tBB\xfaB?r=28^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(Z|[+-]\\d{2}:\\d{2})$\xd0\x01\x01R\aendTime\x12 \n" +
+	"\x05limit\x18\x04 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\x05limit\"M\n" +
+	"\x1dExportSearchAnalyticsResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\fR\x03csv\"M\n" +
 	"\rImportRequest\x12\x1f\n" +
 	"\x03url\x18\x01 \x01(\tB\r\xfaB\n" +
-	"r\b\x10\x01\x18\x80\x10\x88\x01\x01R\x03url\"I\n" +
+	"r\b\x10\x01\x18\x80\x10\x88\x01\x01R\x03url\x12\x1b\n" +
+	"\tall_items\x18\x02 \x01(\bR\ballItems\"\xac\x01\n" +
 	"\x0eImportResponse\x127\n" +
-	"\acontent\x18\x01 \x01(\v2\x13.mawjood.v1.ContentB\b\xfaB\x05\x8a\x01\x02\x10\x01R\acontent*c\n" +
+	"\acontent\x18\x01 \x01(\v2\x13.mawjood.v1.ContentB\b\xfaB\x05\x8a\x01\x02\x10\x01R\acontent\x12:\n" +
+	"\bcontents\x18\x02 \x03(\v2\x13.mawjood.v1.ContentB\t\xfaB\x06\x92\x01\x03\x10\xc8\x01R\bcontents\x12%\n" +
+	"\x0emissing_fields\x18\x03 \x03(\tR\rmissingFields\"P\n" +
+	"\fProviderInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12,\n" +
+	"\x12supports_all_items\x18\x02 \x01(\bR\x10supportsAllItems\"4\n" +
+	"\x11ResolveURLRequest\x12\x1f\n" +
+	"\x03url\x18\x01 \x01(\tB\r\xfaB\n" +
+	"r\b\x10\x01\x18\x80\x10\x88\x01\x01R\x03url\"\\\n" +
+	"\x12ResolveURLResponse\x124\n" +
+	"\bprovider\x18\x01 \x01(\v2\x18.mawjood.v1.ProviderInfoR\bprovider\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\x16\n" +
+	"\x14ListProvidersRequest\"O\n" +
+	"\x15ListProvidersResponse\x126\n" +
+	"\tproviders\x18\x01 \x03(\v2\x18.mawjood.v1.ProviderInfoR\tproviders\"}\n" +
+	"\x14PreviewImportRequest\x12\x1f\n" +
+	"\x03url\x18\x01 \x01(\tB\r\xfaB\n" +
+	"r\b\x10\x01\x18\x80\x10\x88\x01\x01R\x03url\x12\x1b\n" +
+	"\tall_items\x18\x02 \x01(\bR\ballItems\x12'\n" +
+	"\tmax_items\x18\x03 \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xe8\a(\x00R\bmaxItems\"\x9c\x03\n" +
+	"\rImportPreview\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x05R\x0fdurationSeconds\x12!\n" +
+	"\fpublished_at\x18\x06 \x01(\tR\vpublishedAt\x12:\n" +
+	"\fcontent_type\x18\a \x01(\x0e2\x17.mawjood.v1.ContentTypeR\vcontentType\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\x12#\n" +
+	"\rplatform_name\x18\t \x01(\tR\fplatformName\x12\x16\n" +
+	"\x06author\x18\n" +
+	" \x01(\tR\x06author\x12#\n" +
+	"\rthumbnail_url\x18\v \x01(\tR\fthumbnailUrl\x12%\n" +
+	"\x0emissing_fields\x18\f \x03(\tR\rmissingFields\"\x85\x01\n" +
+	"\x15PreviewImportResponse\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12/\n" +
+	"\x05items\x18\x02 \x03(\v2\x19.mawjood.v1.ImportPreviewR\x05items\x12\x1f\n" +
+	"\vtotal_items\x18\x03 \x01(\x05R\n" +
+	"totalItems*c\n" +
 	"\vContentType\x12\x1c\n" +
 	"\x18CONTENT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14CONTENT_TYPE_PODCAST\x10\x01\x12\x1c\n" +
-	"\x18CONTENT_TYPE_DOCUMENTARY\x10\x02B\"Z mawjood/gen/go/packages/proto/v1b\x06proto3"
+	"\x18CONTENT_TYPE_DOCUMENTARY\x10\x02*\x83\x01\n" +
+	"\x0eSuggestionType\x12\x1f\n" +
+	"\x1bSUGGESTION_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SUGGESTION_TYPE_TITLE\x10\x01\x12\x17\n" +
+	"\x13SUGGESTION_TYPE_TAG\x10\x02\x12\x1c\n" +
+	"\x18SUGGESTION_TYPE_PLATFORM\x10\x03*G\n" +
+	"\fSuggestOrder\x12\x1c\n" +
+	"\x18SUGGEST_ORDER_POPULARITY\x10\x00\x12\x19\n" +
+	"\x15SUGGEST_ORDER_RECENCY\x10\x01*;\n" +
+	"\bTagOrder\x12\x1b\n" +
+	"\x17TAG_ORDER_CONTENT_COUNT\x10\x00\x12\x12\n" +
+	"\x0eTAG_ORDER_NAME\x10\x01*\x8b\x01\n" +
+	"\tEventType\x12\x1a\n" +
+	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fEVENT_TYPE_VIEW\x10\x01\x12\x19\n" +
+	"\x15EVENT_TYPE_PLAY_START\x10\x02\x12\x1c\n" +
+	"\x18EVENT_TYPE_PLAY_COMPLETE\x10\x03\x12\x14\n" +
+	"\x10EVENT_TYPE_SHARE\x10\x04*Z\n" +
+	"\x0eTrendingWindow\x12\x17\n" +
+	"\x13TRENDING_WINDOW_24H\x10\x00\x12\x16\n" +
+	"\x12TRENDING_WINDOW_7D\x10\x01\x12\x17\n" +
+	"\x13TRENDING_WINDOW_30D\x10\x02*\x97\x01\n" +
+	"\fSearchReport\x12\x1d\n" +
+	"\x19SEARCH_REPORT_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SEARCH_REPORT_TOP_QUERIES\x10\x01\x12%\n" +
+	"!SEARCH_REPORT_ZERO_RESULT_QUERIES\x10\x02\x12\"\n" +
+	"\x1eSEARCH_REPORT_TRENDING_QUERIES\x10\x03B\"Z mawjood/gen/go/packages/proto/v1b\x06proto3"
 
 var (
 	file_messages_proto_rawDescOnce sync.Once
@@ -920,34 +3942,108 @@ func file_messages_proto_rawDescGZIP() []byte {
 	return file_messages_proto_rawDescData
 }
 
-var file_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_messages_proto_goTypes = []any{
-	(ContentType)(0),               // 0: mawjood.v1.ContentType
-	(*Content)(nil),                // 1: mawjood.v1.Content
-	(*CreateContentRequest)(nil),   // 2: mawjood.v1.CreateContentRequest
-	(*GetContentRequest)(nil),      // 3: mawjood.v1.GetContentRequest
-	(*UpdateContentRequest)(nil),   // 4: mawjood.v1.UpdateContentRequest
-	(*DeleteContentRequest)(nil),   // 5: mawjood.v1.DeleteContentRequest
-	(*ListContentsRequest)(nil),    // 6: mawjood.v1.ListContentsRequest
-	(*ListContentsResponse)(nil),   // 7: mawjood.v1.ListContentsResponse
-	(*SearchContentsRequest)(nil),  // 8: mawjood.v1.SearchContentsRequest
-	(*SearchContentsResponse)(nil), // 9: mawjood.v1.SearchContentsResponse
-	(*ImportRequest)(nil),          // 10: mawjood.v1.ImportRequest
-	(*ImportResponse)(nil),         // 11: mawjood.v1.ImportResponse
+	(ContentType)(0),                      // 0: mawjood.v1.ContentType
+	(SuggestionType)(0),                   // 1: mawjood.v1.SuggestionType
+	(SuggestOrder)(0),                     // 2: mawjood.v1.SuggestOrder
+	(TagOrder)(0),                         // 3: mawjood.v1.TagOrder
+	(EventType)(0),                        // 4: mawjood.v1.EventType
+	(TrendingWindow)(0),                   // 5: mawjood.v1.TrendingWindow
+	(SearchReport)(0),                     // 6: mawjood.v1.SearchReport
+	(*Content)(nil),                       // 7: mawjood.v1.Content
+	(*CreateContentRequest)(nil),          // 8: mawjood.v1.CreateContentRequest
+	(*GetContentRequest)(nil),             // 9: mawjood.v1.GetContentRequest
+	(*BatchGetContentsRequest)(nil),       // 10: mawjood.v1.BatchGetContentsRequest
+	(*BatchGetContentsResult)(nil),        // 11: mawjood.v1.BatchGetContentsResult
+	(*BatchGetContentsResponse)(nil),      // 12: mawjood.v1.BatchGetContentsResponse
+	(*UpdateContentRequest)(nil),          // 13: mawjood.v1.UpdateContentRequest
+	(*DeleteContentRequest)(nil),          // 14: mawjood.v1.DeleteContentRequest
+	(*DeleteContentResponse)(nil),         // 15: mawjood.v1.DeleteContentResponse
+	(*ListContentsRequest)(nil),           // 16: mawjood.v1.ListContentsRequest
+	(*ListContentsResponse)(nil),          // 17: mawjood.v1.ListContentsResponse
+	(*SearchFilters)(nil),                 // 18: mawjood.v1.SearchFilters
+	(*SearchContentsRequest)(nil),         // 19: mawjood.v1.SearchContentsRequest
+	(*FacetBucket)(nil),                   // 20: mawjood.v1.FacetBucket
+	(*SearchFacets)(nil),                  // 21: mawjood.v1.SearchFacets
+	(*TextRange)(nil),                     // 22: mawjood.v1.TextRange
+	(*SearchMatch)(nil),                   // 23: mawjood.v1.SearchMatch
+	(*SearchContentsResponse)(nil),        // 24: mawjood.v1.SearchContentsResponse
+	(*SuggestRequest)(nil),                // 25: mawjood.v1.SuggestRequest
+	(*Suggestion)(nil),                    // 26: mawjood.v1.Suggestion
+	(*SuggestResponse)(nil),               // 27: mawjood.v1.SuggestResponse
+	(*GetRelatedContentsRequest)(nil),     // 28: mawjood.v1.GetRelatedContentsRequest
+	(*GetRelatedContentsResponse)(nil),    // 29: mawjood.v1.GetRelatedContentsResponse
+	(*ListTagsRequest)(nil),               // 30: mawjood.v1.ListTagsRequest
+	(*Tag)(nil),                           // 31: mawjood.v1.Tag
+	(*ListTagsResponse)(nil),              // 32: mawjood.v1.ListTagsResponse
+	(*ListContentsByTagRequest)(nil),      // 33: mawjood.v1.ListContentsByTagRequest
+	(*ListContentsByTagResponse)(nil),     // 34: mawjood.v1.ListContentsByTagResponse
+	(*RecordEventRequest)(nil),            // 35: mawjood.v1.RecordEventRequest
+	(*RecordEventResponse)(nil),           // 36: mawjood.v1.RecordEventResponse
+	(*ListTrendingRequest)(nil),           // 37: mawjood.v1.ListTrendingRequest
+	(*TrendingStats)(nil),                 // 38: mawjood.v1.TrendingStats
+	(*ListTrendingResponse)(nil),          // 39: mawjood.v1.ListTrendingResponse
+	(*SearchAnalyticsRequest)(nil),        // 40: mawjood.v1.SearchAnalyticsRequest
+	(*QueryStats)(nil),                    // 41: mawjood.v1.QueryStats
+	(*SearchAnalyticsResponse)(nil),       // 42: mawjood.v1.SearchAnalyticsResponse
+	(*ExportSearchAnalyticsRequest)(nil),  // 43: mawjood.v1.ExportSearchAnalyticsRequest
+	(*ExportSearchAnalyticsResponse)(nil), // 44: mawjood.v1.ExportSearchAnalyticsResponse
+	(*ImportRequest)(nil),                 // 45: mawjood.v1.ImportRequest
+	(*ImportResponse)(nil),                // 46: mawjood.v1.ImportResponse
+	(*ProviderInfo)(nil),                  // 47: mawjood.v1.ProviderInfo
+	(*ResolveURLRequest)(nil),             // 48: mawjood.v1.ResolveURLRequest
+	(*ResolveURLResponse)(nil),            // 49: mawjood.v1.ResolveURLResponse
+	(*ListProvidersRequest)(nil),          // 50: mawjood.v1.ListProvidersRequest
+	(*ListProvidersResponse)(nil),         // 51: mawjood.v1.ListProvidersResponse
+	(*PreviewImportRequest)(nil),          // 52: mawjood.v1.PreviewImportRequest
+	(*ImportPreview)(nil),                 // 53: mawjood.v1.ImportPreview
+	(*PreviewImportResponse)(nil),         // 54: mawjood.v1.PreviewImportResponse
 }
 var file_messages_proto_depIdxs = []int32{
-	0, // 0: mawjood.v1.Content.content_type:type_name -> mawjood.v1.ContentType
-	0, // 1: mawjood.v1.CreateContentRequest.content_type:type_name -> mawjood.v1.ContentType
-	0, // 2: mawjood.v1.UpdateContentRequest.content_type:type_name -> mawjood.v1.ContentType
-	1, // 3: mawjood.v1.ListContentsResponse.contents:type_name -> mawjood.v1.Content
-	1, // 4: mawjood.v1.SearchContentsResponse.contents:type_name -> mawjood.v1.Content
-	1, // 5: mawjood.v1.ImportResponse.content:type_name -> mawjood.v1.Content
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: mawjood.v1.Content.content_type:type_name -> mawjood.v1.ContentType
+	0,  // 1: mawjood.v1.CreateContentRequest.content_type:type_name -> mawjood.v1.ContentType
+	7,  // 2: mawjood.v1.BatchGetContentsResult.content:type_name -> mawjood.v1.Content
+	11, // 3: mawjood.v1.BatchGetContentsResponse.results:type_name -> mawjood.v1.BatchGetContentsResult
+	0,  // 4: mawjood.v1.UpdateContentRequest.content_type:type_name -> mawjood.v1.ContentType
+	7,  // 5: mawjood.v1.ListContentsResponse.contents:type_name -> mawjood.v1.Content
+	0,  // 6: mawjood.v1.SearchFilters.content_types:type_name -> mawjood.v1.ContentType
+	18, // 7: mawjood.v1.SearchContentsRequest.filters:type_name -> mawjood.v1.SearchFilters
+	20, // 8: mawjood.v1.SearchFacets.content_types:type_name -> mawjood.v1.FacetBucket
+	20, // 9: mawjood.v1.SearchFacets.languages:type_name -> mawjood.v1.FacetBucket
+	20, // 10: mawjood.v1.SearchFacets.platform_names:type_name -> mawjood.v1.FacetBucket
+	20, // 11: mawjood.v1.SearchFacets.tags:type_name -> mawjood.v1.FacetBucket
+	20, // 12: mawjood.v1.SearchFacets.durations:type_name -> mawjood.v1.FacetBucket
+	22, // 13: mawjood.v1.SearchMatch.snippet_highlights:type_name -> mawjood.v1.TextRange
+	7,  // 14: mawjood.v1.SearchContentsResponse.contents:type_name -> mawjood.v1.Content
+	21, // 15: mawjood.v1.SearchContentsResponse.facets:type_name -> mawjood.v1.SearchFacets
+	23, // 16: mawjood.v1.SearchContentsResponse.matches:type_name -> mawjood.v1.SearchMatch
+	2,  // 17: mawjood.v1.SuggestRequest.order:type_name -> mawjood.v1.SuggestOrder
+	1,  // 18: mawjood.v1.Suggestion.type:type_name -> mawjood.v1.SuggestionType
+	26, // 19: mawjood.v1.SuggestResponse.suggestions:type_name -> mawjood.v1.Suggestion
+	7,  // 20: mawjood.v1.GetRelatedContentsResponse.contents:type_name -> mawjood.v1.Content
+	3,  // 21: mawjood.v1.ListTagsRequest.order:type_name -> mawjood.v1.TagOrder
+	31, // 22: mawjood.v1.ListTagsResponse.tags:type_name -> mawjood.v1.Tag
+	7,  // 23: mawjood.v1.ListContentsByTagResponse.contents:type_name -> mawjood.v1.Content
+	4,  // 24: mawjood.v1.RecordEventRequest.type:type_name -> mawjood.v1.EventType
+	5,  // 25: mawjood.v1.ListTrendingRequest.window:type_name -> mawjood.v1.TrendingWindow
+	0,  // 26: mawjood.v1.ListTrendingRequest.content_types:type_name -> mawjood.v1.ContentType
+	7,  // 27: mawjood.v1.ListTrendingResponse.contents:type_name -> mawjood.v1.Content
+	38, // 28: mawjood.v1.ListTrendingResponse.stats:type_name -> mawjood.v1.TrendingStats
+	41, // 29: mawjood.v1.SearchAnalyticsResponse.queries:type_name -> mawjood.v1.QueryStats
+	6,  // 30: mawjood.v1.ExportSearchAnalyticsRequest.report:type_name -> mawjood.v1.SearchReport
+	7,  // 31: mawjood.v1.ImportResponse.content:type_name -> mawjood.v1.Content
+	7,  // 32: mawjood.v1.ImportResponse.contents:type_name -> mawjood.v1.Content
+	47, // 33: mawjood.v1.ResolveURLResponse.provider:type_name -> mawjood.v1.ProviderInfo
+	47, // 34: mawjood.v1.ListProvidersResponse.providers:type_name -> mawjood.v1.ProviderInfo
+	0,  // 35: mawjood.v1.ImportPreview.content_type:type_name -> mawjood.v1.ContentType
+	53, // 36: mawjood.v1.PreviewImportResponse.items:type_name -> mawjood.v1.ImportPreview
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_messages_proto_init() }
@@ -960,8 +4056,8 @@ func file_messages_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_messages_proto_rawDesc), len(file_messages_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      7,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
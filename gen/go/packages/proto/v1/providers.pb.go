@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.1
+// source: providers.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_providers_proto protoreflect.FileDescriptor
+
+const file_providers_proto_rawDesc = "" +
+	"\n" +
+	"\x0fproviders.proto\x12\n" +
+	"mawjood.v1\x1a\x0emessages.proto2\x8b\x02\n" +
+	"\x10ProvidersService\x12K\n" +
+	"\n" +
+	"ResolveURL\x12\x1d.mawjood.v1.ResolveURLRequest\x1a\x1e.mawjood.v1.ResolveURLResponse\x12T\n" +
+	"\rListProviders\x12 .mawjood.v1.ListProvidersRequest\x1a!.mawjood.v1.ListProvidersResponse\x12T\n" +
+	"\rPreviewImport\x12 .mawjood.v1.PreviewImportRequest\x1a!.mawjood.v1.PreviewImportResponseB\"Z mawjood/gen/go/packages/proto/v1b\x06proto3"
+
+var file_providers_proto_goTypes = []any{
+	(*ResolveURLRequest)(nil),     // 0: mawjood.v1.ResolveURLRequest
+	(*ListProvidersRequest)(nil),  // 1: mawjood.v1.ListProvidersRequest
+	(*PreviewImportRequest)(nil),  // 2: mawjood.v1.PreviewImportRequest
+	(*ResolveURLResponse)(nil),    // 3: mawjood.v1.ResolveURLResponse
+	(*ListProvidersResponse)(nil), // 4: mawjood.v1.ListProvidersResponse
+	(*PreviewImportResponse)(nil), // 5: mawjood.v1.PreviewImportResponse
+}
+var file_providers_proto_depIdxs = []int32{
+	0, // 0: mawjood.v1.ProvidersService.ResolveURL:input_type -> mawjood.v1.ResolveURLRequest
+	1, // 1: mawjood.v1.ProvidersService.ListProviders:input_type -> mawjood.v1.ListProvidersRequest
+	2, // 2: mawjood.v1.ProvidersService.PreviewImport:input_type -> mawjood.v1.PreviewImportRequest
+	3, // 3: mawjood.v1.ProvidersService.ResolveURL:output_type -> mawjood.v1.ResolveURLResponse
+	4, // 4: mawjood.v1.ProvidersService.ListProviders:output_type -> mawjood.v1.ListProvidersResponse
+	5, // 5: mawjood.v1.ProvidersService.PreviewImport:output_type -> mawjood.v1.PreviewImportResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_providers_proto_init() }
+func file_providers_proto_init() {
+	if File_providers_proto != nil {
+		return
+	}
+	file_messages_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_providers_proto_rawDesc), len(file_providers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_providers_proto_goTypes,
+		DependencyIndexes: file_providers_proto_depIdxs,
+	}.Build()
+	File_providers_proto = out.File
+	file_providers_proto_goTypes = nil
+	file_providers_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ProvidersServiceClient is the client API for ProvidersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProvidersServiceClient interface {
+	ResolveURL(ctx context.Context, in *ResolveURLRequest, opts ...grpc.CallOption) (*ResolveURLResponse, error)
+	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
+	PreviewImport(ctx context.Context, in *PreviewImportRequest, opts ...grpc.CallOption) (*PreviewImportResponse, error)
+}
+
+type providersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProvidersServiceClient(cc grpc.ClientConnInterface) ProvidersServiceClient {
+	return &providersServiceClient{cc}
+}
+
+func (c *providersServiceClient) ResolveURL(ctx context.Context, in *ResolveURLRequest, opts ...grpc.CallOption) (*ResolveURLResponse, error) {
+	out := new(ResolveURLResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.ProvidersService/ResolveURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
+	out := new(ListProvidersResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.ProvidersService/ListProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providersServiceClient) PreviewImport(ctx context.Context, in *PreviewImportRequest, opts ...grpc.CallOption) (*PreviewImportResponse, error) {
+	out := new(PreviewImportResponse)
+	err := c.cc.Invoke(ctx, "/mawjood.v1.ProvidersService/PreviewImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProvidersServiceServer is the server API for ProvidersService service.
+type ProvidersServiceServer interface {
+	ResolveURL(context.Context, *ResolveURLRequest) (*ResolveURLResponse, error)
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
+	PreviewImport(context.Context, *PreviewImportRequest) (*PreviewImportResponse, error)
+}
+
+// UnimplementedProvidersServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProvidersServiceServer struct {
+}
+
+func (*UnimplementedProvidersServiceServer) ResolveURL(context.Context, *ResolveURLRequest) (*ResolveURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveURL not implemented")
+}
+func (*UnimplementedProvidersServiceServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
+}
+func (*UnimplementedProvidersServiceServer) PreviewImport(context.Context, *PreviewImportRequest) (*PreviewImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewImport not implemented")
+}
+
+func RegisterProvidersServiceServer(s *grpc.Server, srv ProvidersServiceServer) {
+	s.RegisterService(&_ProvidersService_serviceDesc, srv)
+}
+
+func _ProvidersService_ResolveURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ResolveURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.ProvidersService/ResolveURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ResolveURL(ctx, req.(*ResolveURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.ProvidersService/ListProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvidersService_PreviewImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvidersServiceServer).PreviewImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mawjood.v1.ProvidersService/PreviewImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvidersServiceServer).PreviewImport(ctx, req.(*PreviewImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProvidersService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mawjood.v1.ProvidersService",
+	HandlerType: (*ProvidersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ResolveURL",
+			Handler:    _ProvidersService_ResolveURL_Handler,
+		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _ProvidersService_ListProviders_Handler,
+		},
+		{
+			MethodName: "PreviewImport",
+			Handler:    _ProvidersService_PreviewImport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "providers.proto",
+}
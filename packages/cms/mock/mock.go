@@ -2,20 +2,40 @@ package mock
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/mosaibah/Mawjood/packages/cms/store"
+	"github.com/mosaibah/Mawjood/packages/filter"
+	"github.com/mosaibah/Mawjood/packages/pagination"
 )
 
+// InvalidPageToken is rejected by the mock as if it had been tampered with.
+const InvalidPageToken = "invalid-page-token"
+
 type MockContentData struct{}
 
 func (m *MockContentData) CreateContent(ctx context.Context, content store.Content) (*store.Content, error) {
 	content.ID = "550e8400-e29b-41d4-a716-446655440000"
 	content.CreatedAt = time.Now()
 	content.UpdatedAt = time.Now()
+	content.CommittedAt = content.UpdatedAt
 	return &content, nil
 }
 
+func (m *MockContentData) CreateContents(ctx context.Context, contents []store.Content) ([]store.Content, error) {
+	now := time.Now()
+	created := make([]store.Content, len(contents))
+	for i, content := range contents {
+		content.ID = fmt.Sprintf("550e8400-e29b-41d4-a716-%012d", 446655440000+i)
+		content.CreatedAt = now
+		content.UpdatedAt = now
+		content.CommittedAt = now
+		created[i] = content
+	}
+	return created, nil
+}
+
 func (m *MockContentData) GetContent(ctx context.Context, id string) (*store.Content, error) {
 	return &store.Content{
 		ID:              "550e8400-e29b-41d4-a716-446655440000",
@@ -35,14 +55,25 @@ func (m *MockContentData) GetContent(ctx context.Context, id string) (*store.Con
 
 func (m *MockContentData) UpdateContent(ctx context.Context, content store.Content) (*store.Content, error) {
 	content.UpdatedAt = time.Now()
+	content.CommittedAt = content.UpdatedAt
 	return &content, nil
 }
 
-func (m *MockContentData) DeleteContent(ctx context.Context, id string) error {
-	return nil
+func (m *MockContentData) DeleteContent(ctx context.Context, id string) (time.Time, error) {
+	return time.Now(), nil
 }
 
-func (m *MockContentData) ListContents(ctx context.Context, pageSize int32, pageToken string) ([]store.Content, string, error) {
+func (m *MockContentData) ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]store.Content, string, error) {
+	if pageToken == InvalidPageToken {
+		return nil, "", fmt.Errorf("failed to decode page token: %w", pagination.ErrInvalidToken)
+	}
+
+	if filterExpr != "" {
+		if _, err := filter.Parse(filterExpr); err != nil {
+			return nil, "", fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
 	return []store.Content{
 		{
 			ID:              "550e8400-e29b-41d4-a716-446655440000",
@@ -93,3 +124,26 @@ func (m *MockContentData) SearchContents(ctx context.Context, query string, page
 		},
 	}, "", nil
 }
+
+func (m *MockContentData) ReindexSearchText(ctx context.Context, batchSize int) (int, error) {
+	return 0, nil
+}
+
+func (m *MockContentData) TopSearchQueries(ctx context.Context, start, end time.Time, limit int) ([]store.QueryStats, error) {
+	return []store.QueryStats{
+		{Query: "planet earth", Searches: 42, AvgResultCount: 8, AvgLatencyMs: 12.5, AvgPageDepth: 1.2},
+		{Query: "الذكاء الاصطناعي", Searches: 17, ZeroResultSearches: 3, AvgResultCount: 2.5, AvgLatencyMs: 20, AvgPageDepth: 1},
+	}, nil
+}
+
+func (m *MockContentData) ZeroResultSearchQueries(ctx context.Context, start, end time.Time, limit int) ([]store.QueryStats, error) {
+	return []store.QueryStats{
+		{Query: "quantum, \"cats\"", Searches: 5, ZeroResultSearches: 5, AvgLatencyMs: 9, AvgPageDepth: 1},
+	}, nil
+}
+
+func (m *MockContentData) TrendingSearchQueries(ctx context.Context, start, end time.Time, limit int) ([]store.QueryStats, error) {
+	return []store.QueryStats{
+		{Query: "planet earth", Searches: 42, AvgResultCount: 8, AvgLatencyMs: 12.5, AvgPageDepth: 1.2, PreviousSearches: 10, Growth: 3.2},
+	}, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -9,11 +10,13 @@ import (
 
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
 	"github.com/mosaibah/Mawjood/packages/cms/store"
 	v1 "github.com/mosaibah/Mawjood/packages/cms/v1"
+	"github.com/mosaibah/Mawjood/packages/pagination"
 )
 
 func main() {
@@ -24,6 +27,8 @@ func main() {
 	dbPassword := getEnv("DB_PASSWORD", "")
 	dbSSLMode := getEnv("DB_SSL_MODE", "disable")
 	servicePort := getEnv("SERVICE_PORT", "9001")
+	pageTokenSecret := getEnv("PAGE_TOKEN_SECRET", "")
+	providersAddr := getEnv("PROVIDERS_SERVICE_ADDR", "localhost:9003")
 
 	connStr := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s&parseTime=true",
 		dbUser, dbPassword, dbHost, dbPort, dbName, dbSSLMode)
@@ -38,8 +43,35 @@ func main() {
 		log.Fatalf("failed to ping database: %v", err)
 	}
 
-	store := store.New(db)
-	service := v1.New(store)
+	if pageTokenSecret == "" {
+		log.Printf("PAGE_TOKEN_SECRET is not set; page tokens will not survive restarts or work across replicas")
+	}
+	cursors := pagination.NewCodec([]byte(pageTokenSecret), pagination.DefaultTTL)
+
+	// The connection is established lazily, so the CMS starts even while the
+	// providers service is down; only imports fail until it is back.
+	providersConn, err := grpc.NewClient(providersAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to create providers service client: %v", err)
+	}
+	defer providersConn.Close()
+	log.Printf("importing through the providers service at %s", providersAddr)
+
+	store := store.New(db, store.WithCursorCodec(cursors))
+	service := v1.New(store, v1.WithProviders(mawjoodv1.NewProvidersServiceClient(providersConn)))
+
+	// Seed data and rows written before normalized search columns existed are
+	// indexed in the background so startup is not blocked.
+	go func() {
+		updated, err := store.ReindexSearchText(context.Background(), 500)
+		if err != nil {
+			log.Printf("failed to reindex search text: %v", err)
+			return
+		}
+		if updated > 0 {
+			log.Printf("reindexed search text for %d rows", updated)
+		}
+	}()
 
 	lis, err := net.Listen("tcp", ":"+servicePort)
 	if err != nil {
@@ -0,0 +1,144 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultAnalyticsLimit and MaxAnalyticsLimit bound the number of queries a
+// search analytics report returns.
+const (
+	DefaultAnalyticsLimit = 50
+	MaxAnalyticsLimit     = 1000
+)
+
+// QueryStats aggregates the searches logged for one normalized query.
+type QueryStats struct {
+	Query              string
+	Searches           int64
+	ZeroResultSearches int64
+	AvgResultCount     float64
+	AvgLatencyMs       float64
+	AvgPageDepth       float64
+	// PreviousSearches and Growth are only set by TrendingSearchQueries.
+	PreviousSearches int64
+	Growth           float64
+}
+
+// queryStatsColumns aggregates search_queries rows grouped by query. A search
+// is counted once, by its first page, so paginating through the results does
+// not inflate it; likewise running off the end of the results is not a miss.
+// Later pages only feed the average page depth.
+const queryStatsColumns = `
+			query,
+			SUM(CASE WHEN page_depth = 1 THEN 1 ELSE 0 END) as searches,
+			SUM(CASE WHEN result_count = 0 AND page_depth = 1 THEN 1 ELSE 0 END) as zero_result_searches,
+			AVG(result_count)::FLOAT8 as avg_result_count,
+			AVG(latency_ms)::FLOAT8 as avg_latency_ms,
+			AVG(page_depth)::FLOAT8 as avg_page_depth`
+
+// TopSearchQueries returns the most searched queries in [start, end).
+func (cd *ContentData) TopSearchQueries(ctx context.Context, start, end time.Time, limit int) ([]QueryStats, error) {
+	topQuery := fmt.Sprintf(`
+		SELECT %s,
+			0 as previous_searches,
+			0::FLOAT8 as growth
+		FROM search_queries
+		WHERE searched_at >= $1 AND searched_at < $2
+		GROUP BY query
+		ORDER BY searches DESC, query
+		LIMIT $3`, queryStatsColumns)
+
+	return cd.queryStats(ctx, "top", topQuery, start, end, analyticsLimit(limit))
+}
+
+// ZeroResultSearchQueries returns the queries in [start, end) that most often
+// found nothing.
+func (cd *ContentData) ZeroResultSearchQueries(ctx context.Context, start, end time.Time, limit int) ([]QueryStats, error) {
+	zeroResultQuery := fmt.Sprintf(`
+		SELECT %s,
+			0 as previous_searches,
+			0::FLOAT8 as growth
+		FROM search_queries
+		WHERE searched_at >= $1 AND searched_at < $2
+		GROUP BY query
+		HAVING SUM(CASE WHEN result_count = 0 AND page_depth = 1 THEN 1 ELSE 0 END) > 0
+		ORDER BY zero_result_searches DESC, searches DESC, query
+		LIMIT $3`, queryStatsColumns)
+
+	return cd.queryStats(ctx, "zero-result", zeroResultQuery, start, end, analyticsLimit(limit))
+}
+
+// TrendingSearchQueries returns the queries searched more often in
+// [start, end) than in the period of the same length before it, fastest
+// growing first.
+func (cd *ContentData) TrendingSearchQueries(ctx context.Context, start, end time.Time, limit int) ([]QueryStats, error) {
+	trendingQuery := fmt.Sprintf(`
+		WITH current_period AS (
+			SELECT %s
+			FROM search_queries
+			WHERE searched_at >= $1 AND searched_at < $2
+			GROUP BY query
+		),
+		previous_period AS (
+			SELECT query, SUM(CASE WHEN page_depth = 1 THEN 1 ELSE 0 END) as searches
+			FROM search_queries
+			WHERE searched_at >= $4 AND searched_at < $1
+			GROUP BY query
+		)
+		SELECT
+			c.query, c.searches, c.zero_result_searches, c.avg_result_count, c.avg_latency_ms, c.avg_page_depth,
+			COALESCE(p.searches, 0) as previous_searches,
+			((c.searches - COALESCE(p.searches, 0))::FLOAT8 / GREATEST(COALESCE(p.searches, 0), 1)) as growth
+		FROM current_period c
+		LEFT JOIN previous_period p ON p.query = c.query
+		WHERE c.searches > COALESCE(p.searches, 0)
+		ORDER BY growth DESC, c.searches DESC, c.query
+		LIMIT $3`, queryStatsColumns)
+
+	return cd.queryStats(ctx, "trending", trendingQuery, start, end, analyticsLimit(limit), start.Add(-end.Sub(start)))
+}
+
+func (cd *ContentData) queryStats(ctx context.Context, report string, statsQuery string, args ...interface{}) ([]QueryStats, error) {
+	rows, err := cd.db.QueryContext(ctx, statsQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s search queries: %w", report, err)
+	}
+	defer rows.Close()
+
+	var stats []QueryStats
+	for rows.Next() {
+		var s QueryStats
+		err := rows.Scan(
+			&s.Query,
+			&s.Searches,
+			&s.ZeroResultSearches,
+			&s.AvgResultCount,
+			&s.AvgLatencyMs,
+			&s.AvgPageDepth,
+			&s.PreviousSearches,
+			&s.Growth,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan query stats row: %w", err)
+		}
+		stats = append(stats, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over %s search queries: %w", report, err)
+	}
+
+	return stats, nil
+}
+
+func analyticsLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultAnalyticsLimit
+	}
+	if limit > MaxAnalyticsLimit {
+		return MaxAnalyticsLimit
+	}
+	return limit
+}
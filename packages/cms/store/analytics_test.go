@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var queryStatsRowColumns = []string{
+	"query", "searches", "zero_result_searches", "avg_result_count", "avg_latency_ms", "avg_page_depth", "previous_searches", "growth",
+}
+
+func TestTopSearchQueries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	start := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT query, SUM\(CASE WHEN page_depth = 1 THEN 1 ELSE 0 END\) as searches, SUM\(CASE WHEN result_count = 0 AND page_depth = 1 THEN 1 ELSE 0 END\) as zero_result_searches, .* FROM search_queries WHERE searched_at >= \$1 AND searched_at < \$2 GROUP BY query ORDER BY searches DESC, query LIMIT \$3`).
+		WithArgs(start, end, DefaultAnalyticsLimit).
+		WillReturnRows(sqlmock.NewRows(queryStatsRowColumns).
+			AddRow("planet earth", 42, 0, 8.0, 12.5, 1.2, 0, 0.0).
+			AddRow("nature", 7, 2, 3.0, 10.0, 1.0, 0, 0.0))
+
+	stats, err := store.TopSearchQueries(context.Background(), start, end, 0)
+
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	assert.Equal(t, QueryStats{Query: "planet earth", Searches: 42, AvgResultCount: 8, AvgLatencyMs: 12.5, AvgPageDepth: 1.2}, stats[0])
+	assert.Equal(t, int64(2), stats[1].ZeroResultSearches)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestZeroResultSearchQueries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	start := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`GROUP BY query HAVING SUM\(CASE WHEN result_count = 0 AND page_depth = 1 THEN 1 ELSE 0 END\) > 0 ORDER BY zero_result_searches DESC, searches DESC, query LIMIT \$3`).
+		WithArgs(start, end, MaxAnalyticsLimit).
+		WillReturnRows(sqlmock.NewRows(queryStatsRowColumns).
+			AddRow("quantum cats", 5, 5, 0.0, 9.0, 1.0, 0, 0.0))
+
+	stats, err := store.ZeroResultSearchQueries(context.Background(), start, end, 5000)
+
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, "quantum cats", stats[0].Query)
+	assert.Equal(t, int64(5), stats[0].ZeroResultSearches)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrendingSearchQueries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	start := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`previous_period AS \( SELECT query, SUM\(CASE WHEN page_depth = 1 THEN 1 ELSE 0 END\) as searches FROM search_queries WHERE searched_at >= \$4 AND searched_at < \$1 GROUP BY query \).* LEFT JOIN previous_period p ON p\.query = c\.query WHERE c\.searches > COALESCE\(p\.searches, 0\) ORDER BY growth DESC, c\.searches DESC, c\.query LIMIT \$3`).
+		WithArgs(start, end, 10, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows(queryStatsRowColumns).
+			AddRow("planet earth", 42, 0, 8.0, 12.5, 1.2, 10, 3.2))
+
+	stats, err := store.TrendingSearchQueries(context.Background(), start, end, 10)
+
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, int64(10), stats[0].PreviousSearches)
+	assert.Equal(t, 3.2, stats[0].Growth)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,27 +4,69 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
+	"github.com/mosaibah/Mawjood/packages/consistency"
+	"github.com/mosaibah/Mawjood/packages/filter"
+	"github.com/mosaibah/Mawjood/packages/pagination"
+	"github.com/mosaibah/Mawjood/packages/textnorm"
 )
 
 type ContentData struct {
-	db *sql.DB
+	db          *sql.DB
+	cursors     *pagination.Codec
+	normalizers *textnorm.Registry
+}
+
+// Option configures a ContentData created by New.
+type Option func(*ContentData)
+
+// WithCursorCodec sets the codec used to sign and verify page tokens. Without
+// it, tokens are signed with a random per-process secret.
+func WithCursorCodec(codec *pagination.Codec) Option {
+	return func(cd *ContentData) {
+		cd.cursors = codec
+	}
+}
+
+// WithNormalizers sets the registry used to fill the normalized search
+// columns. It must match the one the discovery service uses for queries;
+// defaults to textnorm.Default().
+func WithNormalizers(normalizers *textnorm.Registry) Option {
+	return func(cd *ContentData) {
+		cd.normalizers = normalizers
+	}
 }
 
 type Interface interface {
 	CreateContent(ctx context.Context, content Content) (*Content, error)
+	CreateContents(ctx context.Context, contents []Content) ([]Content, error)
 	GetContent(ctx context.Context, id string) (*Content, error)
 	UpdateContent(ctx context.Context, content Content) (*Content, error)
-	DeleteContent(ctx context.Context, id string) error
-	ListContents(ctx context.Context, pageSize int32, pageToken string) ([]Content, string, error)
+	DeleteContent(ctx context.Context, id string) (time.Time, error)
+	ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]Content, string, error)
 	SearchContents(ctx context.Context, query string, pageSize int32, pageToken string) ([]Content, string, error)
+	ReindexSearchText(ctx context.Context, batchSize int) (int, error)
+	TopSearchQueries(ctx context.Context, start, end time.Time, limit int) ([]QueryStats, error)
+	ZeroResultSearchQueries(ctx context.Context, start, end time.Time, limit int) ([]QueryStats, error)
+	TrendingSearchQueries(ctx context.Context, start, end time.Time, limit int) ([]QueryStats, error)
 }
 
-func New(db *sql.DB) Interface {
-	return &ContentData{db: db}
+func New(db *sql.DB, opts ...Option) Interface {
+	cd := &ContentData{db: db}
+	for _, opt := range opts {
+		opt(cd)
+	}
+	if cd.cursors == nil {
+		cd.cursors = pagination.NewCodec(nil, pagination.DefaultTTL)
+	}
+	if cd.normalizers == nil {
+		cd.normalizers = textnorm.Default()
+	}
+	return cd
 }
 
 type Content struct {
@@ -41,25 +83,70 @@ type Content struct {
 	ExternalURL     string
 	PlatformName    string
 	DeletedAt       *time.Time
+	// CommittedAt is the commit timestamp of the write that returned the
+	// content. It is only set by CreateContent, CreateContents and
+	// UpdateContent.
+	CommittedAt time.Time
 }
 
 func (cd *ContentData) CreateContent(ctx context.Context, content Content) (*Content, error) {
+	created, err := cd.CreateContents(ctx, []Content{content})
+	if err != nil {
+		return nil, err
+	}
+	return &created[0], nil
+}
+
+// CreateContents creates all of contents in one transaction, so either all or
+// none of them are saved.
+func (cd *ContentData) CreateContents(ctx context.Context, contents []Content) ([]Content, error) {
 	tx, err := cd.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
+	created := make([]Content, len(contents))
+	var committedAt string
+	for i, content := range contents {
+		if committedAt, err = cd.insertContent(ctx, tx, &content); err != nil {
+			return nil, err
+		}
+		created[i] = content
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	ts, err := consistency.ParseClusterTimestamp(committedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit timestamp: %w", err)
+	}
+	for i := range created {
+		created[i].CommittedAt = ts
+	}
+
+	return created, nil
+}
+
+// insertContent inserts content and links its tags in tx, filling in the id
+// and timestamps. It returns the transaction's cluster timestamp, which the
+// transaction will commit at.
+func (cd *ContentData) insertContent(ctx context.Context, tx *sql.Tx, content *Content) (string, error) {
 	insertContentQuery := `
-		INSERT INTO contents (title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-		RETURNING id, created_at, updated_at`
+		INSERT INTO contents (title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, title_normalized, description_normalized, platform_name_normalized)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id, created_at, updated_at, cluster_logical_timestamp()`
 
 	now := time.Now()
 	content.CreatedAt = now
 	content.UpdatedAt = now
 
-	err = tx.QueryRowContext(ctx, insertContentQuery,
+	titleNormalized, descriptionNormalized, platformNameNormalized := cd.normalizeContentText(*content)
+
+	var committedAt string
+	err := tx.QueryRowContext(ctx, insertContentQuery,
 		content.Title,
 		content.Description,
 		content.Language,
@@ -70,24 +157,27 @@ func (cd *ContentData) CreateContent(ctx context.Context, content Content) (*Con
 		content.UpdatedAt,
 		content.ExternalURL,
 		content.PlatformName,
-	).Scan(&content.ID, &content.CreatedAt, &content.UpdatedAt)
+		titleNormalized,
+		descriptionNormalized,
+		platformNameNormalized,
+	).Scan(&content.ID, &content.CreatedAt, &content.UpdatedAt, &committedAt)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to insert content: %w", err)
+		return "", fmt.Errorf("failed to insert content: %w", err)
 	}
 
 	if len(content.Tags) > 0 {
 		for _, tagName := range content.Tags {
 			var tagID string
 			upsertTagQuery := `
-				INSERT INTO tags (id, name) 
-				VALUES (gen_random_uuid(), $1)
-				ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+				INSERT INTO tags (id, name, normalized_name) 
+				VALUES (gen_random_uuid(), $1, $2)
+				ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name, normalized_name = EXCLUDED.normalized_name
 				RETURNING id`
 
-			err = tx.QueryRowContext(ctx, upsertTagQuery, tagName).Scan(&tagID)
+			err = tx.QueryRowContext(ctx, upsertTagQuery, tagName, cd.normalizers.Query().Normalize(tagName)).Scan(&tagID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to upsert tag %s: %w", tagName, err)
+				return "", fmt.Errorf("failed to upsert tag %s: %w", tagName, err)
 			}
 
 			insertContentTagQuery := `
@@ -97,16 +187,12 @@ func (cd *ContentData) CreateContent(ctx context.Context, content Content) (*Con
 
 			_, err = tx.ExecContext(ctx, insertContentTagQuery, content.ID, tagID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to link content to tag %s: %w", tagName, err)
+				return "", fmt.Errorf("failed to link content to tag %s: %w", tagName, err)
 			}
 		}
 	}
 
-	if err = tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
-	return &content, nil
+	return committedAt, nil
 }
 
 func (cd *ContentData) GetContent(ctx context.Context, id string) (*Content, error) {
@@ -116,7 +202,8 @@ func (cd *ContentData) GetContent(ctx context.Context, id string) (*Content, err
 		WHERE id = $1 AND deleted_at IS NULL`
 
 	var content Content
-	var publishedAt, createdAt, updatedAt time.Time
+	var createdAt, updatedAt time.Time
+	var publishedAt sql.NullTime
 	var description, language, url, platformName sql.NullString
 	var durationSeconds sql.NullInt32
 	var deletedAt sql.NullTime
@@ -148,7 +235,7 @@ func (cd *ContentData) GetContent(ctx context.Context, id string) (*Content, err
 	content.ExternalURL = url.String
 	content.PlatformName = platformName.String
 	content.DurationSeconds = durationSeconds.Int32
-	content.PublishedAt = publishedAt
+	content.PublishedAt = publishedAt.Time
 	content.CreatedAt = createdAt
 	content.UpdatedAt = updatedAt
 	if deletedAt.Valid {
@@ -173,13 +260,17 @@ func (cd *ContentData) UpdateContent(ctx context.Context, content Content) (*Con
 
 	updateContentQuery := `
 		UPDATE contents 
-		SET title = $1, description = $2, language = $3, duration_seconds = $4, published_at = $5, content_type = $6, updated_at = $7, url = $8, platform_name = $9
-		WHERE id = $10 AND deleted_at IS NULL
-		RETURNING created_at, updated_at`
+		SET title = $1, description = $2, language = $3, duration_seconds = $4, published_at = $5, content_type = $6, updated_at = $7, url = $8, platform_name = $9,
+			title_normalized = $10, description_normalized = $11, platform_name_normalized = $12
+		WHERE id = $13 AND deleted_at IS NULL
+		RETURNING created_at, updated_at, cluster_logical_timestamp()`
 
 	now := time.Now()
 	content.UpdatedAt = now
 
+	titleNormalized, descriptionNormalized, platformNameNormalized := cd.normalizeContentText(content)
+
+	var committedAt string
 	err = tx.QueryRowContext(ctx, updateContentQuery,
 		content.Title,
 		content.Description,
@@ -190,8 +281,11 @@ func (cd *ContentData) UpdateContent(ctx context.Context, content Content) (*Con
 		content.UpdatedAt,
 		content.ExternalURL,
 		content.PlatformName,
+		titleNormalized,
+		descriptionNormalized,
+		platformNameNormalized,
 		content.ID,
-	).Scan(&content.CreatedAt, &content.UpdatedAt)
+	).Scan(&content.CreatedAt, &content.UpdatedAt, &committedAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -210,12 +304,12 @@ func (cd *ContentData) UpdateContent(ctx context.Context, content Content) (*Con
 		for _, tagName := range content.Tags {
 			var tagID string
 			upsertTagQuery := `
-				INSERT INTO tags (id, name) 
-				VALUES (gen_random_uuid(), $1)
-				ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+				INSERT INTO tags (id, name, normalized_name) 
+				VALUES (gen_random_uuid(), $1, $2)
+				ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name, normalized_name = EXCLUDED.normalized_name
 				RETURNING id`
 
-			err = tx.QueryRowContext(ctx, upsertTagQuery, tagName).Scan(&tagID)
+			err = tx.QueryRowContext(ctx, upsertTagQuery, tagName, cd.normalizers.Query().Normalize(tagName)).Scan(&tagID)
 			if err != nil {
 				return nil, fmt.Errorf("failed to upsert tag %s: %w", tagName, err)
 			}
@@ -236,34 +330,40 @@ func (cd *ContentData) UpdateContent(ctx context.Context, content Content) (*Con
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	content.CommittedAt, err = consistency.ParseClusterTimestamp(committedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit timestamp: %w", err)
+	}
+
 	return &content, nil
 }
 
-func (cd *ContentData) DeleteContent(ctx context.Context, id string) error {
+func (cd *ContentData) DeleteContent(ctx context.Context, id string) (time.Time, error) {
 	now := time.Now()
 	softDeleteQuery := `
 		UPDATE contents 
 		SET deleted_at = $1, updated_at = $1
-		WHERE id = $2 AND deleted_at IS NULL`
+		WHERE id = $2 AND deleted_at IS NULL
+		RETURNING cluster_logical_timestamp()`
 
-	result, err := cd.db.ExecContext(ctx, softDeleteQuery, now, id)
+	var committedAt string
+	err := cd.db.QueryRowContext(ctx, softDeleteQuery, now, id).Scan(&committedAt)
 	if err != nil {
-		return fmt.Errorf("failed to soft delete content: %w", err)
+		if err == sql.ErrNoRows {
+			return time.Time{}, fmt.Errorf("content with ID %s not found or already deleted", id)
+		}
+		return time.Time{}, fmt.Errorf("failed to soft delete content: %w", err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	ts, err := consistency.ParseClusterTimestamp(committedAt)
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return time.Time{}, fmt.Errorf("failed to read commit timestamp: %w", err)
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("content with ID %s not found or already deleted", id)
-	}
-
-	return nil
+	return ts, nil
 }
 
-func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageToken string) ([]Content, string, error) {
+func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]Content, string, error) {
 	if pageSize <= 0 {
 		pageSize = 10
 	}
@@ -272,27 +372,41 @@ func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageTok
 		pageSize = 100
 	}
 
-	var query string
+	orderTerms, err := filter.ParseKeysetOrderBy(orderBy, contentSortFields, defaultListOrder)
+	if err != nil {
+		return nil, "", err
+	}
+
+	conditions := []string{"deleted_at IS NULL"}
 	var args []interface{}
 
-	if pageToken == "" {
-		query = `
-			SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, deleted_at
-			FROM contents 
-			WHERE deleted_at IS NULL
-			ORDER BY created_at DESC 
-			LIMIT $1`
-		args = []interface{}{pageSize + 1}
-	} else {
-		query = `
-			SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, deleted_at
-			FROM contents 
-			WHERE deleted_at IS NULL AND created_at < (SELECT created_at FROM contents WHERE id = $1)
-			ORDER BY created_at DESC 
-			LIMIT $2`
-		args = []interface{}{pageToken, pageSize + 1}
+	filterClause, args, err := filter.Translate(filterExpr, contentFilterFields, args)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid filter: %w", err)
+	}
+	if filterClause != "" {
+		conditions = append(conditions, filterClause)
+	}
+
+	scope := listCursorScope(orderTerms, filterExpr)
+
+	if pageToken != "" {
+		keys, err := filter.DecodeKeysetCursor(cd.cursors, pageToken, scope, orderTerms)
+		if err != nil {
+			return nil, "", err
+		}
+		conditions = append(conditions, filter.KeysetCondition(orderTerms, keys, &args))
 	}
 
+	args = append(args, pageSize+1)
+
+	query := fmt.Sprintf(`
+		SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, deleted_at
+		FROM contents 
+		WHERE %s
+		ORDER BY %s 
+		LIMIT $%d`, strings.Join(conditions, " AND "), filter.KeysetOrderBy(orderTerms), len(args))
+
 	rows, err := cd.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to list contents: %w", err)
@@ -302,7 +416,8 @@ func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageTok
 	var contents []Content
 	for rows.Next() {
 		var content Content
-		var publishedAt, createdAt, updatedAt time.Time
+		var createdAt, updatedAt time.Time
+		var publishedAt sql.NullTime
 		var description, language, url, platformName sql.NullString
 		var durationSeconds sql.NullInt32
 		var deletedAt sql.NullTime
@@ -330,19 +445,13 @@ func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageTok
 		content.ExternalURL = url.String
 		content.PlatformName = platformName.String
 		content.DurationSeconds = durationSeconds.Int32
-		content.PublishedAt = publishedAt
+		content.PublishedAt = publishedAt.Time
 		content.CreatedAt = createdAt
 		content.UpdatedAt = updatedAt
 		if deletedAt.Valid {
 			content.DeletedAt = &deletedAt.Time
 		}
 
-		tags, err := cd.getContentTags(ctx, content.ID)
-		if err != nil {
-			return nil, "", fmt.Errorf("failed to get content tags: %w", err)
-		}
-		content.Tags = tags
-
 		contents = append(contents, content)
 	}
 
@@ -353,7 +462,19 @@ func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageTok
 	var nextPageToken string
 	if len(contents) > int(pageSize) {
 		contents = contents[:pageSize]
-		nextPageToken = contents[len(contents)-1].ID
+		last := contents[len(contents)-1]
+		nextPageToken, err = cd.cursors.Encode(scope, filter.KeysetCursorKeys(orderTerms, last.ID, last.sortValue)...)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	page := make([]*Content, len(contents))
+	for i := range contents {
+		page[i] = &contents[i]
+	}
+	if err := cd.loadTags(ctx, page); err != nil {
+		return nil, "", err
 	}
 
 	return contents, nextPageToken, nil
@@ -378,60 +499,39 @@ func (cd *ContentData) SearchContents(ctx context.Context, query string, pageSiz
 		return nil, "", fmt.Errorf("failed to set similarity threshold: %w", err)
 	}
 
-	var sqlQuery string
-	var args []interface{}
+	likeQuery := "%" + searchQuery + "%"
+	args := []interface{}{searchQuery, likeQuery}
 
-	if pageToken == "" {
-		sqlQuery = `
-			WITH content_with_tags AS (
-				SELECT 
-					c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
-					c.content_type, c.created_at, c.updated_at, c.url, c.platform_name, c.deleted_at,
-					STRING_AGG(t.name, ' ') as tag_text
-				FROM contents c
-				LEFT JOIN content_tags ct ON c.id = ct.content_id
-				LEFT JOIN tags t ON ct.tag_id = t.id
-				WHERE c.deleted_at IS NULL
-				GROUP BY c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
-					c.content_type, c.created_at, c.updated_at, c.url, c.platform_name, c.deleted_at
-			)
+	scope := pagination.Scope("cms.SearchContents", searchQuery)
+
+	var paginationClause string
+	if pageToken != "" {
+		score, createdAt, id, err := cd.decodeSearchCursor(pageToken, scope)
+		if err != nil {
+			return nil, "", err
+		}
+		args = append(args, score, createdAt, id)
+		paginationClause = "WHERE (max_similarity, created_at, id) < ($3, $4, $5)"
+	}
+
+	args = append(args, pageSize+1)
+
+	// The score is cast to FLOAT8 so the value echoed back in the page token
+	// compares exactly against the recomputed score on the next page.
+	sqlQuery := fmt.Sprintf(`
+		WITH content_with_tags AS (
 			SELECT 
-				id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, deleted_at,
-				GREATEST(
-					SIMILARITY(LOWER(title), LOWER($1)),
-					SIMILARITY(LOWER(description), LOWER($1)),
-					SIMILARITY(LOWER(platform_name), LOWER($1)),
-					COALESCE(SIMILARITY(LOWER(tag_text), LOWER($1)), 0)
-				) as max_similarity
-			FROM content_with_tags
-			WHERE (
-				LOWER(title) % LOWER($1) OR 
-				LOWER(description) % LOWER($1) OR 
-				LOWER(platform_name) % LOWER($1) OR
-				LOWER(tag_text) % LOWER($1) OR
-				title ILIKE $2 OR 
-				description ILIKE $2 OR
-				platform_name ILIKE $2 OR
-				tag_text ILIKE $2
-			)
-			ORDER BY max_similarity DESC, created_at DESC 
-			LIMIT $3`
-		likeQuery := "%" + searchQuery + "%"
-		args = []interface{}{searchQuery, likeQuery, pageSize + 1}
-	} else {
-		sqlQuery = `
-			WITH content_with_tags AS (
-				SELECT 
-					c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
-					c.content_type, c.created_at, c.updated_at, c.url, c.platform_name, c.deleted_at,
-					STRING_AGG(t.name, ' ') as tag_text
-				FROM contents c
-				LEFT JOIN content_tags ct ON c.id = ct.content_id
-				LEFT JOIN tags t ON ct.tag_id = t.id
-				WHERE c.deleted_at IS NULL
-				GROUP BY c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
-					c.content_type, c.created_at, c.updated_at, c.url, c.platform_name, c.deleted_at
-			)
+				c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
+				c.content_type, c.created_at, c.updated_at, c.url, c.platform_name, c.deleted_at,
+				STRING_AGG(t.name, ' ') as tag_text
+			FROM contents c
+			LEFT JOIN content_tags ct ON c.id = ct.content_id
+			LEFT JOIN tags t ON ct.tag_id = t.id
+			WHERE c.deleted_at IS NULL
+			GROUP BY c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
+				c.content_type, c.created_at, c.updated_at, c.url, c.platform_name, c.deleted_at
+		),
+		ranked AS (
 			SELECT 
 				id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, deleted_at,
 				GREATEST(
@@ -439,24 +539,24 @@ func (cd *ContentData) SearchContents(ctx context.Context, query string, pageSiz
 					SIMILARITY(LOWER(description), LOWER($1)),
 					SIMILARITY(LOWER(platform_name), LOWER($1)),
 					COALESCE(SIMILARITY(LOWER(tag_text), LOWER($1)), 0)
-				) as max_similarity
+				)::FLOAT8 as max_similarity
 			FROM content_with_tags
 			WHERE (
-				LOWER(title) % LOWER($1) OR 
-				LOWER(description) % LOWER($1) OR 
-				LOWER(platform_name) % LOWER($1) OR
-				LOWER(tag_text) % LOWER($1) OR
+				LOWER(title) %% LOWER($1) OR 
+				LOWER(description) %% LOWER($1) OR 
+				LOWER(platform_name) %% LOWER($1) OR
+				LOWER(tag_text) %% LOWER($1) OR
 				title ILIKE $2 OR 
 				description ILIKE $2 OR
 				platform_name ILIKE $2 OR
 				tag_text ILIKE $2
 			)
-			AND created_at < (SELECT created_at FROM content_with_tags WHERE id = $3)
-			ORDER BY max_similarity DESC, created_at DESC 
-			LIMIT $4`
-		likeQuery := "%" + searchQuery + "%"
-		args = []interface{}{searchQuery, likeQuery, pageToken, pageSize + 1}
-	}
+		)
+		SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, deleted_at, max_similarity
+		FROM ranked
+		%s
+		ORDER BY max_similarity DESC, created_at DESC, id DESC 
+		LIMIT $%d`, paginationClause, len(args))
 
 	rows, err := cd.db.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
@@ -465,9 +565,11 @@ func (cd *ContentData) SearchContents(ctx context.Context, query string, pageSiz
 	defer rows.Close()
 
 	var contents []Content
+	var scores []float64
 	for rows.Next() {
 		var content Content
-		var publishedAt, createdAt, updatedAt time.Time
+		var createdAt, updatedAt time.Time
+		var publishedAt sql.NullTime
 		var description, language, url, platformName sql.NullString
 		var durationSeconds sql.NullInt32
 		var deletedAt sql.NullTime
@@ -497,20 +599,15 @@ func (cd *ContentData) SearchContents(ctx context.Context, query string, pageSiz
 		content.ExternalURL = url.String
 		content.PlatformName = platformName.String
 		content.DurationSeconds = durationSeconds.Int32
-		content.PublishedAt = publishedAt
+		content.PublishedAt = publishedAt.Time
 		content.CreatedAt = createdAt
 		content.UpdatedAt = updatedAt
 		if deletedAt.Valid {
 			content.DeletedAt = &deletedAt.Time
 		}
 
-		tags, err := cd.getContentTags(ctx, content.ID)
-		if err != nil {
-			return nil, "", fmt.Errorf("failed to get content tags: %w", err)
-		}
-		content.Tags = tags
-
 		contents = append(contents, content)
+		scores = append(scores, maxSimilarity)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -520,12 +617,253 @@ func (cd *ContentData) SearchContents(ctx context.Context, query string, pageSiz
 	var nextPageToken string
 	if len(contents) > int(pageSize) {
 		contents = contents[:pageSize]
-		nextPageToken = contents[len(contents)-1].ID
+		last := contents[len(contents)-1]
+		nextPageToken, err = cd.cursors.Encode(scope,
+			strconv.FormatFloat(scores[len(contents)-1], 'g', -1, 64),
+			last.CreatedAt.Format(time.RFC3339Nano),
+			last.ID,
+		)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	page := make([]*Content, len(contents))
+	for i := range contents {
+		page[i] = &contents[i]
+	}
+	if err := cd.loadTags(ctx, page); err != nil {
+		return nil, "", err
 	}
 
 	return contents, nextPageToken, nil
 }
 
+// contentFilterFields are the fields ListContents accepts in a filter expression.
+var contentFilterFields = filter.Schema{
+	"title":            {Expr: "title", Type: filter.TypeString},
+	"description":      {Expr: "description", Type: filter.TypeString},
+	"content_type":     {Expr: "content_type", Type: filter.TypeString},
+	"language":         {Expr: "language", Type: filter.TypeString},
+	"platform_name":    {Expr: "platform_name", Type: filter.TypeString},
+	"duration_seconds": {Expr: "duration_seconds", Type: filter.TypeInt},
+	"published_at":     {Expr: "published_at", Type: filter.TypeTimestamp},
+	"created_at":       {Expr: "created_at", Type: filter.TypeTimestamp},
+	"updated_at":       {Expr: "updated_at", Type: filter.TypeTimestamp},
+	"tags": {Membership: `id IN (
+				SELECT fct.content_id
+				FROM content_tags fct
+				INNER JOIN tags ft ON fct.tag_id = ft.id
+				WHERE ft.name = %s)`},
+}
+
+// contentSortFields are the fields ListContents accepts in order_by. Nullable
+// columns are coalesced so keyset comparisons never see NULL, to the zero
+// value Content.sortValue encodes into page tokens for them.
+var contentSortFields = filter.Schema{
+	"created_at":       {Expr: "created_at", Type: filter.TypeTimestamp},
+	"updated_at":       {Expr: "updated_at", Type: filter.TypeTimestamp},
+	"published_at":     {Expr: "COALESCE(published_at, '0001-01-01'::TIMESTAMPTZ)", Type: filter.TypeTimestamp},
+	"duration_seconds": {Expr: "COALESCE(duration_seconds, 0)", Type: filter.TypeInt},
+	"title":            {Expr: "title", Type: filter.TypeString},
+}
+
+// defaultListOrder keeps the historical newest-created-first ordering.
+var defaultListOrder = []filter.OrderTerm{
+	{Name: "created_at", Field: contentSortFields["created_at"], Desc: true},
+}
+
+// listCursorScope binds list page tokens to their ordering and filter so they
+// cannot be replayed against a different list or against search.
+func listCursorScope(orderTerms []filter.OrderTerm, filterExpr string) string {
+	return pagination.Scope("cms.ListContents", filter.FormatOrderBy(orderTerms), filterExpr)
+}
+
+// sortValue returns the value of c's sort field, as listed in
+// contentSortFields.
+func (c Content) sortValue(field string) interface{} {
+	switch field {
+	case "updated_at":
+		return c.UpdatedAt
+	case "published_at":
+		return c.PublishedAt
+	case "duration_seconds":
+		return c.DurationSeconds
+	case "title":
+		return c.Title
+	default:
+		return c.CreatedAt
+	}
+}
+
+func (cd *ContentData) decodeSearchCursor(pageToken string, scope string) (float64, time.Time, string, error) {
+	cursor, err := cd.cursors.Decode(pageToken, scope, 3)
+	if err != nil {
+		return 0, time.Time{}, "", err
+	}
+
+	score, err := strconv.ParseFloat(cursor.Keys[0], 64)
+	if err != nil {
+		return 0, time.Time{}, "", fmt.Errorf("%w: bad score", pagination.ErrInvalidToken)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, cursor.Keys[1])
+	if err != nil {
+		return 0, time.Time{}, "", fmt.Errorf("%w: bad created_at", pagination.ErrInvalidToken)
+	}
+
+	return score, createdAt, cursor.Keys[2], nil
+}
+
+// ReindexSearchText fills the normalized search columns of contents and tags
+// that were written without them, such as seed data or rows created before the
+// columns existed. It works in batches of batchSize rows and returns how many
+// rows it updated.
+func (cd *ContentData) ReindexSearchText(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	updated := 0
+	for {
+		rows, err := cd.db.QueryContext(ctx, `
+			SELECT id, title, description, platform_name
+			FROM contents
+			WHERE title_normalized IS NULL OR platform_name_normalized IS NULL
+			LIMIT $1`, batchSize)
+		if err != nil {
+			return updated, fmt.Errorf("failed to query contents to reindex: %w", err)
+		}
+
+		var batch []Content
+		for rows.Next() {
+			var content Content
+			var description, platformName sql.NullString
+			if err := rows.Scan(&content.ID, &content.Title, &description, &platformName); err != nil {
+				rows.Close()
+				return updated, fmt.Errorf("failed to scan content row: %w", err)
+			}
+			content.Description = description.String
+			content.PlatformName = platformName.String
+			batch = append(batch, content)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return updated, fmt.Errorf("error iterating over content rows: %w", err)
+		}
+
+		for _, content := range batch {
+			titleNormalized, descriptionNormalized, platformNameNormalized := cd.normalizeContentText(content)
+			_, err := cd.db.ExecContext(ctx, `
+				UPDATE contents
+				SET title_normalized = $1, description_normalized = $2, platform_name_normalized = $3
+				WHERE id = $4`, titleNormalized, descriptionNormalized, platformNameNormalized, content.ID)
+			if err != nil {
+				return updated, fmt.Errorf("failed to reindex content %s: %w", content.ID, err)
+			}
+			updated++
+		}
+
+		if len(batch) < batchSize {
+			break
+		}
+	}
+
+	rows, err := cd.db.QueryContext(ctx, `SELECT id, name FROM tags WHERE normalized_name IS NULL`)
+	if err != nil {
+		return updated, fmt.Errorf("failed to query tags to reindex: %w", err)
+	}
+
+	var tagIDs, tagNames []string
+	for rows.Next() {
+		var id, name string
+		if err := rows.Scan(&id, &name); err != nil {
+			rows.Close()
+			return updated, fmt.Errorf("failed to scan tag row: %w", err)
+		}
+		tagIDs = append(tagIDs, id)
+		tagNames = append(tagNames, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return updated, fmt.Errorf("error iterating over tag rows: %w", err)
+	}
+
+	for i, id := range tagIDs {
+		_, err := cd.db.ExecContext(ctx, `UPDATE tags SET normalized_name = $1 WHERE id = $2`, cd.normalizers.Query().Normalize(tagNames[i]), id)
+		if err != nil {
+			return updated, fmt.Errorf("failed to reindex tag %s: %w", tagNames[i], err)
+		}
+		updated++
+	}
+
+	return updated, nil
+}
+
+// normalizeContentText returns the normalized title, description and platform
+// name stored alongside a content for search. They are folded the same way as
+// queries, whatever the content's language, since titles often mix languages
+// and queries are folded for all of them.
+func (cd *ContentData) normalizeContentText(content Content) (string, string, string) {
+	normalizer := cd.normalizers.Query()
+	return normalizer.Normalize(content.Title), normalizer.Normalize(content.Description), normalizer.Normalize(content.PlatformName)
+}
+
+// loadTags fills in the tags of contents with a single query, so a page costs
+// the same number of queries whatever its size.
+func (cd *ContentData) loadTags(ctx context.Context, contents []*Content) error {
+	ids := make([]string, len(contents))
+	for i, content := range contents {
+		ids[i] = content.ID
+	}
+
+	tags, err := cd.getContentsTags(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get content tags: %w", err)
+	}
+
+	for _, content := range contents {
+		content.Tags = tags[content.ID]
+	}
+	return nil
+}
+
+// getContentsTags returns the tag names of each of contentIDs in one query,
+// keyed by content id. Contents without tags are absent from the map.
+func (cd *ContentData) getContentsTags(ctx context.Context, contentIDs []string) (map[string][]string, error) {
+	tags := make(map[string][]string, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return tags, nil
+	}
+
+	query := `
+		SELECT ct.content_id, t.name
+		FROM tags t
+		INNER JOIN content_tags ct ON t.id = ct.tag_id
+		WHERE ct.content_id = ANY($1::UUID[])
+		ORDER BY ct.content_id, t.name`
+
+	rows, err := cd.db.QueryContext(ctx, query, pq.Array(contentIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query content tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contentID, tagName string
+		if err := rows.Scan(&contentID, &tagName); err != nil {
+			return nil, fmt.Errorf("failed to scan tag name: %w", err)
+		}
+		tags[contentID] = append(tags[contentID], tagName)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over tag rows: %w", err)
+	}
+
+	return tags, nil
+}
+
 func (cd *ContentData) getContentTags(ctx context.Context, contentID string) ([]string, error) {
 	query := `
 		SELECT t.name 
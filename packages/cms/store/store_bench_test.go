@@ -0,0 +1,104 @@
+package store
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// benchmarkPageSizes are the page sizes the per-page query counts are
+// reported for; the count should be the same for all of them.
+var benchmarkPageSizes = []int{1, 10, 100}
+
+// benchmarkQueriesPerPage runs list against a mock database for each of
+// benchmarkPageSizes and reports the statements it sends per call as
+// "queries/op". expect sets up the statements for one call returning
+// pageSize rows.
+func benchmarkQueriesPerPage(b *testing.B, expect func(mock sqlmock.Sqlmock, pageSize int), list func(store Interface, pageSize int) error) {
+	for _, pageSize := range benchmarkPageSizes {
+		b.Run(fmt.Sprintf("page_size=%d", pageSize), func(b *testing.B) {
+			var queries int
+			matcher := sqlmock.QueryMatcherFunc(func(expectedSQL, actualSQL string) error {
+				queries++
+				return sqlmock.QueryMatcherRegexp.Match(expectedSQL, actualSQL)
+			})
+
+			db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(matcher))
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer db.Close()
+			store := New(db)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				expect(mock, pageSize)
+				if err := list(store, pageSize); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.StopTimer()
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				b.Fatal(err)
+			}
+			b.ReportMetric(float64(queries)/float64(b.N), "queries/op")
+		})
+	}
+}
+
+// benchmarkRows returns n content rows followed by extra columns, and the
+// tags of those contents as the page tags query returns them.
+func benchmarkRows(n int, extraColumns []string, extraValues ...driver.Value) (*sqlmock.Rows, *sqlmock.Rows) {
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	contents := sqlmock.NewRows(append([]string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "deleted_at",
+	}, extraColumns...))
+	tags := sqlmock.NewRows([]string{"content_id", "name"})
+
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("id%d", i)
+		values := append([]driver.Value{
+			id, "Planet Earth", "Wildlife", "en", 3600,
+			createdAt, "documentary", createdAt, createdAt, "https://example.com", "YouTube", nil,
+		}, extraValues...)
+		contents.AddRow(values...)
+		tags.AddRow(id, "nature").AddRow(id, "science")
+	}
+
+	return contents, tags
+}
+
+func BenchmarkListContents_QueriesPerPage(b *testing.B) {
+	ctx := context.Background()
+	benchmarkQueriesPerPage(b,
+		func(mock sqlmock.Sqlmock, pageSize int) {
+			contents, tags := benchmarkRows(pageSize, nil)
+			mock.ExpectQuery(`FROM contents WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC`).WillReturnRows(contents)
+			mock.ExpectQuery(pageTagsQuery).WillReturnRows(tags)
+		},
+		func(store Interface, pageSize int) error {
+			_, _, err := store.ListContents(ctx, int32(pageSize), "", "", "")
+			return err
+		})
+}
+
+func BenchmarkSearchContents_QueriesPerPage(b *testing.B) {
+	ctx := context.Background()
+	benchmarkQueriesPerPage(b,
+		func(mock sqlmock.Sqlmock, pageSize int) {
+			contents, tags := benchmarkRows(pageSize, []string{"max_similarity"}, 0.5)
+			mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold`).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectQuery(`FROM ranked ORDER BY max_similarity DESC, created_at DESC, id DESC`).WillReturnRows(contents)
+			mock.ExpectQuery(pageTagsQuery).WillReturnRows(tags)
+		},
+		func(store Interface, pageSize int) error {
+			_, _, err := store.SearchContents(ctx, "planet", int32(pageSize), "")
+			return err
+		})
+}
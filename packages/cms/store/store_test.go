@@ -3,14 +3,21 @@ package store
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/mosaibah/Mawjood/packages/filter"
+	"github.com/mosaibah/Mawjood/packages/pagination"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// pageTagsQuery matches the single query that loads the tags of a page.
+const pageTagsQuery = `SELECT ct\.content_id, t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = ANY\(\$1::UUID\[\]\) ORDER BY ct\.content_id, t\.name`
+
 func TestStoreInterface(t *testing.T) {
 	var _ Interface = &ContentData{}
 	assert.True(t, true, "ContentData successfully implements the Interface")
@@ -42,19 +49,20 @@ func TestCreateContent_Success(t *testing.T) {
 
 	mock.ExpectBegin()
 
-	mock.ExpectQuery(`INSERT INTO contents \(title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10\) RETURNING id, created_at, updated_at`).
+	mock.ExpectQuery(`INSERT INTO contents \(title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, title_normalized, description_normalized, platform_name_normalized\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13\) RETURNING id, created_at, updated_at, cluster_logical_timestamp\(\)`).
 		WithArgs(
 			content.Title, content.Description, content.Language, content.DurationSeconds,
 			content.PublishedAt, content.ContentType, sqlmock.AnyArg(), sqlmock.AnyArg(),
 			content.ExternalURL, content.PlatformName,
+			strings.ToLower(content.Title), strings.ToLower(content.Description), strings.ToLower(content.PlatformName),
 		).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
-			AddRow(contentID, createdAt, updatedAt))
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "cluster_logical_timestamp"}).
+			AddRow(contentID, createdAt, updatedAt, "1705312800123456789.0000000001"))
 
 	for _, tag := range content.Tags {
 		tagID := "tag-id-" + tag
-		mock.ExpectQuery(`INSERT INTO tags \(id, name\) VALUES \(gen_random_uuid\(\), \$1\) ON CONFLICT \(name\) DO UPDATE SET name = EXCLUDED\.name RETURNING id`).
-			WithArgs(tag).
+		mock.ExpectQuery(`INSERT INTO tags \(id, name, normalized_name\) VALUES \(gen_random_uuid\(\), \$1, \$2\) ON CONFLICT \(name\) DO UPDATE SET name = EXCLUDED\.name, normalized_name = EXCLUDED\.normalized_name RETURNING id`).
+			WithArgs(tag, strings.ToLower(tag)).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(tagID))
 
 		mock.ExpectExec(`INSERT INTO content_tags \(content_id, tag_id\) VALUES \(\$1, \$2\) ON CONFLICT \(content_id, tag_id\) DO NOTHING`).
@@ -76,6 +84,7 @@ func TestCreateContent_Success(t *testing.T) {
 	assert.Equal(t, content.DurationSeconds, result.DurationSeconds)
 	assert.Equal(t, content.ContentType, result.ContentType)
 	assert.Equal(t, content.Tags, result.Tags)
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.UTC), result.CommittedAt)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -111,6 +120,70 @@ func TestCreateContent_DatabaseError(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestCreateContents_OneTransaction(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	contents := []Content{
+		{Title: "Episode 2", Language: "en", ContentType: "podcast"},
+		{Title: "Episode 1", Language: "en", ContentType: "podcast", Tags: []string{"science"}},
+	}
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO contents`).
+		WithArgs("Episode 2", "", "en", int32(0), time.Time{}, "podcast", sqlmock.AnyArg(), sqlmock.AnyArg(), "", "", "episode 2", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "cluster_logical_timestamp"}).
+			AddRow("id-2", now, now, "1705312800123456789.0000000001"))
+	mock.ExpectQuery(`INSERT INTO contents`).
+		WithArgs("Episode 1", "", "en", int32(0), time.Time{}, "podcast", sqlmock.AnyArg(), sqlmock.AnyArg(), "", "", "episode 1", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "cluster_logical_timestamp"}).
+			AddRow("id-1", now, now, "1705312800123456789.0000000001"))
+	mock.ExpectQuery(`INSERT INTO tags`).
+		WithArgs("science", "science").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("tag-science"))
+	mock.ExpectExec(`INSERT INTO content_tags`).
+		WithArgs("id-1", "tag-science").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	created, err := store.CreateContents(context.Background(), contents)
+
+	require.NoError(t, err)
+	require.Len(t, created, 2)
+	assert.Equal(t, "id-2", created[0].ID)
+	assert.Equal(t, "id-1", created[1].ID)
+	for _, content := range created {
+		assert.Equal(t, time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.UTC), content.CommittedAt)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateContents_RollsBackOnError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO contents`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "cluster_logical_timestamp"}).
+			AddRow("id-1", now, now, "1705312800123456789.0000000001"))
+	mock.ExpectQuery(`INSERT INTO contents`).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	created, err := store.CreateContents(context.Background(), []Content{{Title: "A"}, {Title: "B"}})
+
+	assert.Nil(t, created)
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUpdateContent_Success(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -138,14 +211,15 @@ func TestUpdateContent_Success(t *testing.T) {
 
 	mock.ExpectBegin()
 
-	mock.ExpectQuery(`UPDATE contents SET title = \$1, description = \$2, language = \$3, duration_seconds = \$4, published_at = \$5, content_type = \$6, updated_at = \$7, url = \$8, platform_name = \$9 WHERE id = \$10 AND deleted_at IS NULL RETURNING created_at, updated_at`).
+	mock.ExpectQuery(`UPDATE contents SET title = \$1, description = \$2, language = \$3, duration_seconds = \$4, published_at = \$5, content_type = \$6, updated_at = \$7, url = \$8, platform_name = \$9, title_normalized = \$10, description_normalized = \$11, platform_name_normalized = \$12 WHERE id = \$13 AND deleted_at IS NULL RETURNING created_at, updated_at, cluster_logical_timestamp\(\)`).
 		WithArgs(
 			content.Title, content.Description, content.Language, content.DurationSeconds,
 			content.PublishedAt, content.ContentType, sqlmock.AnyArg(),
-			content.ExternalURL, content.PlatformName, contentID,
+			content.ExternalURL, content.PlatformName,
+			strings.ToLower(content.Title), strings.ToLower(content.Description), strings.ToLower(content.PlatformName), contentID,
 		).
-		WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).
-			AddRow(createdAt, updatedAt))
+		WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at", "cluster_logical_timestamp"}).
+			AddRow(createdAt, updatedAt, "1705312800123456789.0000000001"))
 
 	mock.ExpectExec(`DELETE FROM content_tags WHERE content_id = \$1`).
 		WithArgs(contentID).
@@ -153,8 +227,8 @@ func TestUpdateContent_Success(t *testing.T) {
 
 	for _, tag := range content.Tags {
 		tagID := "tag-id-" + tag
-		mock.ExpectQuery(`INSERT INTO tags \(id, name\) VALUES \(gen_random_uuid\(\), \$1\) ON CONFLICT \(name\) DO UPDATE SET name = EXCLUDED\.name RETURNING id`).
-			WithArgs(tag).
+		mock.ExpectQuery(`INSERT INTO tags \(id, name, normalized_name\) VALUES \(gen_random_uuid\(\), \$1, \$2\) ON CONFLICT \(name\) DO UPDATE SET name = EXCLUDED\.name, normalized_name = EXCLUDED\.normalized_name RETURNING id`).
+			WithArgs(tag, strings.ToLower(tag)).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(tagID))
 
 		mock.ExpectExec(`INSERT INTO content_tags \(content_id, tag_id\) VALUES \(\$1, \$2\) ON CONFLICT \(content_id, tag_id\) DO NOTHING`).
@@ -174,6 +248,7 @@ func TestUpdateContent_Success(t *testing.T) {
 	assert.Equal(t, content.Description, result.Description)
 	assert.Equal(t, content.Tags, result.Tags)
 	assert.Equal(t, createdAt.Format(time.RFC3339), result.CreatedAt.Format(time.RFC3339))
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.UTC), result.CommittedAt)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -200,7 +275,7 @@ func TestUpdateContent_NotFound(t *testing.T) {
 	mock.ExpectQuery(`UPDATE contents SET`).
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
 			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
-			sqlmock.AnyArg(), contentID).
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), contentID).
 		WillReturnError(sql.ErrNoRows)
 
 	mock.ExpectRollback()
@@ -223,13 +298,14 @@ func TestDeleteContent_Success(t *testing.T) {
 	ctx := context.Background()
 	contentID := "550e8400-e29b-41d4-a716-446655440000"
 
-	mock.ExpectExec(`UPDATE contents SET deleted_at = \$1, updated_at = \$1 WHERE id = \$2 AND deleted_at IS NULL`).
+	mock.ExpectQuery(`UPDATE contents SET deleted_at = \$1, updated_at = \$1 WHERE id = \$2 AND deleted_at IS NULL RETURNING cluster_logical_timestamp\(\)`).
 		WithArgs(sqlmock.AnyArg(), contentID).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+		WillReturnRows(sqlmock.NewRows([]string{"cluster_logical_timestamp"}).AddRow("1705312800123456789.0000000001"))
 
-	err = store.DeleteContent(ctx, contentID)
+	committedAt, err := store.DeleteContent(ctx, contentID)
 
 	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.UTC), committedAt)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -243,11 +319,11 @@ func TestDeleteContent_NotFound(t *testing.T) {
 	ctx := context.Background()
 	contentID := "550e8400-e29b-41d4-a716-446655440999"
 
-	mock.ExpectExec(`UPDATE contents SET deleted_at = \$1, updated_at = \$1 WHERE id = \$2 AND deleted_at IS NULL`).
+	mock.ExpectQuery(`UPDATE contents SET deleted_at = \$1, updated_at = \$1 WHERE id = \$2 AND deleted_at IS NULL RETURNING cluster_logical_timestamp\(\)`).
 		WithArgs(sqlmock.AnyArg(), contentID).
-		WillReturnResult(sqlmock.NewResult(0, 0))
+		WillReturnRows(sqlmock.NewRows([]string{"cluster_logical_timestamp"}))
 
-	err = store.DeleteContent(ctx, contentID)
+	_, err = store.DeleteContent(ctx, contentID)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "not found or already deleted")
@@ -332,21 +408,17 @@ func TestListContents_Success(t *testing.T) {
 		time.Date(2024, 1, 16, 12, 0, 0, 0, time.UTC), "documentary", createdAt2, createdAt2, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "Platform 2", nil,
 	)
 
-	mock.ExpectQuery(`SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, deleted_at FROM contents WHERE deleted_at IS NULL ORDER BY created_at DESC LIMIT \$1`).
+	mock.ExpectQuery(`SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, deleted_at FROM contents WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC LIMIT \$1`).
 		WithArgs(11).
 		WillReturnRows(contentRows)
 
-	tagRows1 := sqlmock.NewRows([]string{"name"}).AddRow("tech")
-	mock.ExpectQuery(`SELECT t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = \$1 ORDER BY t\.name`).
-		WithArgs("id1").
-		WillReturnRows(tagRows1)
-
-	tagRows2 := sqlmock.NewRows([]string{"name"}).AddRow("science")
-	mock.ExpectQuery(`SELECT t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = \$1 ORDER BY t\.name`).
-		WithArgs("id2").
-		WillReturnRows(tagRows2)
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1", "id2"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow("id1", "tech").
+			AddRow("id2", "science"))
 
-	contents, nextPageToken, err := store.ListContents(ctx, 10, "")
+	contents, nextPageToken, err := store.ListContents(ctx, 10, "", "", "")
 
 	require.NoError(t, err)
 	assert.Len(t, contents, 2)
@@ -366,3 +438,266 @@ func TestListContents_Success(t *testing.T) {
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestListContents_PageTokenRoundTrip(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db, WithCursorCodec(pagination.NewCodec([]byte("test-secret"), time.Hour)))
+	ctx := context.Background()
+
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	firstPageRows := sqlmock.NewRows([]string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "deleted_at",
+	}).AddRow(
+		"id1", "Content 1", "Description 1", "en", 1800,
+		createdAt, "podcast", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "Platform 1", nil,
+	).AddRow(
+		"id2", "Content 2", "Description 2", "en", 1800,
+		createdAt, "podcast", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "Platform 1", nil,
+	)
+
+	mock.ExpectQuery(`FROM contents WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC LIMIT \$1`).
+		WithArgs(2).
+		WillReturnRows(firstPageRows)
+
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	contents, nextPageToken, err := store.ListContents(ctx, 1, "", "", "")
+
+	require.NoError(t, err)
+	assert.Len(t, contents, 1)
+	require.NotEmpty(t, nextPageToken)
+
+	// id2 shares id1's created_at, so the id tie-breaker must keep it on the next page
+	mock.ExpectQuery(`FROM contents WHERE deleted_at IS NULL AND \(created_at, id\) < \(\$1, \$2\) ORDER BY created_at DESC, id DESC LIMIT \$3`).
+		WithArgs(createdAt, "id1", 2).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "deleted_at",
+		}))
+
+	_, _, err = store.ListContents(ctx, 1, nextPageToken, "", "")
+	require.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContents_PagesAcrossNullPublishedAt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db, WithCursorCodec(pagination.NewCodec([]byte("test-secret"), time.Hour)))
+	ctx := context.Background()
+
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	publishedAt := time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)
+	columns := []string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "deleted_at",
+	}
+	row := func(rows *sqlmock.Rows, id string, publishedAt interface{}) *sqlmock.Rows {
+		return rows.AddRow(id, "Title", "Description", "en", 1800, publishedAt, "podcast", createdAt, createdAt, "https://example.com", "YouTube", nil)
+	}
+	const firstPage = `FROM contents WHERE deleted_at IS NULL ORDER BY COALESCE\(published_at, '0001-01-01'::TIMESTAMPTZ\) DESC, id DESC LIMIT \$1`
+	const nextPage = `FROM contents WHERE deleted_at IS NULL AND \(COALESCE\(published_at, '0001-01-01'::TIMESTAMPTZ\), id\) < \(\$1, \$2\) ORDER BY COALESCE\(published_at, '0001-01-01'::TIMESTAMPTZ\) DESC, id DESC LIMIT \$3`
+
+	mock.ExpectQuery(firstPage).
+		WithArgs(2).
+		WillReturnRows(row(row(sqlmock.NewRows(columns), "id3", publishedAt), "id2", nil))
+	mock.ExpectQuery(pageTagsQuery).WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	contents, nextPageToken, err := store.ListContents(ctx, 1, "", "published_at desc", "")
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	require.NotEmpty(t, nextPageToken)
+
+	// id2 and id1 have no published_at; they sort as the zero time the token
+	// encodes, so the comparison neither skips nor repeats them.
+	mock.ExpectQuery(nextPage).
+		WithArgs(publishedAt, "id3", 2).
+		WillReturnRows(row(row(sqlmock.NewRows(columns), "id2", nil), "id1", nil))
+	mock.ExpectQuery(pageTagsQuery).WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	contents, nextPageToken, err = store.ListContents(ctx, 1, nextPageToken, "published_at desc", "")
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	assert.Equal(t, "id2", contents[0].ID)
+	assert.True(t, contents[0].PublishedAt.IsZero())
+	require.NotEmpty(t, nextPageToken)
+
+	mock.ExpectQuery(nextPage).
+		WithArgs(time.Time{}, "id2", 2).
+		WillReturnRows(row(sqlmock.NewRows(columns), "id1", nil))
+	mock.ExpectQuery(pageTagsQuery).WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	contents, nextPageToken, err = store.ListContents(ctx, 1, nextPageToken, "published_at desc", "")
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	assert.Equal(t, "id1", contents[0].ID)
+	assert.Empty(t, nextPageToken)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContents_InvalidPageToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+
+	contents, nextPageToken, err := store.ListContents(context.Background(), 10, "550e8400-e29b-41d4-a716-446655440000", "", "")
+
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+	assert.Nil(t, contents)
+	assert.Empty(t, nextPageToken)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContents_WithTagFilterAndTitleOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`FROM contents WHERE deleted_at IS NULL AND \(id IN \( SELECT fct\.content_id FROM content_tags fct INNER JOIN tags ft ON fct\.tag_id = ft\.id WHERE ft\.name = \$1\) AND NOT \(platform_name = \$2\)\) ORDER BY title DESC, id DESC LIMIT \$3`).
+		WithArgs("science", "Spotify", 11).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "deleted_at",
+		}))
+
+	contents, nextPageToken, err := store.ListContents(ctx, 10, "", "title desc", `tags:"science" AND NOT platform_name = "Spotify"`)
+
+	require.NoError(t, err)
+	assert.Empty(t, contents)
+	assert.Empty(t, nextPageToken)
+
+	_, _, err = store.ListContents(ctx, 10, "", "", `deleted_at != "x"`)
+	assert.ErrorIs(t, err, filter.ErrInvalidFilter)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateContent_NormalizesArabicSearchText(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	content := Content{
+		Title:        "أَحْكَامُ الصَّلاة",
+		Description:  "شـــرح مبسّط",
+		Tags:         []string{"فقه"},
+		Language:     "ar",
+		ContentType:  "podcast",
+		ExternalURL:  "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG",
+		PlatformName: "YouTube",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO contents`).
+		WithArgs(
+			content.Title, content.Description, content.Language, content.DurationSeconds,
+			content.PublishedAt, content.ContentType, sqlmock.AnyArg(), sqlmock.AnyArg(),
+			content.ExternalURL, content.PlatformName,
+			"احكام الصلاه", "شرح مبسط", "youtube",
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "cluster_logical_timestamp"}).
+			AddRow("content-id", time.Now(), time.Now(), "1705312800123456789.0000000001"))
+	mock.ExpectQuery(`INSERT INTO tags`).
+		WithArgs("فقه", "فقه").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("tag-id"))
+	mock.ExpectExec(`INSERT INTO content_tags`).
+		WithArgs("content-id", "tag-id").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	_, err = store.CreateContent(ctx, content)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateContent_NormalizesArabicSearchTextInAnyLanguage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	content := Content{
+		Title:        "مقابلة مع أحمد",
+		Description:  "Interview in English about الإسلام",
+		Language:     "en",
+		ContentType:  "podcast",
+		PlatformName: "إذاعة القرآن",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO contents`).
+		WithArgs(
+			content.Title, content.Description, content.Language, content.DurationSeconds,
+			content.PublishedAt, content.ContentType, sqlmock.AnyArg(), sqlmock.AnyArg(),
+			content.ExternalURL, content.PlatformName,
+			"مقابله مع احمد", "interview in english about الاسلام", "اذاعه القران",
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "cluster_logical_timestamp"}).
+			AddRow("content-id", time.Now(), time.Now(), "1705312800123456789.0000000001"))
+	mock.ExpectCommit()
+
+	_, err = store.CreateContent(ctx, content)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReindexSearchText(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`SELECT id, title, description, platform_name FROM contents WHERE title_normalized IS NULL OR platform_name_normalized IS NULL LIMIT \$1`).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "platform_name"}).
+			AddRow("id1", "Arabic Tech Talk", "مناقشات حول الذكاء الاصطناعي والابتكار", "إذاعة").
+			AddRow("id2", "Science Friday", nil, nil))
+	mock.ExpectExec(`UPDATE contents SET title_normalized = \$1, description_normalized = \$2, platform_name_normalized = \$3 WHERE id = \$4`).
+		WithArgs("arabic tech talk", "مناقشات حول الذكاء الاصطناعي والابتكار", "اذاعه", "id1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE contents SET title_normalized = \$1, description_normalized = \$2, platform_name_normalized = \$3 WHERE id = \$4`).
+		WithArgs("science friday", "", "", "id2").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	mock.ExpectQuery(`SELECT id, title, description, platform_name FROM contents WHERE title_normalized IS NULL OR platform_name_normalized IS NULL LIMIT \$1`).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "description", "platform_name"}))
+
+	mock.ExpectQuery(`SELECT id, name FROM tags WHERE normalized_name IS NULL`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow("tag1", "True-Crime"))
+	mock.ExpectExec(`UPDATE tags SET normalized_name = \$1 WHERE id = \$2`).
+		WithArgs("true-crime", "tag1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	updated, err := store.ReindexSearchText(ctx, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, updated)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,187 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
+
+	"github.com/mosaibah/Mawjood/packages/cms/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultAnalyticsRange is the period a search analytics report covers when
+// the request does not set start_time.
+const defaultAnalyticsRange = 7 * 24 * time.Hour
+
+// queryStatsReport loads one search analytics report from the store.
+type queryStatsReport func(ctx context.Context, start, end time.Time, limit int) ([]store.QueryStats, error)
+
+func (cs *CMSService) ListTopSearchQueries(ctx context.Context, req *mawjoodv1.SearchAnalyticsRequest) (*mawjoodv1.SearchAnalyticsResponse, error) {
+	return cs.listSearchQueries(ctx, "ListTopSearchQueries", req, cs.store.TopSearchQueries)
+}
+
+func (cs *CMSService) ListZeroResultSearchQueries(ctx context.Context, req *mawjoodv1.SearchAnalyticsRequest) (*mawjoodv1.SearchAnalyticsResponse, error) {
+	return cs.listSearchQueries(ctx, "ListZeroResultSearchQueries", req, cs.store.ZeroResultSearchQueries)
+}
+
+func (cs *CMSService) ListTrendingSearchQueries(ctx context.Context, req *mawjoodv1.SearchAnalyticsRequest) (*mawjoodv1.SearchAnalyticsResponse, error) {
+	return cs.listSearchQueries(ctx, "ListTrendingSearchQueries", req, cs.store.TrendingSearchQueries)
+}
+
+func (cs *CMSService) listSearchQueries(ctx context.Context, method string, req *mawjoodv1.SearchAnalyticsRequest, report queryStatsReport) (*mawjoodv1.SearchAnalyticsResponse, error) {
+	log.Printf("%s started", method)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	start, end, err := parseAnalyticsRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := report(ctx, start, end, int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get search analytics: %v", err)
+	}
+
+	protoStats := make([]*mawjoodv1.QueryStats, len(stats))
+	for i, s := range stats {
+		protoStats[i] = storeQueryStatsToProto(&s)
+	}
+
+	log.Printf("%s completed successfully - count: %d", method, len(stats))
+
+	return &mawjoodv1.SearchAnalyticsResponse{Queries: protoStats}, nil
+}
+
+func (cs *CMSService) ExportSearchAnalytics(ctx context.Context, req *mawjoodv1.ExportSearchAnalyticsRequest) (*mawjoodv1.ExportSearchAnalyticsResponse, error) {
+	log.Printf("ExportSearchAnalytics started - report: %s", req.Report)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	start, end, err := parseAnalyticsRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var name string
+	var report queryStatsReport
+	switch req.Report {
+	case mawjoodv1.SearchReport_SEARCH_REPORT_TOP_QUERIES:
+		name, report = "top-queries", cs.store.TopSearchQueries
+	case mawjoodv1.SearchReport_SEARCH_REPORT_ZERO_RESULT_QUERIES:
+		name, report = "zero-result-queries", cs.store.ZeroResultSearchQueries
+	case mawjoodv1.SearchReport_SEARCH_REPORT_TRENDING_QUERIES:
+		name, report = "trending-queries", cs.store.TrendingSearchQueries
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported report: %s", req.Report)
+	}
+
+	stats, err := report(ctx, start, end, int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get search analytics: %v", err)
+	}
+
+	data, err := queryStatsToCSV(stats)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write csv: %v", err)
+	}
+
+	log.Printf("ExportSearchAnalytics completed successfully - count: %d", len(stats))
+
+	return &mawjoodv1.ExportSearchAnalyticsResponse{
+		Filename: fmt.Sprintf("%s-%s-%s.csv", name, start.Format("20060102"), end.Format("20060102")),
+		Csv:      data,
+	}, nil
+}
+
+// parseAnalyticsRange resolves the report range, defaulting end to now and
+// start to defaultAnalyticsRange before end.
+func parseAnalyticsRange(startTime, endTime string) (time.Time, time.Time, error) {
+	end := time.Now().UTC()
+	if endTime != "" {
+		parsed, err := time.Parse(time.RFC3339, endTime)
+		if err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "invalid end_time format: %v", err)
+		}
+		end = parsed
+	}
+
+	start := end.Add(-defaultAnalyticsRange)
+	if startTime != "" {
+		parsed, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "invalid start_time format: %v", err)
+		}
+		start = parsed
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "start_time must be before end_time")
+	}
+
+	return start, end, nil
+}
+
+func queryStatsToCSV(stats []store.QueryStats) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	w.Write([]string{"query", "searches", "zero_result_searches", "avg_result_count", "avg_latency_ms", "avg_page_depth", "previous_searches", "growth"})
+	for _, s := range stats {
+		record := []string{
+			s.Query,
+			strconv.FormatInt(s.Searches, 10),
+			strconv.FormatInt(s.ZeroResultSearches, 10),
+			strconv.FormatFloat(s.AvgResultCount, 'f', 2, 64),
+			strconv.FormatFloat(s.AvgLatencyMs, 'f', 2, 64),
+			strconv.FormatFloat(s.AvgPageDepth, 'f', 2, 64),
+			strconv.FormatInt(s.PreviousSearches, 10),
+			strconv.FormatFloat(s.Growth, 'f', 2, 64),
+		}
+		for i := range record {
+			record[i] = csvCell(record[i])
+		}
+		w.Write(record)
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// csvCell escapes a value a spreadsheet would otherwise evaluate as a formula.
+// Queries are typed by users, so one starting with =, +, -, @, tab or carriage
+// return is prefixed with a quote and opened as plain text.
+func csvCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func storeQueryStatsToProto(stats *store.QueryStats) *mawjoodv1.QueryStats {
+	return &mawjoodv1.QueryStats{
+		Query:              stats.Query,
+		Searches:           stats.Searches,
+		ZeroResultSearches: stats.ZeroResultSearches,
+		AvgResultCount:     stats.AvgResultCount,
+		AvgLatencyMs:       stats.AvgLatencyMs,
+		AvgPageDepth:       stats.AvgPageDepth,
+		PreviousSearches:   stats.PreviousSearches,
+		Growth:             stats.Growth,
+	}
+}
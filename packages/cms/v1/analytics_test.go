@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
+	"github.com/mosaibah/Mawjood/packages/cms/mock"
+	"github.com/mosaibah/Mawjood/packages/cms/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListTopSearchQueries(t *testing.T) {
+	service := New(&mock.MockContentData{})
+
+	resp, err := service.ListTopSearchQueries(context.Background(), &mawjoodv1.SearchAnalyticsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Queries, 2)
+	assert.Equal(t, "planet earth", resp.Queries[0].Query)
+	assert.Equal(t, int64(42), resp.Queries[0].Searches)
+	assert.Equal(t, int64(3), resp.Queries[1].ZeroResultSearches)
+}
+
+func TestListTrendingSearchQueries(t *testing.T) {
+	service := New(&mock.MockContentData{})
+
+	resp, err := service.ListTrendingSearchQueries(context.Background(), &mawjoodv1.SearchAnalyticsRequest{
+		StartTime: "2024-01-08T00:00:00Z",
+		EndTime:   "2024-01-15T00:00:00Z",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Queries, 1)
+	assert.Equal(t, int64(10), resp.Queries[0].PreviousSearches)
+	assert.Equal(t, 3.2, resp.Queries[0].Growth)
+}
+
+func TestListSearchQueries_InvalidRange(t *testing.T) {
+	service := New(&mock.MockContentData{})
+
+	for _, req := range []*mawjoodv1.SearchAnalyticsRequest{
+		{StartTime: "2024-01-15T00:00:00Z", EndTime: "2024-01-08T00:00:00Z"},
+		{StartTime: "yesterday"},
+		{Limit: 5000},
+	} {
+		resp, err := service.ListZeroResultSearchQueries(context.Background(), req)
+
+		assert.Nil(t, resp)
+		statusErr, ok := status.FromError(err)
+		require.True(t, ok, "Expected gRPC status error")
+		assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+	}
+}
+
+func TestExportSearchAnalytics(t *testing.T) {
+	service := New(&mock.MockContentData{})
+
+	resp, err := service.ExportSearchAnalytics(context.Background(), &mawjoodv1.ExportSearchAnalyticsRequest{
+		Report:    mawjoodv1.SearchReport_SEARCH_REPORT_ZERO_RESULT_QUERIES,
+		StartTime: "2024-01-08T00:00:00Z",
+		EndTime:   "2024-01-15T00:00:00Z",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "zero-result-queries-20240108-20240115.csv", resp.Filename)
+	assert.Equal(t, "query,searches,zero_result_searches,avg_result_count,avg_latency_ms,avg_page_depth,previous_searches,growth\n"+
+		"\"quantum, \"\"cats\"\"\",5,5,0.00,9.00,1.00,0,0.00\n", string(resp.Csv))
+}
+
+func TestQueryStatsToCSV_EscapesFormulas(t *testing.T) {
+	data, err := queryStatsToCSV([]store.QueryStats{
+		{Query: "=HYPERLINK(\"http://evil.example\")", Searches: 1},
+		{Query: "+1", Searches: 1},
+		{Query: "-1", Searches: 1, Growth: -0.5},
+		{Query: "@SUM(A1)", Searches: 1},
+		{Query: "\tcats", Searches: 1},
+		{Query: "\rcats", Searches: 1},
+		{Query: "planet earth", Searches: 1},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "query,searches,zero_result_searches,avg_result_count,avg_latency_ms,avg_page_depth,previous_searches,growth\n"+
+		"\"'=HYPERLINK(\"\"http://evil.example\"\")\",1,0,0.00,0.00,0.00,0,0.00\n"+
+		"'+1,1,0,0.00,0.00,0.00,0,0.00\n"+
+		"'-1,1,0,0.00,0.00,0.00,0,'-0.50\n"+
+		"'@SUM(A1),1,0,0.00,0.00,0.00,0,0.00\n"+
+		"'\tcats,1,0,0.00,0.00,0.00,0,0.00\n"+
+		"\"'\rcats\",1,0,0.00,0.00,0.00,0,0.00\n"+
+		"planet earth,1,0,0.00,0.00,0.00,0,0.00\n", string(data))
+}
+
+func TestExportSearchAnalytics_MissingReport(t *testing.T) {
+	service := New(&mock.MockContentData{})
+
+	resp, err := service.ExportSearchAnalytics(context.Background(), &mawjoodv1.ExportSearchAnalyticsRequest{})
+
+	assert.Nil(t, resp)
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	// "fmt"
@@ -10,18 +11,40 @@ import (
 	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
 
 	"github.com/mosaibah/Mawjood/packages/cms/store"
+	"github.com/mosaibah/Mawjood/packages/consistency"
+	"github.com/mosaibah/Mawjood/packages/filter"
+	"github.com/mosaibah/Mawjood/packages/pagination"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// maxImportItems caps how many contents one ImportFromExternal call creates,
+// keeping the transaction short.
+const maxImportItems = 200
+
 type CMSService struct {
 	mawjoodv1.UnimplementedCMSServiceServer
-	store store.Interface
+	store     store.Interface
+	providers mawjoodv1.ProvidersServiceClient
+}
+
+// Option configures a CMSService created by New.
+type Option func(*CMSService)
+
+// WithProviders resolves ImportFromExternal URLs through the ProvidersService
+// behind client. Without it no URL can be imported.
+func WithProviders(client mawjoodv1.ProvidersServiceClient) Option {
+	return func(cs *CMSService) {
+		cs.providers = client
+	}
 }
 
-func New(store store.Interface) *CMSService {
-	return &CMSService{store: store}
+func New(store store.Interface, opts ...Option) *CMSService {
+	cs := &CMSService{store: store}
+	for _, opt := range opts {
+		opt(cs)
+	}
+	return cs
 }
 
 func (cs *CMSService) CreateContent(ctx context.Context, req *mawjoodv1.CreateContentRequest) (*mawjoodv1.Content, error) {
@@ -61,7 +84,9 @@ func (cs *CMSService) CreateContent(ctx context.Context, req *mawjoodv1.CreateCo
 
 	log.Printf("CreateContent completed successfully - ID: %s", createdContent.ID)
 
-	return cs.storeContentToProto(createdContent), nil
+	resp := cs.storeContentToProto(createdContent)
+	resp.ConsistencyToken = consistency.Encode(createdContent.CommittedAt)
+	return resp, nil
 }
 
 func (cs *CMSService) UpdateContent(ctx context.Context, req *mawjoodv1.UpdateContentRequest) (*mawjoodv1.Content, error) {
@@ -103,24 +128,26 @@ func (cs *CMSService) UpdateContent(ctx context.Context, req *mawjoodv1.UpdateCo
 
 	log.Printf("UpdateContent completed successfully - ID: %s", updatedContent.ID)
 
-	return cs.storeContentToProto(updatedContent), nil
+	resp := cs.storeContentToProto(updatedContent)
+	resp.ConsistencyToken = consistency.Encode(updatedContent.CommittedAt)
+	return resp, nil
 }
 
-func (cs *CMSService) DeleteContent(ctx context.Context, req *mawjoodv1.DeleteContentRequest) (*emptypb.Empty, error) {
+func (cs *CMSService) DeleteContent(ctx context.Context, req *mawjoodv1.DeleteContentRequest) (*mawjoodv1.DeleteContentResponse, error) {
 	log.Printf("DeleteContent started - ID: %s", req.Id)
 
 	if err := req.Validate(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
-	err := cs.store.DeleteContent(ctx, req.Id)
+	committedAt, err := cs.store.DeleteContent(ctx, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete content: %v", err)
 	}
 
 	log.Printf("DeleteContent completed successfully - ID: %s", req.Id)
 
-	return &emptypb.Empty{}, nil
+	return &mawjoodv1.DeleteContentResponse{ConsistencyToken: consistency.Encode(committedAt)}, nil
 }
 
 func (cs *CMSService) ListContents(ctx context.Context, req *mawjoodv1.ListContentsRequest) (*mawjoodv1.ListContentsResponse, error) {
@@ -130,8 +157,14 @@ func (cs *CMSService) ListContents(ctx context.Context, req *mawjoodv1.ListConte
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
-	contents, nextPageToken, err := cs.store.ListContents(ctx, req.PageSize, req.PageToken)
+	contents, nextPageToken, err := cs.store.ListContents(ctx, req.PageSize, req.PageToken, req.OrderBy, req.Filter)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+		if errors.Is(err, filter.ErrInvalidFilter) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to list contents: %v", err)
 	}
 
@@ -154,8 +187,95 @@ func (cs *CMSService) ImportFromExternal(ctx context.Context, req *mawjoodv1.Imp
 	if err := req.Validate(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
+	if cs.providers == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot import %s: no providers service is configured", req.Url)
+	}
 
-	return nil, status.Error(codes.Unimplemented, "ImportFromExternal is not yet implemented")
+	preview, err := cs.providers.PreviewImport(ctx, &mawjoodv1.PreviewImportRequest{
+		Url:      req.Url,
+		AllItems: req.AllItems,
+		MaxItems: maxImportItems,
+	})
+	if err != nil {
+		// The providers service already maps platform failures onto codes the
+		// editor can act on; anything else is a failure of the service itself.
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.Canceled:
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to fetch metadata: %v", err)
+	}
+
+	items := preview.Items
+	switch {
+	case len(items) == 0:
+		return nil, status.Errorf(codes.NotFound, "nothing to import from %s", req.Url)
+	case preview.TotalItems > maxImportItems:
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot import %s: it lists %d contents, at most %d can be imported at once", req.Url, preview.TotalItems, maxImportItems)
+	}
+
+	contents := make([]store.Content, len(items))
+	for i, item := range items {
+		if item.Title == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot import %s: no title found", item.Url)
+		}
+		contents[i] = cs.previewToStoreContent(item)
+	}
+
+	created, err := cs.store.CreateContents(ctx, contents)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create content: %v", err)
+	}
+
+	protoContents := make([]*mawjoodv1.Content, len(created))
+	for i := range created {
+		protoContents[i] = cs.storeContentToProto(&created[i])
+		protoContents[i].ConsistencyToken = consistency.Encode(created[i].CommittedAt)
+	}
+
+	log.Printf("ImportFromExternal completed successfully - provider: %s, count: %d, URL: %s", preview.Provider, len(created), req.Url)
+
+	resp := &mawjoodv1.ImportResponse{Content: protoContents[0], MissingFields: missingFields(items)}
+	if req.AllItems {
+		resp.Contents = protoContents
+	}
+	return resp, nil
+}
+
+// missingFields returns the fields missing from any of items, each once.
+func missingFields(items []*mawjoodv1.ImportPreview) []string {
+	var missing []string
+	seen := map[string]bool{}
+	for _, item := range items {
+		for _, field := range item.MissingFields {
+			if !seen[field] {
+				seen[field] = true
+				missing = append(missing, field)
+			}
+		}
+	}
+	return missing
+}
+
+// previewToStoreContent maps an import preview onto a new content, defaulting
+// the content type to podcast like CreateContent does.
+func (cs *CMSService) previewToStoreContent(item *mawjoodv1.ImportPreview) store.Content {
+	var publishedAt time.Time
+	if item.PublishedAt != "" {
+		publishedAt, _ = time.Parse(time.RFC3339, item.PublishedAt)
+	}
+
+	return store.Content{
+		Title:           item.Title,
+		Description:     item.Description,
+		Tags:            item.Tags,
+		Language:        item.Language,
+		DurationSeconds: item.DurationSeconds,
+		PublishedAt:     publishedAt,
+		ContentType:     cs.protoContentTypeToString(item.ContentType),
+		ExternalURL:     item.Url,
+		PlatformName:    item.PlatformName,
+	}
 }
 
 func (cs *CMSService) protoContentTypeToString(contentType mawjoodv1.ContentType) string {
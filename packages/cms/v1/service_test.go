@@ -3,12 +3,15 @@ package v1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
 	"github.com/mosaibah/Mawjood/packages/cms/mock"
+	"github.com/mosaibah/Mawjood/packages/consistency"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -43,6 +46,10 @@ func TestCreateContent(t *testing.T) {
 	assert.Equal(t, mawjoodv1.ContentType_CONTENT_TYPE_PODCAST, resp.ContentType)
 	assert.NotEmpty(t, resp.CreatedAt)
 	assert.NotEmpty(t, resp.UpdatedAt)
+
+	committedAt, err := consistency.Decode(resp.ConsistencyToken)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), committedAt, time.Minute)
 }
 
 func TestUpdateContent(t *testing.T) {
@@ -87,6 +94,9 @@ func TestDeleteContent(t *testing.T) {
 
 	require.NoError(t, err)
 	require.NotNil(t, resp)
+
+	_, err = consistency.Decode(resp.ConsistencyToken)
+	assert.NoError(t, err)
 }
 
 func TestListContents(t *testing.T) {
@@ -122,23 +132,174 @@ func TestListContents(t *testing.T) {
 	assert.Empty(t, resp.NextPageToken)
 }
 
+// stubProvidersClient answers PreviewImport with a fixed preview, or fails
+// with err, and records the last request.
+type stubProvidersClient struct {
+	mawjoodv1.ProvidersServiceClient
+	preview *mawjoodv1.PreviewImportResponse
+	err     error
+	req     *mawjoodv1.PreviewImportRequest
+}
+
+func (c *stubProvidersClient) PreviewImport(ctx context.Context, req *mawjoodv1.PreviewImportRequest, opts ...grpc.CallOption) (*mawjoodv1.PreviewImportResponse, error) {
+	c.req = req
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.preview, nil
+}
+
+func previewOf(items ...*mawjoodv1.ImportPreview) *mawjoodv1.PreviewImportResponse {
+	return &mawjoodv1.PreviewImportResponse{Provider: "stub", Items: items, TotalItems: int32(len(items))}
+}
+
 func TestImportFromExternal(t *testing.T) {
-	mockStore := &mock.MockContentData{}
-	service := New(mockStore)
+	client := &stubProvidersClient{preview: previewOf(&mawjoodv1.ImportPreview{
+		Title:           "Episode 1",
+		Description:     "The first episode",
+		DurationSeconds: 1800,
+		PublishedAt:     "2024-01-15T10:00:00Z",
+		ContentType:     mawjoodv1.ContentType_CONTENT_TYPE_DOCUMENTARY,
+		PlatformName:    "Example",
+		Language:        "ar",
+		Tags:            []string{"history"},
+		Url:             "https://example.com/episodes/1",
+	})}
+	service := New(&mock.MockContentData{}, WithProviders(client))
+
+	resp, err := service.ImportFromExternal(context.Background(), &mawjoodv1.ImportRequest{Url: "https://example.com/episodes/1"})
 
-	req := &mawjoodv1.ImportRequest{
-		Url: "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG",
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/episodes/1", client.req.Url)
+	assert.False(t, client.req.AllItems)
+	require.NotNil(t, resp.Content)
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", resp.Content.Id)
+	assert.Equal(t, "Episode 1", resp.Content.Title)
+	assert.Equal(t, "The first episode", resp.Content.Description)
+	assert.Equal(t, int32(1800), resp.Content.DurationSeconds)
+	assert.Equal(t, "2024-01-15T10:00:00Z", resp.Content.PublishedAt)
+	assert.Equal(t, mawjoodv1.ContentType_CONTENT_TYPE_DOCUMENTARY, resp.Content.ContentType)
+	assert.Equal(t, "Example", resp.Content.PlatformName)
+	assert.Equal(t, "ar", resp.Content.Language)
+	assert.Equal(t, []string{"history"}, resp.Content.Tags)
+	assert.Equal(t, "https://example.com/episodes/1", resp.Content.Url)
+	assert.NotEmpty(t, resp.Content.ConsistencyToken)
+	assert.Empty(t, resp.MissingFields)
+	assert.Empty(t, resp.Contents)
+}
+
+func TestImportFromExternal_ReportsMissingFields(t *testing.T) {
+	client := &stubProvidersClient{preview: previewOf(&mawjoodv1.ImportPreview{
+		Title:         "Some page",
+		Description:   "A page without structured metadata",
+		PlatformName:  "example.com",
+		Url:           "https://example.com/page",
+		MissingFields: []string{"tags", "language", "duration_seconds", "published_at", "content_type"},
+	})}
+	service := New(&mock.MockContentData{}, WithProviders(client))
+
+	resp, err := service.ImportFromExternal(context.Background(), &mawjoodv1.ImportRequest{Url: "https://example.com/page"})
+
+	require.NoError(t, err)
+	assert.Equal(t, mawjoodv1.ContentType_CONTENT_TYPE_PODCAST, resp.Content.ContentType)
+	assert.Equal(t, []string{"tags", "language", "duration_seconds", "published_at", "content_type"}, resp.MissingFields)
+}
+
+func TestImportFromExternal_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  mawjoodv1.ProvidersServiceClient
+		code    codes.Code
+		message string
+	}{
+		{
+			name:    "no providers service",
+			code:    codes.FailedPrecondition,
+			message: "no providers service",
+		},
+		{
+			name:    "no provider",
+			client:  &stubProvidersClient{err: status.Error(codes.FailedPrecondition, "cannot import: no provider for url; supported providers: [stub]")},
+			code:    codes.FailedPrecondition,
+			message: "supported providers: [stub]",
+		},
+		{
+			name:   "not found",
+			client: &stubProvidersClient{err: status.Error(codes.NotFound, "content not found")},
+			code:   codes.NotFound,
+		},
+		{
+			name:   "providers service down",
+			client: &stubProvidersClient{err: status.Error(codes.Unavailable, "connection refused")},
+			code:   codes.Unavailable,
+		},
+		{
+			name:   "providers service bug",
+			client: &stubProvidersClient{err: status.Error(codes.Internal, "panic")},
+			code:   codes.Unavailable,
+		},
+		{
+			name:   "nothing to import",
+			client: &stubProvidersClient{preview: previewOf()},
+			code:   codes.NotFound,
+		},
+		{
+			name:    "no title",
+			client:  &stubProvidersClient{preview: previewOf(&mawjoodv1.ImportPreview{DurationSeconds: 60})},
+			code:    codes.FailedPrecondition,
+			message: "no title found",
+		},
 	}
 
-	resp, err := service.ImportFromExternal(context.Background(), req)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []Option
+			if tt.client != nil {
+				opts = append(opts, WithProviders(tt.client))
+			}
+			service := New(&mock.MockContentData{}, opts...)
+
+			resp, err := service.ImportFromExternal(context.Background(), &mawjoodv1.ImportRequest{Url: "https://example.com/episodes/1"})
+
+			assert.Nil(t, resp)
+			statusErr, ok := status.FromError(err)
+			require.True(t, ok, "Expected gRPC status error")
+			assert.Equal(t, tt.code, statusErr.Code())
+			assert.Contains(t, statusErr.Message(), tt.message)
+		})
+	}
+}
 
-	assert.Error(t, err)
-	assert.Nil(t, resp)
+func TestImportFromExternal_AllItems(t *testing.T) {
+	client := &stubProvidersClient{preview: previewOf(
+		&mawjoodv1.ImportPreview{Title: "Episode 2", ContentType: mawjoodv1.ContentType_CONTENT_TYPE_PODCAST, Url: "https://example.com/episodes/2", MissingFields: []string{"description", "tags"}},
+		&mawjoodv1.ImportPreview{Title: "Episode 1", ContentType: mawjoodv1.ContentType_CONTENT_TYPE_PODCAST, Url: "https://example.com/episodes/1", MissingFields: []string{"tags", "language"}},
+	)}
+	service := New(&mock.MockContentData{}, WithProviders(client))
 
-	statusErr, ok := status.FromError(err)
-	require.True(t, ok, "Expected gRPC status error")
-	assert.Equal(t, codes.Unimplemented, statusErr.Code())
-	assert.Equal(t, "ImportFromExternal is not yet implemented", statusErr.Message())
+	resp, err := service.ImportFromExternal(context.Background(), &mawjoodv1.ImportRequest{Url: "https://example.com/feed.xml", AllItems: true})
+
+	require.NoError(t, err)
+	assert.True(t, client.req.AllItems)
+	assert.Equal(t, int32(maxImportItems), client.req.MaxItems)
+	require.Len(t, resp.Contents, 2)
+	assert.Equal(t, "Episode 2", resp.Content.Title)
+	assert.Equal(t, "Episode 2", resp.Contents[0].Title)
+	assert.Equal(t, "https://example.com/episodes/1", resp.Contents[1].Url)
+	assert.NotEqual(t, resp.Contents[0].Id, resp.Contents[1].Id)
+	assert.Equal(t, resp.Contents[0].ConsistencyToken, resp.Contents[1].ConsistencyToken)
+	assert.Equal(t, []string{"description", "tags", "language"}, resp.MissingFields)
+}
+
+func TestImportFromExternal_TooManyItems(t *testing.T) {
+	preview := previewOf(&mawjoodv1.ImportPreview{Title: "Episode"})
+	preview.TotalItems = maxImportItems + 1
+	service := New(&mock.MockContentData{}, WithProviders(&stubProvidersClient{preview: preview}))
+
+	_, err := service.ImportFromExternal(context.Background(), &mawjoodv1.ImportRequest{Url: "https://example.com/feed.xml", AllItems: true})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "at most 200")
 }
 
 func TestCreateContent_InvalidPublishedAt(t *testing.T) {
@@ -193,3 +354,42 @@ func TestUpdateContent_InvalidPublishedAt(t *testing.T) {
 	require.True(t, ok, "Expected gRPC status error")
 	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
 }
+
+func TestListContents_InvalidPageToken(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.ListContentsRequest{
+		PageSize:  10,
+		PageToken: mock.InvalidPageToken,
+	}
+
+	resp, err := service.ListContents(context.Background(), req)
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+}
+
+func TestListContents_InvalidFilter(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.ListContentsRequest{
+		PageSize: 10,
+		Filter:   `language = "ar" AND`,
+	}
+
+	resp, err := service.ListContents(context.Background(), req)
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+	assert.Contains(t, statusErr.Message(), "position")
+}
@@ -0,0 +1,62 @@
+// Package consistency issues and reads the read-your-writes tokens that tie
+// the CMS and discovery services together.
+//
+// CMS write RPCs return a token carrying the commit timestamp of the write.
+// Passing it as min_consistency to a discovery read guarantees the read sees
+// that write, even when discovery otherwise serves stale data from follower
+// replicas, caches or its in-memory search index.
+//
+// Tokens are not signed: a forged token can at worst make a read fresher, and
+// so more expensive, than it needs to be.
+package consistency
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// tokenVersion is the first byte of every token, bumped whenever the layout
+// changes so that tokens from an older release are rejected instead of misread.
+const tokenVersion = 1
+
+// ErrInvalidToken is returned for tokens that are malformed or were issued by
+// an incompatible release.
+var ErrInvalidToken = errors.New("invalid consistency token")
+
+// Encode returns the token for a write committed at committedAt.
+func Encode(committedAt time.Time) string {
+	buf := make([]byte, 9)
+	buf[0] = tokenVersion
+	binary.BigEndian.PutUint64(buf[1:], uint64(committedAt.UnixNano()))
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// Decode returns the commit timestamp carried by token.
+func Decode(token string) (time.Time, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if len(buf) != 9 || buf[0] != tokenVersion {
+		return time.Time{}, fmt.Errorf("%w: unsupported format", ErrInvalidToken)
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(buf[1:]))).UTC(), nil
+}
+
+// ParseClusterTimestamp parses the value of CockroachDB's
+// cluster_logical_timestamp(), such as "1700000000123456789.0000000001": the
+// wall time in nanoseconds, then a logical counter. The counter only orders
+// transactions within the same nanosecond and is dropped.
+func ParseClusterTimestamp(s string) (time.Time, error) {
+	wall, _, _ := strings.Cut(s, ".")
+	nanos, err := strconv.ParseInt(wall, 10, 64)
+	if err != nil || nanos <= 0 {
+		return time.Time{}, fmt.Errorf("invalid cluster timestamp %q", s)
+	}
+	return time.Unix(0, nanos).UTC(), nil
+}
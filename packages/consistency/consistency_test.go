@@ -0,0 +1,43 @@
+package consistency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	committedAt := time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.UTC)
+
+	token := Encode(committedAt)
+	decoded, err := Decode(token)
+
+	require.NoError(t, err)
+	assert.True(t, committedAt.Equal(decoded))
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	valid := Encode(time.Now())
+
+	for _, token := range []string{"", "not base64!", valid[:len(valid)-2], "AgAAAAAAAAAA"} {
+		_, err := Decode(token)
+		assert.ErrorIs(t, err, ErrInvalidToken, token)
+	}
+}
+
+func TestParseClusterTimestamp(t *testing.T) {
+	ts, err := ParseClusterTimestamp("1705312800123456789.0000000001")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 0, 0, 123456789, time.UTC), ts)
+
+	ts, err = ParseClusterTimestamp("1705312800000000000")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), ts)
+
+	for _, s := range []string{"", "abc.1", "-5.0"} {
+		_, err := ParseClusterTimestamp(s)
+		assert.Error(t, err, s)
+	}
+}
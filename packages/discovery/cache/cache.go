@@ -0,0 +1,252 @@
+// Package cache provides a read-through cache in front of the discovery store.
+//
+// Discovery only reads the catalog, and a few hot contents, listing pages and
+// searches make up most of its traffic. Store keeps their results in a bounded
+// in-process LRU for a short TTL. Nothing invalidates an entry when the CMS
+// changes a content, so the TTLs bound how stale a response can be.
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+// Defaults for a Store created without options.
+const (
+	DefaultCapacity   = 10000
+	DefaultContentTTL = time.Minute
+	DefaultListTTL    = 30 * time.Second
+	DefaultSearchTTL  = 30 * time.Second
+)
+
+// Store is a store.Interface that caches GetContent, the first page of
+// ListContents and the first page of SearchContents, so popular contents and
+// queries are served from memory. Later pages, which are requested far less
+// often, consistent reads (see store.WithConsistentRead) and every other method
+// go straight to the wrapped store. It is safe for concurrent use.
+type Store struct {
+	store.Interface
+
+	entries    *lru
+	contentTTL time.Duration
+	listTTL    time.Duration
+	searchTTL  time.Duration
+	now        func() time.Time
+
+	getContent     counts
+	listContents   counts
+	searchContents counts
+}
+
+// Option configures a Store created by New.
+type Option func(*Store)
+
+// WithCapacity bounds the number of cached results across all methods.
+func WithCapacity(capacity int) Option {
+	return func(s *Store) {
+		if capacity > 0 {
+			s.entries = newLRU(capacity)
+		}
+	}
+}
+
+// WithContentTTL sets how long GetContent results are served from the cache.
+// Zero disables caching them.
+func WithContentTTL(ttl time.Duration) Option {
+	return func(s *Store) {
+		s.contentTTL = ttl
+	}
+}
+
+// WithListTTL sets how long first pages of ListContents are served from the
+// cache. Zero disables caching them.
+func WithListTTL(ttl time.Duration) Option {
+	return func(s *Store) {
+		s.listTTL = ttl
+	}
+}
+
+// WithSearchTTL sets how long first pages of SearchContents are served from
+// the cache. Zero disables caching them.
+func WithSearchTTL(ttl time.Duration) Option {
+	return func(s *Store) {
+		s.searchTTL = ttl
+	}
+}
+
+// New returns a Store caching the results of next.
+func New(next store.Interface, opts ...Option) *Store {
+	s := &Store{
+		Interface:  next,
+		entries:    newLRU(DefaultCapacity),
+		contentTTL: DefaultContentTTL,
+		listTTL:    DefaultListTTL,
+		searchTTL:  DefaultSearchTTL,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// listPage is a cached page of ListContents.
+type listPage struct {
+	contents      []store.Content
+	nextPageToken string
+}
+
+// searchPage is a cached page of SearchContents.
+type searchPage struct {
+	results       []store.SearchResult
+	nextPageToken string
+}
+
+func (s *Store) GetContent(ctx context.Context, id string) (*store.Content, error) {
+	if s.contentTTL <= 0 || store.IsConsistentRead(ctx) {
+		return s.Interface.GetContent(ctx, id)
+	}
+
+	key := cacheKey("GetContent", id)
+	if value, ok := s.get(key, &s.getContent); ok {
+		content := value.(store.Content)
+		return &content, nil
+	}
+
+	content, err := s.Interface.GetContent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.add(key, *content, s.contentTTL)
+
+	return content, nil
+}
+
+func (s *Store) ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]store.Content, string, error) {
+	if s.listTTL <= 0 || pageToken != "" || store.IsConsistentRead(ctx) {
+		return s.Interface.ListContents(ctx, pageSize, pageToken, orderBy, filterExpr)
+	}
+
+	key := cacheKey("ListContents", strconv.Itoa(int(pageSize)), orderBy, filterExpr)
+	if value, ok := s.get(key, &s.listContents); ok {
+		page := value.(listPage)
+		return append([]store.Content(nil), page.contents...), page.nextPageToken, nil
+	}
+
+	contents, nextPageToken, err := s.Interface.ListContents(ctx, pageSize, pageToken, orderBy, filterExpr)
+	if err != nil {
+		return nil, "", err
+	}
+	s.add(key, listPage{append([]store.Content(nil), contents...), nextPageToken}, s.listTTL)
+
+	return contents, nextPageToken, nil
+}
+
+func (s *Store) SearchContents(ctx context.Context, query string, filters store.SearchFilters, pageSize int32, pageToken string) ([]store.SearchResult, string, error) {
+	if s.searchTTL <= 0 || pageToken != "" || store.IsConsistentRead(ctx) {
+		return s.Interface.SearchContents(ctx, query, filters, pageSize, pageToken)
+	}
+
+	key := cacheKey("SearchContents", query, fmt.Sprintf("%#v", filters), strconv.Itoa(int(pageSize)))
+	if value, ok := s.get(key, &s.searchContents); ok {
+		page := value.(searchPage)
+		return append([]store.SearchResult(nil), page.results...), page.nextPageToken, nil
+	}
+
+	results, nextPageToken, err := s.Interface.SearchContents(ctx, query, filters, pageSize, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	s.add(key, searchPage{append([]store.SearchResult(nil), results...), nextPageToken}, s.searchTTL)
+
+	return results, nextPageToken, nil
+}
+
+// get looks key up, counting the hit or miss against method.
+func (s *Store) get(key string, method *counts) (interface{}, bool) {
+	value, ok := s.entries.get(key, s.now())
+	if ok {
+		method.hits.Add(1)
+	} else {
+		method.misses.Add(1)
+	}
+	return value, ok
+}
+
+func (s *Store) add(key string, value interface{}, ttl time.Duration) {
+	s.entries.add(key, value, s.now().Add(ttl))
+}
+
+// cacheKey joins a method name and its arguments into a key. Arguments are
+// quoted, so distinct calls always get distinct keys.
+func cacheKey(method string, args ...string) string {
+	key := method
+	for _, arg := range args {
+		key += "\x00" + strconv.Quote(arg)
+	}
+	return key
+}
+
+// counts tracks the lookups of one cached method.
+type counts struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+func (c *counts) snapshot() Counts {
+	return Counts{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
+
+// Counts are the cache lookups of one method since the Store was created.
+type Counts struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// HitRatio returns the share of lookups served from the cache, or zero when
+// there were none.
+func (c Counts) HitRatio() float64 {
+	total := c.Hits + c.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(c.Hits) / float64(total)
+}
+
+// Stats is a snapshot of a Store's cache usage.
+type Stats struct {
+	GetContent     Counts
+	ListContents   Counts
+	SearchContents Counts
+	// Entries is the number of cached results, including expired ones not
+	// yet dropped.
+	Entries int
+	// Evictions counts results dropped to make room for newer ones.
+	Evictions uint64
+}
+
+// String formats s for logging.
+func (s Stats) String() string {
+	format := func(c Counts) string {
+		return fmt.Sprintf("%d/%d (%.0f%%)", c.Hits, c.Hits+c.Misses, 100*c.HitRatio())
+	}
+	return fmt.Sprintf("entries: %d, evictions: %d, hits GetContent: %s, ListContents: %s, SearchContents: %s",
+		s.Entries, s.Evictions, format(s.GetContent), format(s.ListContents), format(s.SearchContents))
+}
+
+// Stats returns the hits and misses of each cached method and the size of the
+// cache.
+func (s *Store) Stats() Stats {
+	return Stats{
+		GetContent:     s.getContent.snapshot(),
+		ListContents:   s.listContents.snapshot(),
+		SearchContents: s.searchContents.snapshot(),
+		Entries:        s.entries.len(),
+		Evictions:      s.entries.evicted(),
+	}
+}
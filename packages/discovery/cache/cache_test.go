@@ -0,0 +1,249 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/mock"
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+const (
+	podcastID     = "550e8400-e29b-41d4-a716-446655440000"
+	documentaryID = "550e8400-e29b-41d4-a716-446655440001"
+)
+
+// countingStore counts the calls that reach the mock store.
+type countingStore struct {
+	mock.MockContentData
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func newCountingStore() *countingStore {
+	return &countingStore{calls: map[string]int{}}
+}
+
+func (s *countingStore) count(method string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[method]++
+}
+
+func (s *countingStore) called(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *countingStore) GetContent(ctx context.Context, id string) (*store.Content, error) {
+	s.count("GetContent")
+	return s.MockContentData.GetContent(ctx, id)
+}
+
+func (s *countingStore) ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]store.Content, string, error) {
+	s.count("ListContents")
+	return s.MockContentData.ListContents(ctx, pageSize, pageToken, orderBy, filterExpr)
+}
+
+func (s *countingStore) SearchContents(ctx context.Context, query string, filters store.SearchFilters, pageSize int32, pageToken string) ([]store.SearchResult, string, error) {
+	s.count("SearchContents")
+	return s.MockContentData.SearchContents(ctx, query, filters, pageSize, pageToken)
+}
+
+// clock is a settable time source for expiring entries.
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+func newTestStore(next store.Interface, opts ...Option) (*Store, *clock) {
+	c := &clock{now: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)}
+	s := New(next, opts...)
+	s.now = c.Now
+	return s, c
+}
+
+func TestGetContent_ServesFromCacheUntilExpiry(t *testing.T) {
+	ctx := context.Background()
+	next := newCountingStore()
+	s, c := newTestStore(next, WithContentTTL(time.Minute))
+
+	first, err := s.GetContent(ctx, podcastID)
+	require.NoError(t, err)
+	second, err := s.GetContent(ctx, podcastID)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, next.called("GetContent"))
+
+	c.now = c.now.Add(time.Minute)
+	_, err = s.GetContent(ctx, podcastID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.called("GetContent"))
+
+	assert.Equal(t, Counts{Hits: 1, Misses: 2}, s.Stats().GetContent)
+}
+
+func TestGetContent_DoesNotCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	next := newCountingStore()
+	s, _ := newTestStore(next)
+
+	_, err := s.GetContent(ctx, "missing")
+	assert.True(t, errors.Is(err, store.ErrNotFound))
+	_, err = s.GetContent(ctx, "missing")
+	assert.True(t, errors.Is(err, store.ErrNotFound))
+
+	assert.Equal(t, 2, next.called("GetContent"))
+	assert.Zero(t, s.Stats().Entries)
+}
+
+func TestGetContent_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore(newCountingStore())
+
+	content, err := s.GetContent(ctx, podcastID)
+	require.NoError(t, err)
+	content.Title = "changed by the caller"
+
+	cached, err := s.GetContent(ctx, podcastID)
+	require.NoError(t, err)
+	assert.Equal(t, "Test Podcast", cached.Title)
+}
+
+func TestListContents_CachesFirstPagesOnly(t *testing.T) {
+	ctx := context.Background()
+	next := newCountingStore()
+	s, _ := newTestStore(next)
+
+	for i := 0; i < 2; i++ {
+		contents, nextPageToken, err := s.ListContents(ctx, 1, "", "", "")
+		require.NoError(t, err)
+		require.Len(t, contents, 1)
+		assert.Equal(t, "next-page-token", nextPageToken)
+	}
+	assert.Equal(t, 1, next.called("ListContents"))
+
+	// A different page size, order or filter is a different first page.
+	_, _, err := s.ListContents(ctx, 2, "", "", "")
+	require.NoError(t, err)
+	_, _, err = s.ListContents(ctx, 1, "", "title", "")
+	require.NoError(t, err)
+	assert.Equal(t, 3, next.called("ListContents"))
+
+	for i := 0; i < 2; i++ {
+		_, _, err := s.ListContents(ctx, 1, "next-page-token", "", "")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 5, next.called("ListContents"))
+
+	assert.Equal(t, Counts{Hits: 1, Misses: 3}, s.Stats().ListContents)
+}
+
+func TestSearchContents_CachesByQueryAndFilters(t *testing.T) {
+	ctx := context.Background()
+	next := newCountingStore()
+	s, _ := newTestStore(next)
+
+	podcasts := store.SearchFilters{ContentTypes: []string{"podcast"}}
+	for i := 0; i < 3; i++ {
+		results, _, err := s.SearchContents(ctx, "test", podcasts, 10, "")
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "podcast", results[0].ContentType)
+	}
+	assert.Equal(t, 1, next.called("SearchContents"))
+
+	documentaries := store.SearchFilters{ContentTypes: []string{"documentary"}}
+	results, _, err := s.SearchContents(ctx, "test", documentaries, 10, "")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Equal(t, 2, next.called("SearchContents"))
+
+	assert.Equal(t, Counts{Hits: 2, Misses: 2}, s.Stats().SearchContents)
+}
+
+func TestZeroTTLDisablesCaching(t *testing.T) {
+	ctx := context.Background()
+	next := newCountingStore()
+	s, _ := newTestStore(next, WithContentTTL(0), WithListTTL(0), WithSearchTTL(0))
+
+	for i := 0; i < 2; i++ {
+		_, err := s.GetContent(ctx, podcastID)
+		require.NoError(t, err)
+		_, _, err = s.ListContents(ctx, 10, "", "", "")
+		require.NoError(t, err)
+		_, _, err = s.SearchContents(ctx, "test", store.SearchFilters{}, 10, "")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, next.called("GetContent"))
+	assert.Equal(t, 2, next.called("ListContents"))
+	assert.Equal(t, 2, next.called("SearchContents"))
+	assert.Equal(t, Stats{}, s.Stats())
+}
+
+func TestConsistentReadsBypassCache(t *testing.T) {
+	ctx := store.WithConsistentRead(context.Background())
+	next := newCountingStore()
+	s, _ := newTestStore(next)
+
+	for i := 0; i < 2; i++ {
+		_, err := s.GetContent(ctx, podcastID)
+		require.NoError(t, err)
+		_, _, err = s.ListContents(ctx, 10, "", "", "")
+		require.NoError(t, err)
+		_, _, err = s.SearchContents(ctx, "test", store.SearchFilters{}, 10, "")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, next.called("GetContent"))
+	assert.Equal(t, 2, next.called("ListContents"))
+	assert.Equal(t, 2, next.called("SearchContents"))
+	assert.Zero(t, s.Stats().Entries)
+}
+
+func TestCapacityEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	next := newCountingStore()
+	s, _ := newTestStore(next, WithCapacity(2))
+
+	_, err := s.GetContent(ctx, podcastID)
+	require.NoError(t, err)
+	_, err = s.GetContent(ctx, documentaryID)
+	require.NoError(t, err)
+	// Touch the podcast so the documentary is the least recently used.
+	_, err = s.GetContent(ctx, podcastID)
+	require.NoError(t, err)
+
+	_, _, err = s.ListContents(ctx, 10, "", "", "")
+	require.NoError(t, err)
+
+	stats := s.Stats()
+	assert.Equal(t, 2, stats.Entries)
+	assert.Equal(t, uint64(1), stats.Evictions)
+
+	_, err = s.GetContent(ctx, podcastID)
+	require.NoError(t, err)
+	_, err = s.GetContent(ctx, documentaryID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, next.called("GetContent"))
+}
+
+func TestStats_String(t *testing.T) {
+	stats := Stats{
+		GetContent:   Counts{Hits: 3, Misses: 1},
+		ListContents: Counts{Hits: 1, Misses: 1},
+		Entries:      5,
+		Evictions:    2,
+	}
+
+	assert.Equal(t, "entries: 5, evictions: 2, hits GetContent: 3/4 (75%), ListContents: 1/2 (50%), SearchContents: 0/0 (0%)", stats.String())
+}
@@ -0,0 +1,176 @@
+package events
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// shutdownTimeout bounds the final flush when Run stops.
+const shutdownTimeout = 5 * time.Second
+
+// options holds the settings shared by Recorder and SearchLog.
+type options struct {
+	batchSize     int
+	bufferSize    int
+	flushInterval time.Duration
+	dedupeWindow  time.Duration
+	dedupeSize    int
+}
+
+// Option configures a Recorder or SearchLog.
+type Option func(*options)
+
+// WithBatchSize sets the maximum number of items written per statement.
+func WithBatchSize(size int) Option {
+	return func(o *options) {
+		o.batchSize = size
+	}
+}
+
+// WithBufferSize sets how many items may wait to be written before recording
+// fails with ErrBufferFull.
+func WithBufferSize(size int) Option {
+	return func(o *options) {
+		o.bufferSize = size
+	}
+}
+
+// WithFlushInterval sets how often Run writes pending items that do not fill
+// a batch.
+func WithFlushInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.flushInterval = interval
+	}
+}
+
+// WithDedupeWindow sets how long a Recorder remembers an event to drop repeats
+// from the same session. SearchLog ignores it.
+func WithDedupeWindow(window time.Duration) Option {
+	return func(o *options) {
+		o.dedupeWindow = window
+	}
+}
+
+// WithDedupeSize sets how many recent events a Recorder remembers to drop
+// repeats. Once full, the least recently recorded are forgotten first.
+// SearchLog ignores it.
+func WithDedupeSize(size int) Option {
+	return func(o *options) {
+		o.dedupeSize = size
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		batchSize:     DefaultBatchSize,
+		bufferSize:    DefaultBufferSize,
+		flushInterval: DefaultFlushInterval,
+		dedupeWindow:  DefaultDedupeWindow,
+		dedupeSize:    DefaultDedupeSize,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// batcher queues items in memory and writes them in batches.
+type batcher[T any] struct {
+	// noun names the items in log messages.
+	noun          string
+	write         func(ctx context.Context, items []T) (int64, error)
+	batchSize     int
+	bufferSize    int
+	flushInterval time.Duration
+
+	mu      sync.Mutex
+	pending []T
+
+	// batchReady wakes run when a full batch is pending.
+	batchReady chan struct{}
+}
+
+func newBatcher[T any](noun string, write func(context.Context, []T) (int64, error), o options) *batcher[T] {
+	return &batcher[T]{
+		noun:          noun,
+		write:         write,
+		batchSize:     o.batchSize,
+		bufferSize:    o.bufferSize,
+		flushInterval: o.flushInterval,
+		batchReady:    make(chan struct{}, 1),
+	}
+}
+
+// add queues item, or fails with ErrBufferFull.
+func (b *batcher[T]) add(item T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.pending) >= b.bufferSize {
+		return ErrBufferFull
+	}
+	b.pending = append(b.pending, item)
+
+	if len(b.pending) >= b.batchSize {
+		select {
+		case b.batchReady <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (b *batcher[T]) len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.pending)
+}
+
+// run calls flush every flush interval, or as soon as a full batch is
+// pending, until ctx is cancelled, and once more after that.
+func (b *batcher[T]) run(ctx context.Context, flush func(context.Context) int64) {
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+		case <-b.batchReady:
+		}
+		flush(ctx)
+	}
+}
+
+// flush writes all pending items in batches. Batches that fail are logged and
+// dropped. It returns the number of items stored.
+func (b *batcher[T]) flush(ctx context.Context) int64 {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.mu.Unlock()
+
+	var recorded int64
+	for start := 0; start < len(pending); start += b.batchSize {
+		end := start + b.batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+
+		n, err := b.write(ctx, pending[start:end])
+		if err != nil {
+			log.Printf("failed to write %d %s: %v", end-start, b.noun, err)
+			continue
+		}
+		recorded += n
+	}
+
+	return recorded
+}
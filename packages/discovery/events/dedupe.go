@@ -0,0 +1,67 @@
+package events
+
+import (
+	"container/list"
+	"time"
+)
+
+// dedupeCache remembers the keys of recently recorded events until they
+// expire, holding at most size of them. Once full, recording a new key
+// evicts the least recently recorded one, so its memory stays bounded however
+// many sessions send events; an evicted key is only written again, for the
+// store to drop. It is not safe for concurrent use.
+type dedupeCache struct {
+	size int
+	// order holds the entries from most to least recently recorded.
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type dedupeEntry struct {
+	key    string
+	expiry time.Time
+}
+
+func newDedupeCache(size int) *dedupeCache {
+	return &dedupeCache{size: size, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// contains reports whether key was recorded and has not expired by now.
+func (c *dedupeCache) contains(key string, now time.Time) bool {
+	elem, ok := c.entries[key]
+	return ok && now.Before(elem.Value.(*dedupeEntry).expiry)
+}
+
+// add remembers key until expiry, evicting the least recently recorded key
+// if the cache is full.
+func (c *dedupeCache) add(key string, expiry time.Time) {
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*dedupeEntry).expiry = expiry
+		c.order.MoveToFront(elem)
+		return
+	}
+	if c.size <= 0 {
+		return
+	}
+	if c.order.Len() >= c.size {
+		c.remove(c.order.Back())
+	}
+	c.entries[key] = c.order.PushFront(&dedupeEntry{key: key, expiry: expiry})
+}
+
+// expire forgets the keys that have expired by now. Keys are recorded with
+// the same window, so they expire from the least recently recorded on.
+func (c *dedupeCache) expire(now time.Time) {
+	for elem := c.order.Back(); elem != nil && !now.Before(elem.Value.(*dedupeEntry).expiry); elem = c.order.Back() {
+		c.remove(elem)
+	}
+}
+
+func (c *dedupeCache) len() int {
+	return c.order.Len()
+}
+
+func (c *dedupeCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*dedupeEntry).key)
+}
@@ -0,0 +1,107 @@
+// Package events buffers user interaction events and search logs in memory
+// and writes them to the store in batches, off the request path.
+//
+// Writes are at most once: a batch that fails to write is logged and dropped
+// rather than retried, and items still buffered when the process dies are
+// lost. That is acceptable for trending and search analytics, which only need
+// the aggregate shape.
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+// ErrBufferFull is returned when writes have fallen so far behind that the
+// buffer is at capacity.
+var ErrBufferFull = errors.New("event buffer is full")
+
+// Defaults for a Recorder or SearchLog created without options.
+const (
+	DefaultBatchSize     = 100
+	DefaultBufferSize    = 10000
+	DefaultFlushInterval = time.Second
+	DefaultDedupeWindow  = store.EventDedupeWindow
+	DefaultDedupeSize    = 100000
+)
+
+// Sink stores batches of events; store.Interface satisfies it.
+type Sink interface {
+	RecordEvents(ctx context.Context, events []store.Event) (int64, error)
+}
+
+// Recorder queues events and writes them to a Sink in batches. Repeats of an
+// event by the same session are dropped in memory for the dedupe window, which
+// saves most duplicate writes. This is only a best-effort pre-filter: it
+// remembers a bounded number of recent events, and the events table's unique
+// constraint, keyed by store.EventDedupeWindow buckets, catches the repeats it
+// misses. It is safe for concurrent use.
+type Recorder struct {
+	queue        *batcher[store.Event]
+	dedupeWindow time.Duration
+	now          func() time.Time
+
+	mu sync.Mutex
+	// seen holds the dedupe keys of recently recorded events until they can
+	// be recorded again.
+	seen *dedupeCache
+}
+
+// NewRecorder returns a Recorder writing to sink. Call Run to start writing.
+func NewRecorder(sink Sink, opts ...Option) *Recorder {
+	o := newOptions(opts)
+	return &Recorder{
+		queue:        newBatcher("events", sink.RecordEvents, o),
+		dedupeWindow: o.dedupeWindow,
+		now:          time.Now,
+		seen:         newDedupeCache(o.dedupeSize),
+	}
+}
+
+// Record queues event for writing. It reports false, without queueing, when
+// the same session recorded the same event for the content within the dedupe
+// window.
+func (r *Recorder) Record(event store.Event) (bool, error) {
+	key := event.SessionID + "\x00" + event.ContentID + "\x00" + event.Type
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.seen.contains(key, now) {
+		return false, nil
+	}
+	if err := r.queue.add(event); err != nil {
+		return false, err
+	}
+	r.seen.add(key, now.Add(r.dedupeWindow))
+
+	return true, nil
+}
+
+// Pending returns the number of events waiting to be written.
+func (r *Recorder) Pending() int {
+	return r.queue.len()
+}
+
+// Run writes pending events every flush interval, or as soon as a full batch
+// is pending, until ctx is cancelled. It then flushes what is left.
+func (r *Recorder) Run(ctx context.Context) {
+	r.queue.run(ctx, r.Flush)
+}
+
+// Flush writes all pending events in batches and forgets expired dedupe
+// keys. Batches that fail are logged and dropped. It returns the number of
+// events stored.
+func (r *Recorder) Flush(ctx context.Context) int64 {
+	now := r.now()
+	r.mu.Lock()
+	r.seen.expire(now)
+	r.mu.Unlock()
+
+	return r.queue.flush(ctx)
+}
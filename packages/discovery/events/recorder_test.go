@@ -0,0 +1,178 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+type fakeSink struct {
+	mu      sync.Mutex
+	batches [][]store.Event
+	err     error
+}
+
+func (s *fakeSink) RecordEvents(ctx context.Context, events []store.Event) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return 0, s.err
+	}
+	s.batches = append(s.batches, append([]store.Event{}, events...))
+	return int64(len(events)), nil
+}
+
+func (s *fakeSink) batchSizes() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sizes := make([]int, len(s.batches))
+	for i, batch := range s.batches {
+		sizes[i] = len(batch)
+	}
+	return sizes
+}
+
+func view(sessionID, contentID string) store.Event {
+	return store.Event{ContentID: contentID, Type: store.EventTypeView, SessionID: sessionID}
+}
+
+func TestRecord_DedupesPerSessionWithinWindow(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	r := NewRecorder(&fakeSink{}, WithDedupeWindow(time.Minute))
+	r.now = func() time.Time { return now }
+
+	accepted, err := r.Record(view("s1", "c1"))
+	require.NoError(t, err)
+	assert.True(t, accepted)
+
+	accepted, _ = r.Record(view("s1", "c1"))
+	assert.False(t, accepted, "same session, content and type")
+
+	accepted, _ = r.Record(view("s2", "c1"))
+	assert.True(t, accepted, "other session")
+
+	accepted, _ = r.Record(store.Event{ContentID: "c1", Type: store.EventTypePlayStart, SessionID: "s1"})
+	assert.True(t, accepted, "other event type")
+
+	now = now.Add(time.Minute)
+	accepted, _ = r.Record(view("s1", "c1"))
+	assert.True(t, accepted, "after the dedupe window")
+
+	assert.Equal(t, 4, r.Pending())
+}
+
+func TestRecord_BufferFull(t *testing.T) {
+	r := NewRecorder(&fakeSink{}, WithBufferSize(2))
+
+	_, err := r.Record(view("s1", "c1"))
+	require.NoError(t, err)
+	_, err = r.Record(view("s1", "c2"))
+	require.NoError(t, err)
+
+	accepted, err := r.Record(view("s1", "c3"))
+	assert.False(t, accepted)
+	assert.ErrorIs(t, err, ErrBufferFull)
+
+	// A rejected event is not remembered, so it can be retried.
+	r.Flush(context.Background())
+	accepted, err = r.Record(view("s1", "c3"))
+	require.NoError(t, err)
+	assert.True(t, accepted)
+}
+
+func TestFlush_WritesInBatches(t *testing.T) {
+	sink := &fakeSink{}
+	r := NewRecorder(sink, WithBatchSize(2))
+
+	for _, contentID := range []string{"c1", "c2", "c3", "c4", "c5"} {
+		_, err := r.Record(view("s1", contentID))
+		require.NoError(t, err)
+	}
+
+	recorded := r.Flush(context.Background())
+
+	assert.Equal(t, int64(5), recorded)
+	assert.Equal(t, []int{2, 2, 1}, sink.batchSizes())
+	assert.Zero(t, r.Pending())
+}
+
+func TestFlush_DropsFailedBatches(t *testing.T) {
+	sink := &fakeSink{err: errors.New("database is down")}
+	r := NewRecorder(sink)
+
+	_, err := r.Record(view("s1", "c1"))
+	require.NoError(t, err)
+
+	assert.Zero(t, r.Flush(context.Background()))
+	assert.Zero(t, r.Pending())
+}
+
+func TestFlush_ForgetsExpiredDedupeKeys(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	r := NewRecorder(&fakeSink{}, WithDedupeWindow(time.Minute))
+	r.now = func() time.Time { return now }
+
+	_, err := r.Record(view("s1", "c1"))
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+	r.Flush(context.Background())
+
+	assert.Zero(t, r.seen.len())
+}
+
+func TestRecord_DedupeSizeIsBounded(t *testing.T) {
+	r := NewRecorder(&fakeSink{}, WithDedupeSize(2))
+
+	for _, sessionID := range []string{"s1", "s2", "s3"} {
+		accepted, err := r.Record(view(sessionID, "c1"))
+		require.NoError(t, err)
+		assert.True(t, accepted)
+	}
+	assert.Equal(t, 2, r.seen.len())
+
+	// s1 was the least recently recorded, so it was forgotten; the store
+	// drops the repeat instead.
+	accepted, _ := r.Record(view("s1", "c1"))
+	assert.True(t, accepted)
+
+	accepted, _ = r.Record(view("s3", "c1"))
+	assert.False(t, accepted)
+	assert.Equal(t, 2, r.seen.len())
+}
+
+func TestRun_FlushesFullBatchesAndOnShutdown(t *testing.T) {
+	sink := &fakeSink{}
+	r := NewRecorder(sink, WithBatchSize(2), WithFlushInterval(time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Run(ctx)
+		close(done)
+	}()
+
+	_, err := r.Record(view("s1", "c1"))
+	require.NoError(t, err)
+	_, err = r.Record(view("s1", "c2"))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(sink.batchSizes()) == 1
+	}, time.Second, 5*time.Millisecond, "a full batch is written without waiting for the interval")
+
+	_, err = r.Record(view("s1", "c3"))
+	require.NoError(t, err)
+
+	cancel()
+	<-done
+
+	assert.Equal(t, []int{2, 1}, sink.batchSizes())
+}
@@ -0,0 +1,45 @@
+package events
+
+import (
+	"context"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+// SearchSink stores batches of search records; store.Interface satisfies it.
+type SearchSink interface {
+	RecordSearches(ctx context.Context, searches []store.SearchRecord) (int64, error)
+}
+
+// SearchLog queues search records and writes them to a SearchSink in batches.
+// It is safe for concurrent use.
+type SearchLog struct {
+	queue *batcher[store.SearchRecord]
+}
+
+// NewSearchLog returns a SearchLog writing to sink. Call Run to start writing.
+func NewSearchLog(sink SearchSink, opts ...Option) *SearchLog {
+	return &SearchLog{queue: newBatcher("searches", sink.RecordSearches, newOptions(opts))}
+}
+
+// Record queues search for writing.
+func (l *SearchLog) Record(search store.SearchRecord) error {
+	return l.queue.add(search)
+}
+
+// Pending returns the number of searches waiting to be written.
+func (l *SearchLog) Pending() int {
+	return l.queue.len()
+}
+
+// Run writes pending searches every flush interval, or as soon as a full
+// batch is pending, until ctx is cancelled. It then flushes what is left.
+func (l *SearchLog) Run(ctx context.Context) {
+	l.queue.run(ctx, l.Flush)
+}
+
+// Flush writes all pending searches in batches. Batches that fail are logged
+// and dropped. It returns the number of searches stored.
+func (l *SearchLog) Flush(ctx context.Context) int64 {
+	return l.queue.flush(ctx)
+}
@@ -0,0 +1,39 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+type fakeSearchSink struct {
+	mu       sync.Mutex
+	searches []store.SearchRecord
+}
+
+func (s *fakeSearchSink) RecordSearches(ctx context.Context, searches []store.SearchRecord) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.searches = append(s.searches, searches...)
+	return int64(len(searches)), nil
+}
+
+func TestSearchLog_RecordAndFlush(t *testing.T) {
+	sink := &fakeSearchSink{}
+	l := NewSearchLog(sink, WithBufferSize(2))
+
+	// Searches are not deduped: repeating a query is a signal in itself.
+	require.NoError(t, l.Record(store.SearchRecord{Query: "planet earth", PageDepth: 1}))
+	require.NoError(t, l.Record(store.SearchRecord{Query: "planet earth", PageDepth: 1}))
+	assert.ErrorIs(t, l.Record(store.SearchRecord{Query: "nature"}), ErrBufferFull)
+	assert.Equal(t, 2, l.Pending())
+
+	assert.Equal(t, int64(2), l.Flush(context.Background()))
+	assert.Len(t, sink.searches, 2)
+	assert.Zero(t, l.Pending())
+}
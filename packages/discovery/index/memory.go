@@ -0,0 +1,737 @@
+// Package index provides search backends for the discovery service that run
+// alongside, rather than inside, the database.
+package index
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/query"
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+	"github.com/mosaibah/Mawjood/packages/pagination"
+	"github.com/mosaibah/Mawjood/packages/textnorm"
+)
+
+// BM25 parameters. k1 controls term frequency saturation and b how strongly
+// scores are normalized by field length.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// maxTagFacetBuckets matches the limit used by the SQL facet query.
+const maxTagFacetBuckets = 20
+
+// field identifies one of the indexed text fields of a content.
+type field int
+
+const (
+	fieldTitle field = iota
+	fieldDescription
+	fieldTags
+	fieldPlatformName
+	numFields
+)
+
+// fieldNames are the names reported in store.SearchResult.MatchedFields.
+var fieldNames = [numFields]string{
+	fieldTitle:        store.MatchedFieldTitle,
+	fieldDescription:  store.MatchedFieldDescription,
+	fieldTags:         store.MatchedFieldTags,
+	fieldPlatformName: store.MatchedFieldPlatformName,
+}
+
+// document is an indexed content together with its per-field token counts,
+// its normalized field text for phrase and exact matches, and its normalized
+// tags.
+type document struct {
+	content store.Content
+	lengths [numFields]int
+	terms   map[string][numFields]int
+	text    [numFields]string
+	tags    []string
+}
+
+// Memory is an in-process inverted index scored with BM25. Each field is
+// scored separately, multiplied by its store.Boosts weight and summed, so a
+// term found in both the title and the description counts for both. The exact
+// and prefix bonuses of store.Boosts are added on top. It is safe for
+// concurrent use.
+type Memory struct {
+	mu sync.RWMutex
+
+	docs     map[string]*document
+	postings map[string]map[string]struct{}
+	// totalLengths holds the summed token count of each field over all docs,
+	// used for the average field length in BM25.
+	totalLengths [numFields]int
+
+	cursors     *pagination.Codec
+	normalizers *textnorm.Registry
+	boosts      store.Boosts
+}
+
+// Option configures a Memory created by NewMemory.
+type Option func(*Memory)
+
+// WithCursorCodec sets the codec used to sign and verify page tokens. Without
+// it, tokens are signed with a random per-process secret.
+func WithCursorCodec(codec *pagination.Codec) Option {
+	return func(m *Memory) {
+		m.cursors = codec
+	}
+}
+
+// WithNormalizers sets the registry used to normalize indexed text and
+// queries. Defaults to textnorm.Default().
+func WithNormalizers(normalizers *textnorm.Registry) Option {
+	return func(m *Memory) {
+		m.normalizers = normalizers
+	}
+}
+
+// WithBoosts sets the relevance weights. Defaults to store.DefaultBoosts().
+func WithBoosts(boosts store.Boosts) Option {
+	return func(m *Memory) {
+		m.boosts = boosts
+	}
+}
+
+// NewMemory returns an empty index.
+func NewMemory(opts ...Option) *Memory {
+	m := &Memory{
+		docs:     map[string]*document{},
+		postings: map[string]map[string]struct{}{},
+		boosts:   store.DefaultBoosts(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.cursors == nil {
+		m.cursors = pagination.NewCodec(nil, pagination.DefaultTTL)
+	}
+	if m.normalizers == nil {
+		m.normalizers = textnorm.Default()
+	}
+	return m
+}
+
+// Len returns the number of indexed contents.
+func (m *Memory) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.docs)
+}
+
+// Upsert adds content to the index, replacing any previous version with the
+// same ID.
+func (m *Memory) Upsert(content store.Content) {
+	doc := m.analyze(content)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(content.ID)
+	m.docs[content.ID] = doc
+	for i, length := range doc.lengths {
+		m.totalLengths[i] += length
+	}
+	for term := range doc.terms {
+		ids, ok := m.postings[term]
+		if !ok {
+			ids = map[string]struct{}{}
+			m.postings[term] = ids
+		}
+		ids[content.ID] = struct{}{}
+	}
+}
+
+// Delete removes the content with id from the index. Unknown ids are ignored.
+func (m *Memory) Delete(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.remove(id)
+}
+
+// remove must be called with mu held for writing.
+func (m *Memory) remove(id string) {
+	doc, ok := m.docs[id]
+	if !ok {
+		return
+	}
+
+	delete(m.docs, id)
+	for i, length := range doc.lengths {
+		m.totalLengths[i] -= length
+	}
+	for term := range doc.terms {
+		delete(m.postings[term], id)
+		if len(m.postings[term]) == 0 {
+			delete(m.postings, term)
+		}
+	}
+}
+
+// Source lists the contents to index; store.Interface satisfies it.
+type Source interface {
+	ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]store.Content, string, error)
+}
+
+// Sync brings the index in line with source: new and changed contents are
+// upserted and contents no longer listed are deleted. Contents whose
+// UpdatedAt has not changed are left untouched. It returns the number of
+// upserts and deletes applied.
+func (m *Memory) Sync(ctx context.Context, source Source) (int, error) {
+	seen := map[string]bool{}
+	changes := 0
+
+	pageToken := ""
+	for {
+		contents, nextPageToken, err := source.ListContents(ctx, 100, pageToken, "", "")
+		if err != nil {
+			return changes, fmt.Errorf("failed to list contents to index: %w", err)
+		}
+
+		for _, content := range contents {
+			seen[content.ID] = true
+			if m.isCurrent(content) {
+				continue
+			}
+			m.Upsert(content)
+			changes++
+		}
+
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	m.mu.RLock()
+	var stale []string
+	for id := range m.docs {
+		if !seen[id] {
+			stale = append(stale, id)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, id := range stale {
+		m.Delete(id)
+		changes++
+	}
+
+	return changes, nil
+}
+
+func (m *Memory) isCurrent(content store.Content) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	doc, ok := m.docs[content.ID]
+	return ok && doc.content.UpdatedAt.Equal(content.UpdatedAt)
+}
+
+// SearchContents implements store.SearchIndex. Results are ordered by BM25
+// score, then newest first, with the same keyset pagination as the SQL
+// backend.
+func (m *Memory) SearchContents(ctx context.Context, rawQuery string, filters store.SearchFilters, pageSize int32, pageToken string) ([]store.SearchResult, string, error) {
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	searchQuery := strings.TrimSpace(rawQuery)
+	if searchQuery == "" {
+		return []store.SearchResult{}, "", nil
+	}
+
+	parsed, err := query.Parse(searchQuery)
+	if err != nil {
+		return nil, "", err
+	}
+
+	scope := pagination.Scope("index.SearchContents", searchQuery, fmt.Sprintf("%+v", filters))
+
+	var after *store.SearchResult
+	if pageToken != "" {
+		cursor, err := m.cursors.Decode(pageToken, scope, 3)
+		if err != nil {
+			return nil, "", err
+		}
+		score, err := strconv.ParseFloat(cursor.Keys[0], 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: bad score", pagination.ErrInvalidToken)
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, cursor.Keys[1])
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: bad created_at", pagination.ErrInvalidToken)
+		}
+		after = &store.SearchResult{Content: store.Content{ID: cursor.Keys[2], CreatedAt: createdAt}, Score: score}
+	}
+
+	results := m.match(parsed, filters)
+	sort.Slice(results, func(i, j int) bool {
+		return ranksBefore(results[i], results[j])
+	})
+
+	if after != nil {
+		start := sort.Search(len(results), func(i int) bool {
+			return ranksBefore(*after, results[i])
+		})
+		results = results[start:]
+	}
+
+	var nextPageToken string
+	if len(results) > int(pageSize) {
+		results = results[:pageSize]
+		last := results[len(results)-1]
+		var err error
+		nextPageToken, err = m.cursors.EncodePage(scope, pagination.PageOf(pageToken)+1,
+			strconv.FormatFloat(last.Score, 'g', -1, 64),
+			last.CreatedAt.Format(time.RFC3339Nano),
+			last.ID,
+		)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return results, nextPageToken, nil
+}
+
+// SearchFacets implements store.SearchIndex.
+func (m *Memory) SearchFacets(ctx context.Context, rawQuery string, filters store.SearchFilters) (*store.SearchFacets, error) {
+	searchQuery := strings.TrimSpace(rawQuery)
+	if searchQuery == "" {
+		return &store.SearchFacets{}, nil
+	}
+
+	parsed, err := query.Parse(searchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	contentTypes := map[string]int64{}
+	languages := map[string]int64{}
+	platformNames := map[string]int64{}
+	tags := map[string]int64{}
+	durations := map[string]int64{}
+
+	for _, result := range m.match(parsed, filters) {
+		contentTypes[result.ContentType]++
+		if result.Language != "" {
+			languages[result.Language]++
+		}
+		if result.PlatformName != "" {
+			platformNames[result.PlatformName]++
+		}
+		for _, tag := range result.Tags {
+			tags[tag]++
+		}
+		// Contents synced without a duration carry zero, which the SQL
+		// backend leaves out as NULL rather than counting as under 10 minutes.
+		if result.DurationSeconds > 0 {
+			durations[store.DurationBucket(result.DurationSeconds)]++
+		}
+	}
+
+	facets := &store.SearchFacets{
+		ContentTypes:  toBuckets(contentTypes),
+		Languages:     toBuckets(languages),
+		PlatformNames: toBuckets(platformNames),
+		Tags:          toBuckets(tags),
+		Durations:     toBuckets(durations),
+	}
+	facets.Sort()
+	if len(facets.Tags) > maxTagFacetBuckets {
+		facets.Tags = facets.Tags[:maxTagFacetBuckets]
+	}
+
+	return facets, nil
+}
+
+// match returns the documents that satisfy parsed and pass filters, in no
+// particular order, scored with BM25 against the ranking text of the query.
+// Only the documents in the postings of the query's words and phrases are
+// checked; every document is checked only when the query has none that a
+// match must contain, such as type:podcast or -nature.
+func (m *Memory) match(parsed *query.Query, filters store.SearchFilters) []store.SearchResult {
+	normalizedQuery := strings.Join(tokenize(m.normalizers.Query().Normalize(parsed.Text())), " ")
+	terms := uniqueTerms(tokenize(normalizedQuery))
+	matches := m.compile(parsed.Expr)
+	weights := [numFields]float64{
+		fieldTitle:        m.boosts.Title,
+		fieldDescription:  m.boosts.Description,
+		fieldTags:         m.boosts.Tags,
+		fieldPlatformName: m.boosts.PlatformName,
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	n := float64(len(m.docs))
+	var avgLengths [numFields]float64
+	for i, total := range m.totalLengths {
+		if len(m.docs) > 0 {
+			avgLengths[i] = float64(total) / n
+		}
+	}
+
+	scores := map[string]float64{}
+	matched := map[string]*[numFields]bool{}
+	for _, term := range terms {
+		ids := m.postings[term]
+		if len(ids) == 0 {
+			continue
+		}
+		df := float64(len(ids))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+		for id := range ids {
+			doc := m.docs[id]
+			freqs := doc.terms[term]
+			if matched[id] == nil {
+				matched[id] = &[numFields]bool{}
+			}
+			for f := field(0); f < numFields; f++ {
+				tf := float64(freqs[f])
+				if tf == 0 {
+					continue
+				}
+				matched[id][f] = true
+				norm := 1.0
+				if avgLengths[f] > 0 {
+					norm = 1 - bm25B + bm25B*float64(doc.lengths[f])/avgLengths[f]
+				}
+				scores[id] += weights[f] * idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+			}
+		}
+	}
+
+	ids, bounded := m.candidates(parsed.Expr)
+	if !bounded {
+		ids = make(map[string]struct{}, len(m.docs))
+		for id := range m.docs {
+			ids[id] = struct{}{}
+		}
+	}
+
+	results := []store.SearchResult{}
+	for id := range ids {
+		doc := m.docs[id]
+		if !matches(doc) || !filters.Matches(doc.content) {
+			continue
+		}
+
+		score := scores[id]
+		switch {
+		case normalizedQuery == "":
+		case doc.text[fieldTitle] == normalizedQuery:
+			score += m.boosts.ExactTitle
+		case strings.HasPrefix(doc.text[fieldTitle], normalizedQuery):
+			score += m.boosts.TitlePrefix
+		}
+		for _, tag := range doc.tags {
+			if tag == normalizedQuery {
+				score += m.boosts.ExactTag
+				break
+			}
+		}
+
+		var matchedFields []string
+		for f := field(0); f < numFields; f++ {
+			if matched[id] != nil && matched[id][f] {
+				matchedFields = append(matchedFields, fieldNames[f])
+			}
+		}
+
+		results = append(results, store.SearchResult{
+			Content:       doc.content,
+			Score:         score,
+			MatchedFields: matchedFields,
+		})
+	}
+
+	return results
+}
+
+// compile turns a parsed query into a predicate over documents, normalizing
+// its values once. It mirrors the SQL backend: words match when any of their
+// tokens occurs in the field, phrases when their tokens occur in sequence, and
+// tag, platform, language and type values must match exactly. A nil expression
+// matches every document.
+func (m *Memory) compile(expr query.Expr) func(*document) bool {
+	switch e := expr.(type) {
+	case query.And:
+		predicates := m.compileAll(e.Terms)
+		return func(doc *document) bool {
+			for _, predicate := range predicates {
+				if !predicate(doc) {
+					return false
+				}
+			}
+			return true
+		}
+
+	case query.Or:
+		predicates := m.compileAll(e.Terms)
+		return func(doc *document) bool {
+			for _, predicate := range predicates {
+				if predicate(doc) {
+					return true
+				}
+			}
+			return false
+		}
+
+	case query.Not:
+		predicate := m.compile(e.Expr)
+		return func(doc *document) bool {
+			return !predicate(doc)
+		}
+
+	case query.Term:
+		value := m.termValue(e)
+		switch e.Field {
+		case query.FieldTag:
+			return func(doc *document) bool {
+				return containsString(doc.tags, value)
+			}
+		case query.FieldPlatform:
+			return func(doc *document) bool {
+				return doc.text[fieldPlatformName] == value
+			}
+		case query.FieldLanguage:
+			return func(doc *document) bool {
+				return strings.EqualFold(doc.content.Language, e.Value)
+			}
+		case query.FieldType:
+			return func(doc *document) bool {
+				return strings.EqualFold(doc.content.ContentType, e.Value)
+			}
+		}
+
+		fields := []field{fieldTitle, fieldDescription, fieldTags, fieldPlatformName}
+		switch e.Field {
+		case query.FieldTitle:
+			fields = []field{fieldTitle}
+		case query.FieldDescription:
+			fields = []field{fieldDescription}
+		}
+
+		if e.Phrase {
+			return func(doc *document) bool {
+				for _, f := range fields {
+					if strings.Contains(" "+doc.text[f]+" ", " "+value+" ") {
+						return true
+					}
+				}
+				return false
+			}
+		}
+
+		tokens := tokenize(value)
+		return func(doc *document) bool {
+			for _, token := range tokens {
+				freqs := doc.terms[token]
+				for _, f := range fields {
+					if freqs[f] > 0 {
+						return true
+					}
+				}
+			}
+			return false
+		}
+
+	default:
+		return func(*document) bool { return true }
+	}
+}
+
+// candidates returns the ids of the documents that can match expr, taken
+// from the postings of its words, phrases and tag and platform values, and
+// whether expr is bounded by them at all. Excluded terms, language and type
+// values and a nil expression are not, so for them every document is a
+// candidate. It must be called with mu held for reading.
+func (m *Memory) candidates(expr query.Expr) (map[string]struct{}, bool) {
+	switch e := expr.(type) {
+	case query.And:
+		// Any bounded term bounds the conjunction; the smallest is cheapest
+		// to check, and the predicate rejects what the others would have.
+		var smallest map[string]struct{}
+		bounded := false
+		for _, term := range e.Terms {
+			ids, ok := m.candidates(term)
+			if ok && (!bounded || len(ids) < len(smallest)) {
+				smallest, bounded = ids, true
+			}
+		}
+		return smallest, bounded
+
+	case query.Or:
+		union := map[string]struct{}{}
+		for _, term := range e.Terms {
+			ids, ok := m.candidates(term)
+			if !ok {
+				return nil, false
+			}
+			for id := range ids {
+				union[id] = struct{}{}
+			}
+		}
+		return union, true
+
+	case query.Term:
+		switch e.Field {
+		case query.FieldLanguage, query.FieldType:
+			return nil, false
+		}
+		tokens := tokenize(m.termValue(e))
+		if len(tokens) == 0 {
+			return nil, false
+		}
+		// A phrase, tag or platform match contains all of its tokens, so
+		// only documents in the postings of every one of them can match. A
+		// word matches when any of its tokens does.
+		if e.Phrase || e.Field == query.FieldTag || e.Field == query.FieldPlatform {
+			return m.intersectPostings(tokens), true
+		}
+		union := map[string]struct{}{}
+		for _, token := range tokens {
+			for id := range m.postings[token] {
+				union[id] = struct{}{}
+			}
+		}
+		return union, true
+
+	default:
+		return nil, false
+	}
+}
+
+// intersectPostings returns the ids in the postings of every token. It must
+// be called with mu held for reading.
+func (m *Memory) intersectPostings(tokens []string) map[string]struct{} {
+	smallest := m.postings[tokens[0]]
+	for _, token := range tokens[1:] {
+		if len(m.postings[token]) < len(smallest) {
+			smallest = m.postings[token]
+		}
+	}
+
+	ids := map[string]struct{}{}
+	for id := range smallest {
+		inAll := true
+		for _, token := range tokens {
+			if _, ok := m.postings[token][id]; !ok {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			ids[id] = struct{}{}
+		}
+	}
+	return ids
+}
+
+// termValue normalizes the value of a query term the way indexed text is.
+func (m *Memory) termValue(term query.Term) string {
+	return strings.Join(tokenize(m.normalizers.Query().Normalize(term.Value)), " ")
+}
+
+func (m *Memory) compileAll(exprs []query.Expr) []func(*document) bool {
+	predicates := make([]func(*document) bool, len(exprs))
+	for i, expr := range exprs {
+		predicates[i] = m.compile(expr)
+	}
+	return predicates
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// analyze tokenizes the searchable fields of content. They are folded the
+// same way as queries, whatever the content's language, so a query matches
+// Arabic text in contents of any language.
+func (m *Memory) analyze(content store.Content) *document {
+	normalizer := m.normalizers.Query()
+	fields := [numFields]string{
+		fieldTitle:        content.Title,
+		fieldDescription:  content.Description,
+		fieldTags:         strings.Join(content.Tags, " "),
+		fieldPlatformName: content.PlatformName,
+	}
+
+	doc := &document{content: content, terms: map[string][numFields]int{}}
+	for _, tag := range content.Tags {
+		doc.tags = append(doc.tags, strings.Join(tokenize(normalizer.Normalize(tag)), " "))
+	}
+	for f, text := range fields {
+		tokens := tokenize(normalizer.Normalize(text))
+		doc.lengths[f] = len(tokens)
+		doc.text[f] = strings.Join(tokens, " ")
+		for _, token := range tokens {
+			freqs := doc.terms[token]
+			freqs[f]++
+			doc.terms[token] = freqs
+		}
+	}
+	return doc
+}
+
+// tokenize splits normalized text into words. Anything that is not a letter,
+// digit or combining mark separates words.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+	})
+}
+
+func uniqueTerms(tokens []string) []string {
+	seen := make(map[string]bool, len(tokens))
+	terms := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if !seen[token] {
+			seen[token] = true
+			terms = append(terms, token)
+		}
+	}
+	return terms
+}
+
+// ranksBefore orders results by score, then newest first, then by id, matching
+// the ORDER BY of the SQL backend.
+func ranksBefore(a, b store.SearchResult) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.After(b.CreatedAt)
+	}
+	return a.ID > b.ID
+}
+
+func toBuckets(counts map[string]int64) []store.FacetBucket {
+	buckets := make([]store.FacetBucket, 0, len(counts))
+	for value, count := range counts {
+		buckets = append(buckets, store.FacetBucket{Value: value, Count: count})
+	}
+	return buckets
+}
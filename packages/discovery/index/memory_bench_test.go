@@ -0,0 +1,57 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+// benchmarkIndexSizes are the index sizes search time is reported for. With
+// a selective query it should stay about the same for all of them.
+var benchmarkIndexSizes = []int{1000, 10000, 100000}
+
+// benchmarkIndex returns an index of n contents that share their common
+// words, of which only the first ten mention "coffee".
+func benchmarkIndex(n int) *Memory {
+	m := NewMemory()
+	for i := 0; i < n; i++ {
+		title := fmt.Sprintf("Planet Earth episode %d", i)
+		if i < 10 {
+			title = fmt.Sprintf("The history of coffee %d", i)
+		}
+		m.Upsert(store.Content{
+			ID:           fmt.Sprintf("id%d", i),
+			Title:        title,
+			Description:  "A journey through the natural world and the animals that live in it.",
+			Tags:         []string{"nature", "science"},
+			Language:     "en",
+			ContentType:  "documentary",
+			PlatformName: "YouTube",
+			CreatedAt:    baseTime,
+			UpdatedAt:    baseTime,
+		})
+	}
+	return m
+}
+
+func BenchmarkSearchContents_SelectiveTerm(b *testing.B) {
+	ctx := context.Background()
+	for _, size := range benchmarkIndexSizes {
+		b.Run(fmt.Sprintf("docs=%d", size), func(b *testing.B) {
+			m := benchmarkIndex(size)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				results, _, err := m.SearchContents(ctx, "coffee history", store.SearchFilters{}, 10, "")
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(results) != 10 {
+					b.Fatalf("got %d results, want 10", len(results))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/query"
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+	"github.com/mosaibah/Mawjood/packages/pagination"
+)
+
+var baseTime = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+func testContents() []store.Content {
+	return []store.Content{
+		{
+			ID: "id1", Title: "The Blue Planet", Description: "A comprehensive exploration of the world's oceans.",
+			Tags: []string{"nature", "science"}, Language: "en", DurationSeconds: 3600, ContentType: "documentary",
+			PlatformName: "YouTube", CreatedAt: baseTime, UpdatedAt: baseTime,
+		},
+		{
+			ID: "id2", Title: "Planet Earth II", Description: "The planet from the viewpoint of animals.",
+			Tags: []string{"nature"}, Language: "en", DurationSeconds: 3000, ContentType: "documentary",
+			PlatformName: "YouTube", CreatedAt: baseTime.Add(time.Hour), UpdatedAt: baseTime,
+		},
+		{
+			ID: "id3", Title: "Science Friday", Description: "Weekly discussions about science and technology.",
+			Tags: []string{"science", "technology"}, Language: "en", DurationSeconds: 1800, ContentType: "podcast",
+			PlatformName: "Spotify", CreatedAt: baseTime.Add(2 * time.Hour), UpdatedAt: baseTime,
+		},
+		{
+			ID: "id4", Title: "حديث التقنية", Description: "مناقشات حول الذكاء الاصطناعي والابتكار",
+			Tags: []string{"technology"}, Language: "ar", DurationSeconds: 2400, ContentType: "podcast",
+			PlatformName: "YouTube", CreatedAt: baseTime.Add(3 * time.Hour), UpdatedAt: baseTime,
+		},
+	}
+}
+
+func newTestIndex(t *testing.T) *Memory {
+	t.Helper()
+	m := NewMemory(WithCursorCodec(pagination.NewCodec([]byte("test-secret"), time.Hour)))
+	for _, content := range testContents() {
+		m.Upsert(content)
+	}
+	return m
+}
+
+func resultIDs(results []store.SearchResult) []string {
+	ids := make([]string, len(results))
+	for i, result := range results {
+		ids[i] = result.ID
+	}
+	return ids
+}
+
+func TestSearchContents_RanksByBM25(t *testing.T) {
+	m := newTestIndex(t)
+
+	results, nextPageToken, err := m.SearchContents(context.Background(), "planet", store.SearchFilters{}, 10, "")
+
+	require.NoError(t, err)
+	assert.Empty(t, nextPageToken)
+	// "planet" appears in both the title and description of id2 but only in
+	// the title of id1.
+	assert.Equal(t, []string{"id2", "id1"}, resultIDs(results))
+	assert.Greater(t, results[0].Score, results[1].Score)
+	assert.Equal(t, []string{store.MatchedFieldTitle, store.MatchedFieldDescription}, results[0].MatchedFields)
+	assert.Equal(t, []string{store.MatchedFieldTitle}, results[1].MatchedFields)
+}
+
+func TestSearchContents_SumsTermScores(t *testing.T) {
+	m := newTestIndex(t)
+
+	results, _, err := m.SearchContents(context.Background(), "nature oceans", store.SearchFilters{}, 10, "")
+
+	require.NoError(t, err)
+	// id1 matches both terms, id2 only "nature".
+	assert.Equal(t, []string{"id1", "id2"}, resultIDs(results))
+	assert.Equal(t, []string{store.MatchedFieldDescription, store.MatchedFieldTags}, results[0].MatchedFields)
+}
+
+func TestSearchContents_Boosts(t *testing.T) {
+	// With equal field weights and no bonuses, id2 wins for "planet" because
+	// the term also appears in its description.
+	flat := store.Boosts{Title: 1, Description: 1, PlatformName: 1, Tags: 1}
+	m := NewMemory(WithBoosts(flat))
+	for _, content := range testContents() {
+		m.Upsert(content)
+	}
+	results, _, err := m.SearchContents(context.Background(), "planet", store.SearchFilters{}, 10, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id2", "id1"}, resultIDs(results))
+
+	// A large exact-title bonus lifts the content whose title is the query.
+	boosted := flat
+	boosted.ExactTitle = 10
+	m = NewMemory(WithBoosts(boosted))
+	for _, content := range testContents() {
+		m.Upsert(content)
+	}
+	results, _, err = m.SearchContents(context.Background(), "the blue planet", store.SearchFilters{}, 10, "")
+	require.NoError(t, err)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "id1", results[0].ID)
+	assert.Greater(t, results[0].Score, 10.0)
+
+	// Zeroing a field weight removes its contribution entirely.
+	noDescription := flat
+	noDescription.Description = 0
+	m = NewMemory(WithBoosts(noDescription))
+	for _, content := range testContents() {
+		m.Upsert(content)
+	}
+	results, _, err = m.SearchContents(context.Background(), "oceans", store.SearchFilters{}, 10, "")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Zero(t, results[0].Score)
+}
+
+func TestSearchContents_QuerySyntax(t *testing.T) {
+	m := newTestIndex(t)
+
+	cases := map[string][]string{
+		`"planet earth"`:                     {"id2"},
+		`planet -tag:science`:                {"id2"},
+		`planet -"blue planet"`:              {"id2"},
+		`title:science`:                      {"id3"},
+		`tag:technology lang:ar`:             {"id4"},
+		`tag:nature OR type:podcast`:         {"id4", "id3", "id2", "id1"},
+		`platform:youtube -type:documentary`: {"id4"},
+		`(oceans OR animals) planet`:         {"id2", "id1"},
+		`-tag:nature`:                        {"id4", "id3"},
+		`type:podcast`:                       {"id4", "id3"},
+		`science OR -planet`:                 {"id4", "id3", "id1"},
+	}
+
+	for input, expected := range cases {
+		results, _, err := m.SearchContents(context.Background(), input, store.SearchFilters{}, 10, "")
+		require.NoError(t, err, input)
+		assert.ElementsMatch(t, expected, resultIDs(results), input)
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	m := newTestIndex(t)
+
+	cases := map[string][]string{
+		`planet`:                     {"id1", "id2"},
+		`"planet earth"`:             {"id2"},
+		`science type:podcast`:       {"id1", "id3"},
+		`tag:technology -science`:    {"id3", "id4"},
+		`(oceans OR animals) planet`: {"id1", "id2"},
+		`missing`:                    {},
+	}
+	for input, expected := range cases {
+		parsed, err := query.Parse(input)
+		require.NoError(t, err, input)
+
+		ids, bounded := m.candidates(parsed.Expr)
+
+		assert.True(t, bounded, input)
+		var got []string
+		for id := range ids {
+			got = append(got, id)
+		}
+		assert.ElementsMatch(t, expected, got, input)
+	}
+
+	for _, input := range []string{`type:podcast`, `-nature`, `science OR lang:ar`} {
+		parsed, err := query.Parse(input)
+		require.NoError(t, err, input)
+
+		_, bounded := m.candidates(parsed.Expr)
+		assert.False(t, bounded, input)
+	}
+}
+
+func TestSearchContents_InvalidQuery(t *testing.T) {
+	m := newTestIndex(t)
+
+	_, _, err := m.SearchContents(context.Background(), `"planet`, store.SearchFilters{}, 10, "")
+	assert.ErrorIs(t, err, query.ErrInvalidQuery)
+
+	_, err = m.SearchFacets(context.Background(), `tag:`, store.SearchFilters{})
+	assert.ErrorIs(t, err, query.ErrInvalidQuery)
+}
+
+func TestSearchContents_NormalizesArabic(t *testing.T) {
+	m := newTestIndex(t)
+
+	results, _, err := m.SearchContents(context.Background(), "الذَّكاء الإصطناعي", store.SearchFilters{}, 10, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id4"}, resultIDs(results))
+}
+
+func TestSearchContents_NormalizesArabicInAnyLanguage(t *testing.T) {
+	m := newTestIndex(t)
+	m.Upsert(store.Content{
+		ID: "id5", Title: "مقابلة مع أحمد", Description: "An interview in English.",
+		Language: "en", ContentType: "podcast", CreatedAt: baseTime, UpdatedAt: baseTime,
+	})
+
+	results, _, err := m.SearchContents(context.Background(), "احمد", store.SearchFilters{}, 10, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id5"}, resultIDs(results))
+
+	results, _, err = m.SearchContents(context.Background(), `"مقابلة مع أحمد"`, store.SearchFilters{}, 10, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id5"}, resultIDs(results))
+}
+
+func TestSearchContents_Filters(t *testing.T) {
+	m := newTestIndex(t)
+
+	results, _, err := m.SearchContents(context.Background(), "technology science", store.SearchFilters{
+		ContentTypes: []string{"podcast"},
+		Languages:    []string{"en"},
+	}, 10, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id3"}, resultIDs(results))
+}
+
+func TestSearchContents_Pagination(t *testing.T) {
+	m := newTestIndex(t)
+	ctx := context.Background()
+
+	var pages [][]string
+	pageToken := ""
+	for {
+		results, nextPageToken, err := m.SearchContents(ctx, "nature science technology", store.SearchFilters{}, 1, pageToken)
+		require.NoError(t, err)
+		pages = append(pages, resultIDs(results))
+		if nextPageToken == "" {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	all, _, err := m.SearchContents(ctx, "nature science technology", store.SearchFilters{}, 10, "")
+	require.NoError(t, err)
+
+	var flattened []string
+	for _, page := range pages {
+		flattened = append(flattened, page...)
+	}
+	assert.Equal(t, resultIDs(all), flattened)
+	assert.Len(t, flattened, 4)
+
+	_, _, err = m.SearchContents(ctx, "planet", store.SearchFilters{}, 1, pageToken)
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+}
+
+func TestUpsertAndDelete(t *testing.T) {
+	m := newTestIndex(t)
+	ctx := context.Background()
+
+	updated := testContents()[2]
+	updated.Title = "Oceans Friday"
+	updated.Description = "Weekly discussions."
+	updated.Tags = nil
+	m.Upsert(updated)
+
+	results, _, err := m.SearchContents(ctx, "science", store.SearchFilters{}, 10, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id1"}, resultIDs(results))
+
+	results, _, err = m.SearchContents(ctx, "oceans", store.SearchFilters{}, 10, "")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"id1", "id3"}, resultIDs(results))
+
+	m.Delete("id1")
+	m.Delete("unknown")
+
+	results, _, err = m.SearchContents(ctx, "oceans", store.SearchFilters{}, 10, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id3"}, resultIDs(results))
+	assert.Equal(t, 3, m.Len())
+}
+
+func TestSearchFacets(t *testing.T) {
+	m := newTestIndex(t)
+
+	facets, err := m.SearchFacets(context.Background(), "science nature", store.SearchFilters{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []store.FacetBucket{{Value: "documentary", Count: 2}, {Value: "podcast", Count: 1}}, facets.ContentTypes)
+	assert.Equal(t, []store.FacetBucket{{Value: "YouTube", Count: 2}, {Value: "Spotify", Count: 1}}, facets.PlatformNames)
+	assert.Equal(t, []store.FacetBucket{{Value: "nature", Count: 2}, {Value: "science", Count: 2}, {Value: "technology", Count: 1}}, facets.Tags)
+	assert.Equal(t, []store.FacetBucket{
+		{Value: store.DurationBucket30To60Minutes, Count: 2},
+		{Value: store.DurationBucketOver60Minutes, Count: 1},
+	}, facets.Durations)
+}
+
+func TestSearchFacets_SkipsUnknownDurations(t *testing.T) {
+	m := newTestIndex(t)
+	m.Upsert(store.Content{
+		ID: "id5", Title: "Nature Sounds", Description: "Ambient recordings from the forest.",
+		Tags: []string{"nature"}, Language: "en", ContentType: "podcast",
+		PlatformName: "Spotify", CreatedAt: baseTime, UpdatedAt: baseTime,
+	})
+
+	facets, err := m.SearchFacets(context.Background(), "nature", store.SearchFilters{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []store.FacetBucket{{Value: "documentary", Count: 2}, {Value: "podcast", Count: 1}}, facets.ContentTypes)
+	assert.Equal(t, []store.FacetBucket{
+		{Value: store.DurationBucket30To60Minutes, Count: 1},
+		{Value: store.DurationBucketOver60Minutes, Count: 1},
+	}, facets.Durations)
+}
+
+type fakeSource struct {
+	contents []store.Content
+}
+
+func (f *fakeSource) ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]store.Content, string, error) {
+	start := 0
+	if pageToken != "" {
+		fmt.Sscanf(pageToken, "%d", &start)
+	}
+	end := start + int(pageSize)
+	if end >= len(f.contents) {
+		return f.contents[start:], "", nil
+	}
+	return f.contents[start:end], fmt.Sprint(end), nil
+}
+
+func TestSync(t *testing.T) {
+	m := NewMemory()
+	source := &fakeSource{contents: testContents()}
+	ctx := context.Background()
+
+	changes, err := m.Sync(ctx, source)
+	require.NoError(t, err)
+	assert.Equal(t, 4, changes)
+
+	changes, err = m.Sync(ctx, source)
+	require.NoError(t, err)
+	assert.Equal(t, 0, changes)
+
+	source.contents = source.contents[1:]
+	source.contents[0].Title = "Planet Earth III"
+	source.contents[0].UpdatedAt = baseTime.Add(time.Minute)
+
+	changes, err = m.Sync(ctx, source)
+	require.NoError(t, err)
+	assert.Equal(t, 2, changes)
+
+	results, _, err := m.SearchContents(ctx, "iii", store.SearchFilters{}, 10, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id2"}, resultIDs(results))
+	assert.Equal(t, 3, m.Len())
+}
@@ -3,11 +3,18 @@ package mock
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/mosaibah/Mawjood/packages/discovery/store"
+	"github.com/mosaibah/Mawjood/packages/filter"
+	"github.com/mosaibah/Mawjood/packages/pagination"
 )
 
+// InvalidPageToken is rejected by the mock as if it had been tampered with.
+const InvalidPageToken = "invalid-page-token"
+
 type MockContentData struct{}
 
 func (m *MockContentData) GetContent(ctx context.Context, id string) (*store.Content, error) {
@@ -43,11 +50,31 @@ func (m *MockContentData) GetContent(ctx context.Context, id string) (*store.Con
 			PlatformName:    "Documentary Platform",
 		}, nil
 	default:
-		return nil, fmt.Errorf("content with ID %s not found", id)
+		return nil, fmt.Errorf("content with ID %s %w", id, store.ErrNotFound)
+	}
+}
+
+func (m *MockContentData) BatchGetContents(ctx context.Context, ids []string) ([]*store.Content, error) {
+	contents := make([]*store.Content, len(ids))
+	for i, id := range ids {
+		if content, err := m.GetContent(ctx, id); err == nil {
+			contents[i] = content
+		}
 	}
+	return contents, nil
 }
 
-func (m *MockContentData) ListContents(ctx context.Context, pageSize int32, pageToken string) ([]store.Content, string, error) {
+func (m *MockContentData) ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]store.Content, string, error) {
+	if pageToken == InvalidPageToken {
+		return nil, "", fmt.Errorf("failed to decode page token: %w", pagination.ErrInvalidToken)
+	}
+
+	if filterExpr != "" {
+		if _, err := filter.Parse(filterExpr); err != nil {
+			return nil, "", fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
 	// Return mock list of contents
 	contents := []store.Content{
 		{
@@ -93,7 +120,212 @@ func (m *MockContentData) ListContents(ctx context.Context, pageSize int32, page
 	return []store.Content{}, "", nil
 }
 
-func (m *MockContentData) SearchContents(ctx context.Context, query string, pageSize int32, pageToken string) ([]store.Content, string, error) {
+func (m *MockContentData) SearchContents(ctx context.Context, query string, filters store.SearchFilters, pageSize int32, pageToken string) ([]store.SearchResult, string, error) {
+	if pageToken == InvalidPageToken {
+		return nil, "", fmt.Errorf("failed to decode page token: %w", pagination.ErrInvalidToken)
+	}
+
+	contents, nextPageToken, err := m.searchByQuery(query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Apply the simple equality filters so tests can verify they reach the store
+	filtered := []store.SearchResult{}
+	for _, content := range contents {
+		if matchesAny(content.ContentType, filters.ContentTypes) && matchesAny(content.Language, filters.Languages) {
+			filtered = append(filtered, store.SearchResult{
+				Content:       content,
+				Score:         0.8,
+				MatchedFields: []string{store.MatchedFieldTitle, store.MatchedFieldDescription},
+			})
+		}
+	}
+
+	return filtered, nextPageToken, nil
+}
+
+func (m *MockContentData) SearchFacets(ctx context.Context, query string, filters store.SearchFilters) (*store.SearchFacets, error) {
+	if query == "nonexistent" {
+		return &store.SearchFacets{}, nil
+	}
+
+	return &store.SearchFacets{
+		ContentTypes: []store.FacetBucket{
+			{Value: "podcast", Count: 132},
+			{Value: "documentary", Count: 41},
+		},
+		Languages: []store.FacetBucket{
+			{Value: "en", Count: 150},
+			{Value: "ar", Count: 23},
+		},
+		PlatformNames: []store.FacetBucket{
+			{Value: "YouTube", Count: 173},
+		},
+		Tags: []store.FacetBucket{
+			{Value: "science", Count: 40},
+			{Value: "technology", Count: 35},
+		},
+		Durations: []store.FacetBucket{
+			{Value: store.DurationBucket10To30Minutes, Count: 60},
+			{Value: store.DurationBucket30To60Minutes, Count: 113},
+		},
+	}, nil
+}
+
+func (m *MockContentData) Suggest(ctx context.Context, prefix string, limit int32, order store.SuggestOrder) ([]store.Suggestion, error) {
+	candidates := []store.Suggestion{
+		{Text: "science", Type: store.SuggestionTypeTag, ContentCount: 40},
+		{Text: "Science Friday", Type: store.SuggestionTypeTitle, ContentID: "550e8400-e29b-41d4-a716-446655440000", ContentCount: 1},
+		{Text: "space", Type: store.SuggestionTypeTag, ContentCount: 12},
+		{Text: "Spotify", Type: store.SuggestionTypePlatform, ContentCount: 8},
+		{Text: "التاريخ الإسلامي", Type: store.SuggestionTypeTitle, ContentID: "550e8400-e29b-41d4-a716-446655440001", ContentCount: 1},
+	}
+
+	suggestions := []store.Suggestion{}
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToLower(candidate.Text), strings.ToLower(prefix)) {
+			suggestions = append(suggestions, candidate)
+		}
+	}
+
+	if limit > 0 && len(suggestions) > int(limit) {
+		suggestions = suggestions[:limit]
+	}
+
+	return suggestions, nil
+}
+
+func (m *MockContentData) GetRelatedContents(ctx context.Context, id string, samePlatform bool, pageSize int32, pageToken string) ([]store.Content, string, error) {
+	if pageToken == InvalidPageToken {
+		return nil, "", fmt.Errorf("failed to decode page token: %w", pagination.ErrInvalidToken)
+	}
+
+	source, err := m.GetContent(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The two fixture contents share the "test" tag but not their platform
+	related := []store.Content{}
+	for _, candidateID := range []string{"550e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440001"} {
+		if candidateID == id {
+			continue
+		}
+		candidate, _ := m.GetContent(ctx, candidateID)
+		if samePlatform && candidate.PlatformName != source.PlatformName {
+			continue
+		}
+		related = append(related, *candidate)
+	}
+
+	return related, "", nil
+}
+
+// fixtureIDs are the contents GetContent knows about.
+var fixtureIDs = []string{"550e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440001"}
+
+func (m *MockContentData) ListTags(ctx context.Context, prefix string, order store.TagOrder, pageSize int32, pageToken string) ([]store.Tag, string, error) {
+	if pageToken == InvalidPageToken {
+		return nil, "", fmt.Errorf("failed to decode page token: %w", pagination.ErrInvalidToken)
+	}
+
+	// Count the tags of the fixture contents: "test" is on both
+	counts := map[string]int64{}
+	for _, id := range fixtureIDs {
+		content, _ := m.GetContent(ctx, id)
+		for _, tag := range content.Tags {
+			if strings.HasPrefix(tag, strings.ToLower(prefix)) {
+				counts[tag]++
+			}
+		}
+	}
+
+	tags := []store.Tag{}
+	for name, count := range counts {
+		tags = append(tags, store.Tag{ID: "tag-" + name, Name: name, ContentCount: count})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if order == store.TagsByCount && tags[i].ContentCount != tags[j].ContentCount {
+			return tags[i].ContentCount > tags[j].ContentCount
+		}
+		return tags[i].Name < tags[j].Name
+	})
+
+	return tags, "", nil
+}
+
+func (m *MockContentData) ListContentsByTag(ctx context.Context, tag string, pageSize int32, pageToken string) ([]store.Content, string, error) {
+	if pageToken == InvalidPageToken {
+		return nil, "", fmt.Errorf("failed to decode page token: %w", pagination.ErrInvalidToken)
+	}
+
+	contents := []store.Content{}
+	for _, id := range fixtureIDs {
+		content, _ := m.GetContent(ctx, id)
+		if containsString(content.Tags, tag) {
+			contents = append(contents, *content)
+		}
+	}
+
+	if len(contents) == 0 {
+		return nil, "", fmt.Errorf("tag %q %w", tag, store.ErrNotFound)
+	}
+
+	return contents, "", nil
+}
+
+func (m *MockContentData) RecordEvents(ctx context.Context, events []store.Event) (int64, error) {
+	return int64(len(events)), nil
+}
+
+func (m *MockContentData) RecordSearches(ctx context.Context, searches []store.SearchRecord) (int64, error) {
+	return int64(len(searches)), nil
+}
+
+func (m *MockContentData) ListTrending(ctx context.Context, window store.TrendingWindow, filters store.TrendingFilters, pageSize int32, pageToken string) ([]store.TrendingContent, string, error) {
+	if pageToken == InvalidPageToken {
+		return nil, "", fmt.Errorf("failed to decode page token: %w", pagination.ErrInvalidToken)
+	}
+
+	// The podcast trends over every window, the documentary only over longer ones
+	trending := []store.TrendingContent{}
+	for _, id := range []string{"550e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440001"} {
+		content, _ := m.GetContent(ctx, id)
+		if content.ContentType == "documentary" && window == store.Trending24Hours {
+			continue
+		}
+		if !matchesAny(content.Language, filters.Languages) || !matchesAny(content.ContentType, filters.ContentTypes) {
+			continue
+		}
+		trending = append(trending, store.TrendingContent{Content: *content, Score: 12.5 / float64(len(trending)+1), EventCount: 20})
+	}
+
+	return trending, "", nil
+}
+
+func matchesAny(value string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, candidate := range allowed {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MockContentData) searchByQuery(query string) ([]store.Content, string, error) {
 	// Return search results based on query
 	if query == "podcast" {
 		return []store.Content{
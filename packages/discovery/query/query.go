@@ -0,0 +1,451 @@
+// Package query parses the search syntax accepted by SearchContents, e.g.
+//
+//	"planet earth" tag:nature -lang:en platform:YouTube
+//
+// Supported syntax:
+//   - bare words, matched fuzzily against all searchable text
+//   - "double quoted" phrases, matched literally
+//   - field:value and field:"quoted value" scoping, for the fields listed in
+//     Fields
+//   - a - prefix to exclude a term, phrase, group or field match
+//   - OR between terms, and parentheses for grouping
+//
+// Terms that are not separated by OR must all match. OR binds tighter than
+// the implicit AND, so a b OR c means a AND (b OR c). A word followed by a
+// colon that is not a known field, such as "episode 3:", is plain text, and
+// so is a - that is not directly followed by a term.
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidQuery is wrapped by every syntax error returned by this package,
+// so callers can map them to InvalidArgument.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// Error describes a syntax error in a query. Pos is the 1-based character
+// position of the offending token.
+type Error struct {
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos)
+}
+
+func (e *Error) Unwrap() error {
+	return ErrInvalidQuery
+}
+
+func errorf(pos int, format string, args ...interface{}) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Fields a term can be scoped to.
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldTag         = "tag"
+	FieldPlatform    = "platform"
+	FieldLanguage    = "lang"
+	FieldType        = "type"
+)
+
+// Fields maps every accepted field name, including aliases, to the canonical
+// field it refers to. Names are matched case-insensitively.
+var Fields = map[string]string{
+	"title":         FieldTitle,
+	"description":   FieldDescription,
+	"tag":           FieldTag,
+	"tags":          FieldTag,
+	"platform":      FieldPlatform,
+	"platform_name": FieldPlatform,
+	"lang":          FieldLanguage,
+	"language":      FieldLanguage,
+	"type":          FieldType,
+	"content_type":  FieldType,
+}
+
+// Expr is a node of a parsed query.
+type Expr interface {
+	isExpr()
+}
+
+// And matches when all of its terms match.
+type And struct {
+	Terms []Expr
+}
+
+// Or matches when any of its terms match.
+type Or struct {
+	Terms []Expr
+}
+
+// Not matches when its operand does not.
+type Not struct {
+	Expr Expr
+}
+
+// Term is a word, a run of adjacent words, or a phrase, optionally scoped to
+// a field. Field is empty for unscoped text. Adjacent unscoped words are
+// merged into a single Term so they are matched together, as the whole query
+// was before this syntax existed.
+type Term struct {
+	Field  string
+	Value  string
+	Phrase bool
+	Pos    int
+}
+
+func (And) isExpr()  {}
+func (Or) isExpr()   {}
+func (Not) isExpr()  {}
+func (Term) isExpr() {}
+
+// Query is a parsed search query.
+type Query struct {
+	// Expr is nil for a blank query.
+	Expr Expr
+}
+
+// Text returns the unscoped words and phrases and the title and description
+// values that are not excluded, joined by spaces. It is the text results are
+// ranked against.
+func (q *Query) Text() string {
+	var parts []string
+	q.visit(func(term Term) {
+		switch term.Field {
+		case "", FieldTitle, FieldDescription:
+			parts = append(parts, term.Value)
+		}
+	})
+	return strings.Join(parts, " ")
+}
+
+// Terms returns the distinct words and phrases a result is expected to
+// contain, for highlighting: every value that is not excluded, except
+// language and type values. Words are split on anything that is not a letter,
+// digit or mark; phrases are kept whole.
+func (q *Query) Terms() []string {
+	seen := map[string]bool{}
+	var terms []string
+	add := func(term string) {
+		if term != "" && !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	q.visit(func(term Term) {
+		switch {
+		case term.Field == FieldLanguage || term.Field == FieldType:
+		case term.Phrase:
+			add(term.Value)
+		default:
+			for _, word := range strings.FieldsFunc(term.Value, isSeparator) {
+				add(word)
+			}
+		}
+	})
+	return terms
+}
+
+// visit calls fn for every Term that is not under a Not.
+func (q *Query) visit(fn func(Term)) {
+	var walk func(Expr)
+	walk = func(expr Expr) {
+		switch e := expr.(type) {
+		case And:
+			for _, term := range e.Terms {
+				walk(term)
+			}
+		case Or:
+			for _, term := range e.Terms {
+				walk(term)
+			}
+		case Term:
+			fn(e)
+		}
+	}
+	walk(q.Expr)
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenPhrase
+	tokenField
+	tokenOr
+	tokenMinus
+	tokenLParen
+	tokenRParen
+)
+
+// token is a lexical token. pos is the 1-based position of its first
+// character and end the position just after its last one.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+	end  int
+}
+
+func (t token) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenField:
+		return fmt.Sprintf("%q", t.text+":")
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+func isWordRune(r rune) bool {
+	return !unicode.IsSpace(r) && r != '(' && r != ')' && r != '"'
+}
+
+func tokenize(input string) ([]token, error) {
+	runes := []rune(input)
+	var tokens []token
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: pos, end: pos + 1})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: pos, end: pos + 1})
+			i++
+
+		case r == '"':
+			phrase, next, err := readPhrase(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenPhrase, text: phrase, pos: pos, end: next + 1})
+			i = next
+
+		case r == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) && runes[i+1] != ')' && runes[i+1] != '-':
+			tokens = append(tokens, token{kind: tokenMinus, text: "-", pos: pos, end: pos + 1})
+			i++
+
+		default:
+			start := i
+			for i < len(runes) && isWordRune(runes[i]) && runes[i] != ':' {
+				i++
+			}
+			if i < len(runes) && runes[i] == ':' {
+				if _, ok := Fields[strings.ToLower(string(runes[start:i]))]; ok {
+					name := string(runes[start:i])
+					i++
+					tokens = append(tokens, token{kind: tokenField, text: name, pos: pos, end: i + 1})
+					continue
+				}
+				// Not a field, so the colon is part of the word.
+				for i < len(runes) && isWordRune(runes[i]) {
+					i++
+				}
+			}
+
+			word := string(runes[start:i])
+			kind := tokenWord
+			if word == "OR" {
+				kind = tokenOr
+			}
+			tokens = append(tokens, token{kind: kind, text: word, pos: pos, end: i + 1})
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(runes) + 1, end: len(runes) + 1})
+	return tokens, nil
+}
+
+// readPhrase reads the quoted phrase starting at runes[start], which must be
+// a double quote, and returns its content and the index after the closing
+// quote. A backslash escapes the next character.
+func readPhrase(runes []rune, start int) (string, int, error) {
+	var sb strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && i+1 < len(runes):
+			i++
+			sb.WriteRune(runes[i])
+		case runes[i] == '"':
+			phrase := strings.Join(strings.Fields(sb.String()), " ")
+			if phrase == "" {
+				return "", 0, errorf(start+1, "empty phrase")
+			}
+			return phrase, i + 1, nil
+		default:
+			sb.WriteRune(runes[i])
+		}
+	}
+	return "", 0, errorf(start+1, "unterminated phrase")
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses a search query. A blank input yields a Query with a nil Expr.
+func Parse(input string) (*Query, error) {
+	if strings.TrimSpace(input) == "" {
+		return &Query{}, nil
+	}
+
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseSequence()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, errorf(tok.pos, "unexpected %s", tok.describe())
+	}
+
+	return &Query{Expr: expr}, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// sequence = alternative { alternative }
+func (p *parser) parseSequence() (Expr, error) {
+	var terms []Expr
+
+	for {
+		tok := p.peek()
+		if tok.kind == tokenEOF || tok.kind == tokenRParen {
+			break
+		}
+
+		alternative, err := p.parseAlternative()
+		if err != nil {
+			return nil, err
+		}
+
+		// Merge runs of plain words so "planet earth" is matched as one text.
+		if word, ok := alternative.(Term); ok && word.Field == "" && !word.Phrase && len(terms) > 0 {
+			if last, ok := terms[len(terms)-1].(Term); ok && last.Field == "" && !last.Phrase {
+				last.Value += " " + word.Value
+				terms[len(terms)-1] = last
+				continue
+			}
+		}
+		terms = append(terms, alternative)
+	}
+
+	switch len(terms) {
+	case 0:
+		return nil, errorf(p.peek().pos, "expected a search term, got %s", p.peek().describe())
+	case 1:
+		return terms[0], nil
+	}
+	return And{Terms: terms}, nil
+}
+
+// alternative = unary { "OR" unary }
+func (p *parser) parseAlternative() (Expr, error) {
+	var terms []Expr
+
+	for {
+		term, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+
+		if p.peek().kind != tokenOr {
+			break
+		}
+		p.next()
+	}
+
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return Or{Terms: terms}, nil
+}
+
+// unary = [ "-" ] primary
+func (p *parser) parseUnary() (Expr, error) {
+	if p.peek().kind == tokenMinus {
+		p.next()
+		expr, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+// primary = "(" sequence ")" | [ field ":" ] ( word | phrase )
+func (p *parser) parsePrimary() (Expr, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenLParen:
+		expr, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		closing := p.next()
+		if closing.kind != tokenRParen {
+			return nil, errorf(closing.pos, "expected \")\" to close \"(\" at position %d, got %s", tok.pos, closing.describe())
+		}
+		return expr, nil
+
+	case tokenWord:
+		return Term{Value: tok.text, Pos: tok.pos}, nil
+
+	case tokenPhrase:
+		return Term{Value: tok.text, Phrase: true, Pos: tok.pos}, nil
+
+	case tokenField:
+		// The value must follow the colon directly: "tag: nature" is an error
+		// rather than a search for every tag.
+		value := p.peek()
+		if value.pos != tok.end || (value.kind != tokenWord && value.kind != tokenPhrase && value.kind != tokenOr) {
+			return nil, errorf(tok.pos, "expected a value after %s", tok.describe())
+		}
+		p.next()
+		return Term{Field: Fields[strings.ToLower(tok.text)], Value: value.text, Phrase: value.kind == tokenPhrase, Pos: tok.pos}, nil
+
+	default:
+		return nil, errorf(tok.pos, "expected a search term, got %s", tok.describe())
+	}
+}
@@ -0,0 +1,115 @@
+package query
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_PlainWordsStayOneTerm(t *testing.T) {
+	q, err := Parse("  Planet   Earth II ")
+
+	require.NoError(t, err)
+	assert.Equal(t, Term{Value: "Planet Earth II", Pos: 3}, q.Expr)
+	assert.Equal(t, "Planet Earth II", q.Text())
+	assert.Equal(t, []string{"Planet", "Earth", "II"}, q.Terms())
+}
+
+func TestParse_PhrasesFieldsAndNegation(t *testing.T) {
+	q, err := Parse(`"planet earth" tag:nature -lang:en platform:YouTube`)
+
+	require.NoError(t, err)
+	assert.Equal(t, And{Terms: []Expr{
+		Term{Value: "planet earth", Phrase: true, Pos: 1},
+		Term{Field: FieldTag, Value: "nature", Pos: 16},
+		Not{Expr: Term{Field: FieldLanguage, Value: "en", Pos: 28}},
+		Term{Field: FieldPlatform, Value: "YouTube", Pos: 36},
+	}}, q.Expr)
+	assert.Equal(t, "planet earth", q.Text())
+	assert.Equal(t, []string{"planet earth", "nature", "YouTube"}, q.Terms())
+}
+
+func TestParse_OrBindsTighterThanSequence(t *testing.T) {
+	q, err := Parse(`science type:podcast OR type:documentary -(news OR "breaking news")`)
+
+	require.NoError(t, err)
+	assert.Equal(t, And{Terms: []Expr{
+		Term{Value: "science", Pos: 1},
+		Or{Terms: []Expr{
+			Term{Field: FieldType, Value: "podcast", Pos: 9},
+			Term{Field: FieldType, Value: "documentary", Pos: 25},
+		}},
+		Not{Expr: Or{Terms: []Expr{
+			Term{Value: "news", Pos: 44},
+			Term{Value: "breaking news", Phrase: true, Pos: 52},
+		}}},
+	}}, q.Expr)
+	assert.Equal(t, "science", q.Text())
+	assert.Equal(t, []string{"science"}, q.Terms())
+}
+
+func TestParse_FieldAliasesAndQuotedValues(t *testing.T) {
+	q, err := Parse(`Language:ar title:"الذكاء الاصطناعي"`)
+
+	require.NoError(t, err)
+	assert.Equal(t, And{Terms: []Expr{
+		Term{Field: FieldLanguage, Value: "ar", Pos: 1},
+		Term{Field: FieldTitle, Value: "الذكاء الاصطناعي", Phrase: true, Pos: 13},
+	}}, q.Expr)
+	assert.Equal(t, "الذكاء الاصطناعي", q.Text())
+}
+
+func TestParse_LiteralText(t *testing.T) {
+	cases := map[string]string{
+		"episode 3: the return":   "episode 3: the return",
+		"Spider-Man - Homecoming": "Spider-Man - Homecoming",
+		"https://example.com":     "https://example.com",
+		"rock or roll":            "rock or roll",
+	}
+
+	for input, expected := range cases {
+		q, err := Parse(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, Term{Value: expected, Pos: 1}, q.Expr, input)
+	}
+}
+
+func TestParse_Blank(t *testing.T) {
+	q, err := Parse("   ")
+
+	require.NoError(t, err)
+	assert.Nil(t, q.Expr)
+	assert.Empty(t, q.Text())
+	assert.Empty(t, q.Terms())
+}
+
+func TestParse_Errors(t *testing.T) {
+	cases := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{`planet "earth`, 8, "unterminated phrase"},
+		{`planet ""`, 8, "empty phrase"},
+		{`tag: nature`, 1, `expected a value after "tag:"`},
+		{`science -tag:`, 10, `expected a value after "tag:"`},
+		{`OR science`, 1, `expected a search term, got "OR"`},
+		{`science OR`, 11, "expected a search term, got end of query"},
+		{`(science nature`, 16, `expected ")" to close "(" at position 1, got end of query`},
+		{`science)`, 8, `unexpected ")"`},
+		{`()`, 2, `expected a search term, got ")"`},
+	}
+
+	for _, tc := range cases {
+		_, err := Parse(tc.input)
+		require.Error(t, err, tc.input)
+		assert.True(t, errors.Is(err, ErrInvalidQuery), tc.input)
+
+		var queryErr *Error
+		require.True(t, errors.As(err, &queryErr), tc.input)
+		assert.Equal(t, tc.pos, queryErr.Pos, tc.input)
+		assert.Equal(t, tc.msg, queryErr.Msg, tc.input)
+	}
+}
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
@@ -13,8 +17,12 @@ import (
 
 	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
 
+	"github.com/mosaibah/Mawjood/packages/discovery/cache"
+	"github.com/mosaibah/Mawjood/packages/discovery/events"
+	"github.com/mosaibah/Mawjood/packages/discovery/index"
 	"github.com/mosaibah/Mawjood/packages/discovery/store"
 	v1 "github.com/mosaibah/Mawjood/packages/discovery/v1"
+	"github.com/mosaibah/Mawjood/packages/pagination"
 )
 
 func main() {
@@ -25,6 +33,19 @@ func main() {
 	dbPassword := getEnv("DB_PASSWORD", "")
 	dbSSLMode := getEnv("DB_SSL_MODE", "disable")
 	servicePort := getEnv("SERVICE_PORT", "9002")
+	pageTokenSecret := getEnv("PAGE_TOKEN_SECRET", "")
+	searchBackend := getEnv("SEARCH_BACKEND", "sql")
+	searchIndexRefresh := getEnv("SEARCH_INDEX_REFRESH", "1m")
+	searchBoosts := getEnv("SEARCH_BOOSTS", "")
+	eventFlushInterval := getEnv("EVENT_FLUSH_INTERVAL", "1s")
+	cacheEnabled := getEnv("CACHE_ENABLED", "false")
+	cacheSize := getEnv("CACHE_SIZE", "10000")
+	cacheContentTTL := getEnv("CACHE_CONTENT_TTL", "1m")
+	cacheListTTL := getEnv("CACHE_LIST_TTL", "30s")
+	cacheSearchTTL := getEnv("CACHE_SEARCH_TTL", "30s")
+	statsInterval := getEnv("STATS_INTERVAL", "5m")
+	staleReads := getEnv("STALE_READS", "none")
+	consistentReadRPCs := getEnv("CONSISTENT_READ_RPCS", "GetContent")
 
 	connStr := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s&parseTime=true",
 		dbUser, dbPassword, dbHost, dbPort, dbName, dbSSLMode)
@@ -39,15 +60,149 @@ func main() {
 		log.Fatalf("failed to ping database: %v", err)
 	}
 
-	store := store.New(db)
-	service := v1.New(store)
+	if pageTokenSecret == "" {
+		log.Printf("PAGE_TOKEN_SECRET is not set; page tokens will not survive restarts or work across replicas")
+	}
+	cursors := pagination.NewCodec([]byte(pageTokenSecret), pagination.DefaultTTL)
+
+	boosts, err := store.ParseBoosts(searchBoosts)
+	if err != nil {
+		log.Fatalf("invalid SEARCH_BOOSTS: %v", err)
+	}
+
+	flushInterval, err := time.ParseDuration(eventFlushInterval)
+	if err != nil {
+		log.Fatalf("invalid EVENT_FLUSH_INTERVAL: %v", err)
+	}
+
+	statsPeriod, err := time.ParseDuration(statsInterval)
+	if err != nil {
+		log.Fatalf("invalid STATS_INTERVAL: %v", err)
+	}
+
+	staleness, err := store.ParseStaleness(staleReads)
+	if err != nil {
+		log.Fatalf("invalid STALE_READS: %v", err)
+	}
+
+	var consistentMethods []string
+	for _, method := range strings.Split(consistentReadRPCs, ",") {
+		if method = strings.TrimSpace(method); method != "" {
+			consistentMethods = append(consistentMethods, method)
+		}
+	}
+	consistentReads, err := v1.ConsistentReads(staleness, consistentMethods...)
+	if err != nil {
+		log.Fatalf("invalid CONSISTENT_READ_RPCS: %v", err)
+	}
+
+	if staleness != (store.Staleness{}) {
+		log.Printf("stale reads enabled - staleness: %s, consistent RPCs: %v", staleness, consistentMethods)
+	}
+
+	store := store.New(db, store.WithCursorCodec(cursors), store.WithBoosts(boosts), store.WithStaleness(staleness))
+
+	recorder := events.NewRecorder(store, events.WithFlushInterval(flushInterval))
+	go recorder.Run(context.Background())
+
+	searchLog := events.NewSearchLog(store, events.WithFlushInterval(flushInterval))
+	go searchLog.Run(context.Background())
+
+	// Reads can lag writes by the staleness window plus however long the cache
+	// and search index hold on to what they read. Tokens older than that are
+	// met by any read; the extra second absorbs clock skew between services.
+	horizon := staleness.Window() + time.Second
+
+	opts := []v1.Option{v1.WithEventRecorder(recorder), v1.WithSearchLog(searchLog)}
+	switch searchBackend {
+	case "sql":
+	case "memory":
+		refreshInterval, err := time.ParseDuration(searchIndexRefresh)
+		if err != nil {
+			log.Fatalf("invalid SEARCH_INDEX_REFRESH: %v", err)
+		}
+
+		memoryIndex := index.NewMemory(index.WithCursorCodec(cursors), index.WithBoosts(boosts))
+		if _, err := memoryIndex.Sync(context.Background(), store); err != nil {
+			log.Fatalf("failed to build search index: %v", err)
+		}
+		log.Printf("in-memory search index built with %d contents", memoryIndex.Len())
+
+		go func() {
+			for range time.Tick(refreshInterval) {
+				changes, err := memoryIndex.Sync(context.Background(), store)
+				if err != nil {
+					log.Printf("failed to refresh search index: %v", err)
+					continue
+				}
+				if changes > 0 {
+					log.Printf("search index refreshed - changes: %d", changes)
+				}
+			}
+		}()
+
+		horizon += refreshInterval
+		opts = append(opts, v1.WithSearchIndex(memoryIndex))
+	default:
+		log.Fatalf("unknown SEARCH_BACKEND %q, expected \"sql\" or \"memory\"", searchBackend)
+	}
+
+	// The recorder, search log and search index keep reading the store
+	// directly; only requests are served through the cache.
+	serviceStore := store
+	if cacheEnabled == "true" {
+		size, err := strconv.Atoi(cacheSize)
+		if err != nil || size <= 0 {
+			log.Fatalf("invalid CACHE_SIZE %q, expected a positive number of entries", cacheSize)
+		}
+		contentTTL, err := time.ParseDuration(cacheContentTTL)
+		if err != nil {
+			log.Fatalf("invalid CACHE_CONTENT_TTL: %v", err)
+		}
+		listTTL, err := time.ParseDuration(cacheListTTL)
+		if err != nil {
+			log.Fatalf("invalid CACHE_LIST_TTL: %v", err)
+		}
+		searchTTL, err := time.ParseDuration(cacheSearchTTL)
+		if err != nil {
+			log.Fatalf("invalid CACHE_SEARCH_TTL: %v", err)
+		}
+
+		cached := cache.New(store,
+			cache.WithCapacity(size),
+			cache.WithContentTTL(contentTTL),
+			cache.WithListTTL(listTTL),
+			cache.WithSearchTTL(searchTTL),
+		)
+		log.Printf("read-through cache enabled - size: %d, TTLs content: %s, list: %s, search: %s", size, contentTTL, listTTL, searchTTL)
+
+		go func() {
+			for range time.Tick(statsPeriod) {
+				log.Printf("cache stats - %s", cached.Stats())
+			}
+		}()
+
+		serviceStore = cached
+		horizon += max(contentTTL, listTTL, searchTTL)
+	}
+
+	log.Printf("read-your-writes consistency horizon: %s", horizon)
+	opts = append(opts, v1.WithConsistencyHorizon(horizon))
+	service := v1.New(serviceStore, opts...)
+
+	go func() {
+		for range time.Tick(statsPeriod) {
+			stats := service.SearchCoalescingStats()
+			log.Printf("search coalescing stats - searches: %d, collapsed: %d", stats.Searches, stats.Collapsed)
+		}
+	}()
 
 	lis, err := net.Listen("tcp", ":"+servicePort)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(consistentReads))
 	mawjoodv1.RegisterDiscoveryServiceServer(grpcServer, service)
 
 	reflection.Register(grpcServer)
@@ -0,0 +1,343 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/mosaibah/Mawjood/packages/pagination"
+)
+
+// Event types accepted by RecordEvents.
+const (
+	EventTypeView         = "view"
+	EventTypePlayStart    = "play_start"
+	EventTypePlayComplete = "play_complete"
+	EventTypeShare        = "share"
+)
+
+// EventDedupeWindow is how long a session's repeats of an event count once.
+// Events are keyed by the window-aligned bucket they occurred in, so a repeat
+// at least this long after the first always lands in a later bucket and is
+// counted again.
+const EventDedupeWindow = 30 * time.Minute
+
+// eventWeights is how much one event of each type adds to a trending score.
+// Deeper engagement counts for more.
+var eventWeights = []struct {
+	eventType string
+	weight    float64
+}{
+	{EventTypeView, 1},
+	{EventTypePlayStart, 2},
+	{EventTypePlayComplete, 3},
+	{EventTypeShare, 5},
+}
+
+// Event is a single user interaction with a content.
+type Event struct {
+	ContentID  string
+	Type       string
+	SessionID  string
+	OccurredAt time.Time
+}
+
+// SearchRecord is one logged SearchContents call.
+type SearchRecord struct {
+	// Query is the normalized query text.
+	Query       string
+	ResultCount int
+	Latency     time.Duration
+	// PageDepth is the number of the page returned, starting at 1.
+	PageDepth  int
+	SearchedAt time.Time
+}
+
+// TrendingWindow is the period over which ListTrending counts events.
+type TrendingWindow int
+
+const (
+	Trending24Hours TrendingWindow = iota
+	Trending7Days
+	Trending30Days
+)
+
+// Duration returns the length of the window.
+func (w TrendingWindow) Duration() time.Duration {
+	switch w {
+	case Trending7Days:
+		return 7 * 24 * time.Hour
+	case Trending30Days:
+		return 30 * 24 * time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// HalfLife returns the age at which an event counts for half as much as a
+// fresh one: a quarter of the window, so the last few hours of a day or the
+// last couple of days of a week dominate.
+func (w TrendingWindow) HalfLife() time.Duration {
+	return w.Duration() / 4
+}
+
+// TrendingFilters narrows ListTrending. Values within a field are OR-ed and
+// empty fields are unfiltered.
+type TrendingFilters struct {
+	Languages    []string
+	ContentTypes []string
+}
+
+// TrendingContent is a content ranked by ListTrending.
+type TrendingContent struct {
+	Content
+	Score      float64
+	EventCount int64
+}
+
+// RecordEvents inserts events in a single statement and returns how many were
+// stored. Events for unknown or deleted contents are dropped, as are events a
+// session has already recorded for the same content within the same
+// EventDedupeWindow bucket.
+func (cd *ContentData) RecordEvents(ctx context.Context, events []Event) (int64, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	values := make([]string, 0, len(events))
+	args := make([]interface{}, 0, 5*len(events))
+	for _, event := range events {
+		args = append(args, event.ContentID, event.Type, event.SessionID, event.OccurredAt, dedupeBucket(event.OccurredAt))
+		n := len(args)
+		values = append(values, fmt.Sprintf("($%d::UUID, $%d, $%d, $%d::TIMESTAMPTZ, $%d::TIMESTAMPTZ)", n-4, n-3, n-2, n-1, n))
+	}
+
+	insertQuery := fmt.Sprintf(`
+		INSERT INTO content_events (content_id, event_type, session_id, occurred_at, dedupe_bucket)
+		SELECT v.content_id, v.event_type, v.session_id, v.occurred_at, v.dedupe_bucket
+		FROM (VALUES %s) AS v (content_id, event_type, session_id, occurred_at, dedupe_bucket)
+		INNER JOIN contents c ON c.id = v.content_id AND c.deleted_at IS NULL
+		ON CONFLICT (session_id, content_id, event_type, dedupe_bucket) DO NOTHING`, strings.Join(values, ", "))
+
+	result, err := cd.db.ExecContext(ctx, insertQuery, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record events: %w", err)
+	}
+
+	recorded, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get recorded events count: %w", err)
+	}
+
+	return recorded, nil
+}
+
+// dedupeBucket returns the start of the EventDedupeWindow bucket t falls in.
+// Buckets are aligned to the Unix epoch, like the backfill in db_setup.sql.
+func dedupeBucket(t time.Time) time.Time {
+	return t.UTC().Truncate(EventDedupeWindow)
+}
+
+// RecordSearches inserts search records in a single statement and returns how
+// many were stored.
+func (cd *ContentData) RecordSearches(ctx context.Context, searches []SearchRecord) (int64, error) {
+	if len(searches) == 0 {
+		return 0, nil
+	}
+
+	values := make([]string, 0, len(searches))
+	args := make([]interface{}, 0, 5*len(searches))
+	for _, search := range searches {
+		args = append(args, search.Query, search.ResultCount, search.Latency.Milliseconds(), search.PageDepth, search.SearchedAt)
+		n := len(args)
+		values = append(values, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", n-4, n-3, n-2, n-1, n))
+	}
+
+	insertQuery := fmt.Sprintf(`
+		INSERT INTO search_queries (query, result_count, latency_ms, page_depth, searched_at)
+		VALUES %s`, strings.Join(values, ", "))
+
+	result, err := cd.db.ExecContext(ctx, insertQuery, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record searches: %w", err)
+	}
+
+	recorded, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get recorded searches count: %w", err)
+	}
+
+	return recorded, nil
+}
+
+// ListTrending ranks contents by the events recorded within window. Each
+// event adds its type's weight, halved for every window.HalfLife() of age.
+// The time the first page was computed at is carried in the page token, so
+// later pages rank with the same decay and do not shift.
+func (cd *ContentData) ListTrending(ctx context.Context, window TrendingWindow, filters TrendingFilters, pageSize int32, pageToken string) ([]TrendingContent, string, error) {
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	scope := pagination.Scope("discovery.ListTrending", strconv.Itoa(int(window)), fmt.Sprintf("%+v", filters))
+
+	asOf := time.Now().UTC()
+	var afterScore float64
+	var afterID string
+	if pageToken != "" {
+		cursor, err := cd.cursors.Decode(pageToken, scope, 3)
+		if err != nil {
+			return nil, "", err
+		}
+		if asOf, err = time.Parse(time.RFC3339Nano, cursor.Keys[0]); err != nil {
+			return nil, "", fmt.Errorf("%w: bad as_of", pagination.ErrInvalidToken)
+		}
+		if afterScore, err = strconv.ParseFloat(cursor.Keys[1], 64); err != nil {
+			return nil, "", fmt.Errorf("%w: bad score", pagination.ErrInvalidToken)
+		}
+		afterID = cursor.Keys[2]
+	}
+
+	args := []interface{}{asOf, asOf.Add(-window.Duration()), window.HalfLife().Seconds()}
+
+	var conditions []string
+	if len(filters.Languages) > 0 {
+		args = append(args, pq.Array(filters.Languages))
+		conditions = append(conditions, fmt.Sprintf("c.language = ANY($%d)", len(args)))
+	}
+	if len(filters.ContentTypes) > 0 {
+		args = append(args, pq.Array(filters.ContentTypes))
+		conditions = append(conditions, fmt.Sprintf("c.content_type = ANY($%d)", len(args)))
+	}
+	if pageToken != "" {
+		args = append(args, afterScore, afterID)
+		conditions = append(conditions, fmt.Sprintf("(s.score, c.id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	var filterClause string
+	if len(conditions) > 0 {
+		filterClause = "\n\t\t\tAND " + strings.Join(conditions, "\n\t\t\tAND ")
+	}
+
+	args = append(args, pageSize+1)
+
+	trendingQuery := fmt.Sprintf(`
+		WITH scores AS (
+			SELECT
+				content_id,
+				SUM(
+					%s *
+					EXP(-LN(2) * EXTRACT(EPOCH FROM ($1::TIMESTAMPTZ - occurred_at)) / $3::FLOAT8)
+				)::FLOAT8 as score,
+				COUNT(*) as event_count
+			FROM content_events
+			WHERE occurred_at > $2 AND occurred_at <= $1
+			GROUP BY content_id
+		)
+		SELECT c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, c.content_type, c.created_at, c.updated_at, c.url, c.platform_name, s.score, s.event_count
+		FROM scores s
+		INNER JOIN contents c ON c.id = s.content_id
+		WHERE c.deleted_at IS NULL%s
+		ORDER BY s.score DESC, c.id DESC
+		LIMIT $%d`, eventWeightExpression(), filterClause, len(args))
+
+	q, done, err := cd.reader(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer done()
+
+	rows, err := q.QueryContext(ctx, trendingQuery, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list trending contents: %w", err)
+	}
+	defer rows.Close()
+
+	var results []TrendingContent
+	for rows.Next() {
+		var content Content
+		var createdAt, updatedAt time.Time
+		var publishedAt sql.NullTime
+		var description, language, url, platformName sql.NullString
+		var durationSeconds sql.NullInt32
+		var score float64
+		var eventCount int64
+
+		err := rows.Scan(
+			&content.ID,
+			&content.Title,
+			&description,
+			&language,
+			&durationSeconds,
+			&publishedAt,
+			&content.ContentType,
+			&createdAt,
+			&updatedAt,
+			&url,
+			&platformName,
+			&score,
+			&eventCount,
+		)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan content row: %w", err)
+		}
+
+		content.Description = description.String
+		content.Language = language.String
+		content.ExternalURL = url.String
+		content.PlatformName = platformName.String
+		content.DurationSeconds = durationSeconds.Int32
+		content.PublishedAt = publishedAt.Time
+		content.CreatedAt = createdAt
+		content.UpdatedAt = updatedAt
+
+		results = append(results, TrendingContent{Content: content, Score: score, EventCount: eventCount})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating over trending contents: %w", err)
+	}
+
+	var nextPageToken string
+	if len(results) > int(pageSize) {
+		results = results[:pageSize]
+		last := results[len(results)-1]
+		nextPageToken, err = cd.cursors.Encode(scope,
+			asOf.Format(time.RFC3339Nano),
+			strconv.FormatFloat(last.Score, 'g', -1, 64),
+			last.ID,
+		)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	page := make([]*Content, len(results))
+	for i := range results {
+		page[i] = &results[i].Content
+	}
+	if err := cd.loadTags(ctx, q, page); err != nil {
+		return nil, "", err
+	}
+
+	return results, nextPageToken, nil
+}
+
+// eventWeightExpression renders eventWeights as a CASE over event_type.
+func eventWeightExpression() string {
+	var b strings.Builder
+	b.WriteString("CASE event_type")
+	for _, w := range eventWeights {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %s", w.eventType, strconv.FormatFloat(w.weight, 'f', -1, 64))
+	}
+	b.WriteString(" ELSE 0 END")
+	return b.String()
+}
@@ -0,0 +1,207 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/mosaibah/Mawjood/packages/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	occurredAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`INSERT INTO content_events \(content_id, event_type, session_id, occurred_at, dedupe_bucket\) SELECT .* FROM \(VALUES \(\$1::UUID, \$2, \$3, \$4::TIMESTAMPTZ, \$5::TIMESTAMPTZ\), \(\$6::UUID, \$7, \$8, \$9::TIMESTAMPTZ, \$10::TIMESTAMPTZ\)\) AS v .* INNER JOIN contents c ON c\.id = v\.content_id AND c\.deleted_at IS NULL ON CONFLICT \(session_id, content_id, event_type, dedupe_bucket\) DO NOTHING`).
+		WithArgs("id1", EventTypeView, "s1", occurredAt, occurredAt, "id2", EventTypeShare, "s1", occurredAt, occurredAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	recorded, err := store.RecordEvents(context.Background(), []Event{
+		{ContentID: "id1", Type: EventTypeView, SessionID: "s1", OccurredAt: occurredAt},
+		{ContentID: "id2", Type: EventTypeShare, SessionID: "s1", OccurredAt: occurredAt},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), recorded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordEvents_RepeatAfterDedupeWindow(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	first := time.Date(2024, 1, 15, 10, 20, 0, 0, time.UTC)
+	repeat := first.Add(EventDedupeWindow)
+
+	// The repeat falls in a later bucket, so the unique key differs and both
+	// rows are stored.
+	mock.ExpectExec(`INSERT INTO content_events .* ON CONFLICT \(session_id, content_id, event_type, dedupe_bucket\) DO NOTHING`).
+		WithArgs(
+			"id1", EventTypeView, "s1", first, time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+			"id1", EventTypeView, "s1", repeat, time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	recorded, err := store.RecordEvents(context.Background(), []Event{
+		{ContentID: "id1", Type: EventTypeView, SessionID: "s1", OccurredAt: first},
+		{ContentID: "id1", Type: EventTypeView, SessionID: "s1", OccurredAt: repeat},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), recorded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDedupeBucket(t *testing.T) {
+	testCases := []struct {
+		occurredAt time.Time
+		want       time.Time
+	}{
+		{time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 1, 15, 10, 29, 59, 0, time.UTC), time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)},
+		{time.Date(2024, 1, 15, 16, 15, 0, 0, time.FixedZone("AST", 3*60*60)), time.Date(2024, 1, 15, 13, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.want, dedupeBucket(tc.occurredAt), tc.occurredAt.String())
+	}
+}
+
+func TestRecordEvents_Empty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	recorded, err := New(db).RecordEvents(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Zero(t, recorded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordSearches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	searchedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`INSERT INTO search_queries \(query, result_count, latency_ms, page_depth, searched_at\) VALUES \(\$1, \$2, \$3, \$4, \$5\), \(\$6, \$7, \$8, \$9, \$10\)`).
+		WithArgs("planet earth", 10, int64(12), 1, searchedAt, "planet earth", 0, int64(8), 3, searchedAt).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	recorded, err := store.RecordSearches(context.Background(), []SearchRecord{
+		{Query: "planet earth", ResultCount: 10, Latency: 12 * time.Millisecond, PageDepth: 1, SearchedAt: searchedAt},
+		{Query: "planet earth", ResultCount: 0, Latency: 8 * time.Millisecond, PageDepth: 3, SearchedAt: searchedAt},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), recorded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTrending_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	filters := TrendingFilters{Languages: []string{"en"}, ContentTypes: []string{"podcast"}}
+
+	rows := sqlmock.NewRows([]string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "event_count",
+	}).AddRow(
+		"id1", "Science Friday", "Weekly science", "en", 3600,
+		createdAt, "podcast", createdAt, createdAt, "https://example.com/1", "Spotify", 7.5, 4,
+	).AddRow(
+		"id2", "Radiolab", nil, "en", 1800,
+		createdAt, "podcast", createdAt, createdAt, nil, nil, 2.0, 2,
+	)
+
+	mock.ExpectQuery(`WITH scores AS \( SELECT content_id, SUM\( CASE event_type WHEN 'view' THEN 1 WHEN 'play_start' THEN 2 WHEN 'play_complete' THEN 3 WHEN 'share' THEN 5 ELSE 0 END \* EXP\(.*\) / \$3::FLOAT8\) \)::FLOAT8 as score, COUNT\(\*\) as event_count FROM content_events WHERE occurred_at > \$2 AND occurred_at <= \$1 GROUP BY content_id \).* WHERE c\.deleted_at IS NULL AND c\.language = ANY\(\$4\) AND c\.content_type = ANY\(\$5\) ORDER BY s\.score DESC, c\.id DESC LIMIT \$6`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 21600.0, pq.Array([]string{"en"}), pq.Array([]string{"podcast"}), 2).
+		WillReturnRows(rows)
+
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow("id1", "science"))
+
+	contents, nextPageToken, err := store.ListTrending(context.Background(), Trending24Hours, filters, 1, "")
+
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	assert.Equal(t, "id1", contents[0].ID)
+	assert.Equal(t, 7.5, contents[0].Score)
+	assert.Equal(t, int64(4), contents[0].EventCount)
+	assert.Equal(t, []string{"science"}, contents[0].Tags)
+	require.NotEmpty(t, nextPageToken)
+
+	cursor, err := codec.Decode(nextPageToken, pagination.Scope("discovery.ListTrending", "0", "{Languages:[en] ContentTypes:[podcast]}"), 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"7.5", "id1"}, cursor.Keys[1:])
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTrending_WithPageToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+	asOf := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	pageToken, err := codec.Encode(pagination.Scope("discovery.ListTrending", "1", "{Languages:[] ContentTypes:[]}"), asOf.Format(time.RFC3339Nano), "7.5", "id1")
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`WHERE c\.deleted_at IS NULL AND \(s\.score, c\.id\) < \(\$4, \$5\) ORDER BY s\.score DESC, c\.id DESC LIMIT \$6`).
+		WithArgs(asOf, asOf.Add(-7*24*time.Hour), (42 * time.Hour).Seconds(), 7.5, "id1", 11).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "event_count",
+		}))
+
+	contents, nextPageToken, err := store.ListTrending(context.Background(), Trending7Days, TrendingFilters{}, 10, pageToken)
+
+	require.NoError(t, err)
+	assert.Empty(t, contents)
+	assert.Empty(t, nextPageToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTrending_InvalidPageToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+
+	// A token issued for another window is not valid here.
+	otherToken, err := codec.Encode(pagination.Scope("discovery.ListTrending", "2", "{Languages:[] ContentTypes:[]}"), time.Now().Format(time.RFC3339Nano), "7.5", "id1")
+	require.NoError(t, err)
+
+	for _, pageToken := range []string{"not-a-token", otherToken} {
+		_, _, err = store.ListTrending(context.Background(), Trending7Days, TrendingFilters{}, 10, pageToken)
+		assert.ErrorIs(t, err, pagination.ErrInvalidToken, pageToken)
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,142 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Staleness is how far behind the latest writes the store's reads may be.
+// Stale reads let CockroachDB serve them from the nearest replica instead of
+// the leaseholder, which lowers latency and spreads load. The zero value reads
+// the latest data.
+type Staleness struct {
+	follower bool
+	bound    time.Duration
+}
+
+// FollowerReads reads as of follower_read_timestamp(), the most recent time
+// any replica can serve, typically about 4.8s in the past.
+func FollowerReads() Staleness {
+	return Staleness{follower: true}
+}
+
+// BoundedStaleness reads data exactly bound old. CockroachDB's own
+// with_max_staleness() only supports single-row lookups, so reads use a fixed
+// timestamp, which never exceeds the bound. Bounds shorter than the follower
+// read delay still go to the leaseholder.
+func BoundedStaleness(bound time.Duration) Staleness {
+	return Staleness{bound: bound}
+}
+
+// ParseStaleness parses a staleness setting: "" or "none" for the latest
+// data, "follower" for FollowerReads, or a duration such as "10s" for
+// BoundedStaleness.
+func ParseStaleness(s string) (Staleness, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "none":
+		return Staleness{}, nil
+	case "follower":
+		return FollowerReads(), nil
+	}
+
+	bound, err := time.ParseDuration(s)
+	if err != nil || bound <= 0 {
+		return Staleness{}, fmt.Errorf("staleness %q is not \"none\", \"follower\" or a positive duration", s)
+	}
+	return BoundedStaleness(bound), nil
+}
+
+// String formats s as ParseStaleness accepts it.
+func (s Staleness) String() string {
+	switch {
+	case s.follower:
+		return "follower"
+	case s.bound > 0:
+		return s.bound.String()
+	default:
+		return "none"
+	}
+}
+
+// followerReadWindow is how far behind the latest writes follower reads are
+// assumed to be at most. follower_read_timestamp() trails by about 4.8s with
+// the default cluster settings; the rest is headroom.
+const followerReadWindow = 10 * time.Second
+
+// Window returns how far behind the latest writes reads may be.
+func (s Staleness) Window() time.Duration {
+	if s.follower {
+		return followerReadWindow
+	}
+	return s.bound
+}
+
+// asOf returns the AS OF SYSTEM TIME expression for s, or "" when reads are
+// not stale.
+func (s Staleness) asOf() string {
+	switch {
+	case s.follower:
+		return "follower_read_timestamp()"
+	case s.bound > 0:
+		return fmt.Sprintf("'-%dms'", s.bound.Milliseconds())
+	default:
+		return ""
+	}
+}
+
+// WithStaleness lets reads return data up to staleness old. Writes and reads
+// under a WithConsistentRead context are not affected.
+func WithStaleness(staleness Staleness) Option {
+	return func(cd *ContentData) {
+		cd.staleness = staleness
+	}
+}
+
+type consistentReadKey struct{}
+
+// WithConsistentRead returns a context under which the store reads the latest
+// data whatever its configured staleness.
+func WithConsistentRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, consistentReadKey{}, true)
+}
+
+// IsConsistentRead reports whether ctx was returned by WithConsistentRead.
+func IsConsistentRead(ctx context.Context) bool {
+	consistent, _ := ctx.Value(consistentReadKey{}).(bool)
+	return consistent
+}
+
+// querier runs the statements of a read, on the database or in a stale
+// read transaction.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// reader returns what a read should run its statements on, and a function to
+// call once it is done. Stale reads run in a read-only transaction pinned to
+// the staleness timestamp, so all the statements of a read, such as a page
+// and its tags, see the same snapshot.
+func (cd *ContentData) reader(ctx context.Context) (querier, func(), error) {
+	asOf := cd.staleness.asOf()
+	if asOf == "" || IsConsistentRead(ctx) {
+		return cd.db, func() {}, nil
+	}
+
+	tx, err := cd.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin stale read: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "SET TRANSACTION AS OF SYSTEM TIME "+asOf); err != nil {
+		_ = tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to set stale read timestamp: %w", err)
+	}
+
+	// Nothing was written, so ending the transaction with a rollback is
+	// as good as a commit.
+	return tx, func() { _ = tx.Rollback() }, nil
+}
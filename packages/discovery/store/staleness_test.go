@@ -0,0 +1,146 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const getContentQuery = `SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name FROM contents WHERE id = \$1 AND deleted_at IS NULL`
+
+func expectGetContent(mock sqlmock.Sqlmock, id string) {
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(getContentQuery).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name",
+		}).AddRow(id, "Planet Earth", "Wildlife", "en", 3600, createdAt, "documentary", createdAt, createdAt, nil, nil))
+	mock.ExpectQuery(`SELECT t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = \$1 ORDER BY t\.name`).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("nature"))
+}
+
+func TestParseStaleness(t *testing.T) {
+	cases := map[string]Staleness{
+		"":         {},
+		"none":     {},
+		"Follower": FollowerReads(),
+		"10s":      BoundedStaleness(10 * time.Second),
+	}
+	for input, expected := range cases {
+		staleness, err := ParseStaleness(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, staleness, input)
+	}
+
+	for _, input := range []string{"stale", "-5s", "0s"} {
+		_, err := ParseStaleness(input)
+		assert.Error(t, err, input)
+	}
+
+	assert.Equal(t, "none", Staleness{}.String())
+	assert.Equal(t, "follower", FollowerReads().String())
+	assert.Equal(t, "1m30s", BoundedStaleness(90*time.Second).String())
+
+	assert.Equal(t, time.Duration(0), Staleness{}.Window())
+	assert.Equal(t, 90*time.Second, BoundedStaleness(90*time.Second).Window())
+	assert.Greater(t, FollowerReads().Window(), 5*time.Second)
+}
+
+func TestGetContent_FollowerReads(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db, WithStaleness(FollowerReads()))
+	contentID := "550e8400-e29b-41d4-a716-446655440000"
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SET TRANSACTION AS OF SYSTEM TIME follower_read_timestamp\(\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectGetContent(mock, contentID)
+	mock.ExpectRollback()
+
+	content, err := store.GetContent(context.Background(), contentID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Planet Earth", content.Title)
+	assert.Equal(t, []string{"nature"}, content.Tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContents_BoundedStalenessReadsPageAndTagsInOneSnapshot(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db, WithStaleness(BoundedStaleness(10*time.Second)))
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SET TRANSACTION AS OF SYSTEM TIME '-10000ms'`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`FROM contents WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC LIMIT \$1`).
+		WithArgs(11).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name",
+		}).AddRow("id1", "Planet Earth", "Wildlife", "en", 3600, createdAt, "documentary", createdAt, createdAt, nil, nil))
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).AddRow("id1", "nature"))
+	mock.ExpectRollback()
+
+	contents, _, err := store.ListContents(context.Background(), 10, "", "", "")
+
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	assert.Equal(t, []string{"nature"}, contents[0].Tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetContent_ConsistentReadIgnoresStaleness(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db, WithStaleness(FollowerReads()))
+	contentID := "550e8400-e29b-41d4-a716-446655440000"
+
+	expectGetContent(mock, contentID)
+
+	ctx := WithConsistentRead(context.Background())
+	assert.True(t, IsConsistentRead(ctx))
+	assert.False(t, IsConsistentRead(context.Background()))
+
+	_, err = store.GetContent(ctx, contentID)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetContent_StaleReadSetupFails(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db, WithStaleness(FollowerReads()))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SET TRANSACTION AS OF SYSTEM TIME`).
+		WillReturnError(errors.New("follower reads are not enabled"))
+	mock.ExpectRollback()
+
+	_, err = store.GetContent(context.Background(), "550e8400-e29b-41d4-a716-446655440000")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to set stale read timestamp")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,25 +3,95 @@ package store
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
+	"github.com/mosaibah/Mawjood/packages/discovery/query"
+	"github.com/mosaibah/Mawjood/packages/filter"
+	"github.com/mosaibah/Mawjood/packages/pagination"
+	"github.com/mosaibah/Mawjood/packages/textnorm"
 )
 
+// ErrNotFound is wrapped by the errors returned for unknown or deleted
+// contents.
+var ErrNotFound = errors.New("not found")
+
 type ContentData struct {
-	db *sql.DB
+	db          *sql.DB
+	cursors     *pagination.Codec
+	normalizers *textnorm.Registry
+	boosts      Boosts
+	staleness   Staleness
+}
+
+// Option configures a ContentData created by New.
+type Option func(*ContentData)
+
+// WithCursorCodec sets the codec used to sign and verify page tokens. Without
+// it, tokens are signed with a random per-process secret.
+func WithCursorCodec(codec *pagination.Codec) Option {
+	return func(cd *ContentData) {
+		cd.cursors = codec
+	}
+}
+
+// WithNormalizers sets the registry used to normalize search queries. It must
+// match the one the CMS uses to index content; defaults to textnorm.Default().
+func WithNormalizers(normalizers *textnorm.Registry) Option {
+	return func(cd *ContentData) {
+		cd.normalizers = normalizers
+	}
+}
+
+// WithBoosts sets the relevance weights used to score search results.
+// Defaults to DefaultBoosts().
+func WithBoosts(boosts Boosts) Option {
+	return func(cd *ContentData) {
+		cd.boosts = boosts
+	}
 }
 
 type Interface interface {
+	SearchIndex
 	GetContent(ctx context.Context, id string) (*Content, error)
-	ListContents(ctx context.Context, pageSize int32, pageToken string) ([]Content, string, error)
-	SearchContents(ctx context.Context, query string, pageSize int32, pageToken string) ([]Content, string, error)
+	BatchGetContents(ctx context.Context, ids []string) ([]*Content, error)
+	ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]Content, string, error)
+	Suggest(ctx context.Context, prefix string, limit int32, order SuggestOrder) ([]Suggestion, error)
+	GetRelatedContents(ctx context.Context, id string, samePlatform bool, pageSize int32, pageToken string) ([]Content, string, error)
+	ListTags(ctx context.Context, prefix string, order TagOrder, pageSize int32, pageToken string) ([]Tag, string, error)
+	ListContentsByTag(ctx context.Context, tag string, pageSize int32, pageToken string) ([]Content, string, error)
+	RecordEvents(ctx context.Context, events []Event) (int64, error)
+	RecordSearches(ctx context.Context, searches []SearchRecord) (int64, error)
+	ListTrending(ctx context.Context, window TrendingWindow, filters TrendingFilters, pageSize int32, pageToken string) ([]TrendingContent, string, error)
 }
 
-func New(db *sql.DB) Interface {
-	return &ContentData{db: db}
+// SearchIndex answers full-text search queries over the catalog. ContentData
+// implements it with CockroachDB trigram matching; other backends, such as the
+// in-memory index in packages/discovery/index, can serve search without
+// touching the database.
+type SearchIndex interface {
+	SearchContents(ctx context.Context, query string, filters SearchFilters, pageSize int32, pageToken string) ([]SearchResult, string, error)
+	SearchFacets(ctx context.Context, query string, filters SearchFilters) (*SearchFacets, error)
+}
+
+func New(db *sql.DB, opts ...Option) Interface {
+	cd := &ContentData{db: db, boosts: DefaultBoosts()}
+	for _, opt := range opts {
+		opt(cd)
+	}
+	if cd.cursors == nil {
+		cd.cursors = pagination.NewCodec(nil, pagination.DefaultTTL)
+	}
+	if cd.normalizers == nil {
+		cd.normalizers = textnorm.Default()
+	}
+	return cd
 }
 
 type Content struct {
@@ -39,6 +109,186 @@ type Content struct {
 	PlatformName    string
 }
 
+// Boosts weighs the signals that make up a search score. Each field
+// weight multiplies that field's trigram similarity (or BM25 score in the
+// in-memory index) and the bonuses are added when the query equals the
+// title, starts the title, or equals one of the tags. All comparisons are made
+// on normalized text.
+type Boosts struct {
+	Title        float64
+	Description  float64
+	PlatformName float64
+	Tags         float64
+	ExactTitle   float64
+	TitlePrefix  float64
+	ExactTag     float64
+}
+
+// DefaultBoosts favours title and tag matches over description matches.
+func DefaultBoosts() Boosts {
+	return Boosts{
+		Title:        3,
+		Description:  1,
+		PlatformName: 0.5,
+		Tags:         2,
+		ExactTitle:   2,
+		TitlePrefix:  1,
+		ExactTag:     1,
+	}
+}
+
+// ParseBoosts overrides DefaultBoosts with a comma separated list of
+// name=weight pairs, e.g. "title=4,description=0.5,exact_tag=2". Valid names
+// are title, description, platform_name, tags, exact_title, title_prefix and
+// exact_tag. Weights must be non-negative.
+func ParseBoosts(spec string) (Boosts, error) {
+	boosts := DefaultBoosts()
+	fields := map[string]*float64{
+		"title":         &boosts.Title,
+		"description":   &boosts.Description,
+		"platform_name": &boosts.PlatformName,
+		"tags":          &boosts.Tags,
+		"exact_title":   &boosts.ExactTitle,
+		"title_prefix":  &boosts.TitlePrefix,
+		"exact_tag":     &boosts.ExactTag,
+	}
+
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return Boosts{}, fmt.Errorf("invalid boost %q: expected name=weight", pair)
+		}
+
+		target, ok := fields[strings.TrimSpace(name)]
+		if !ok {
+			return Boosts{}, fmt.Errorf("unknown boost %q", strings.TrimSpace(name))
+		}
+
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || weight < 0 || math.IsInf(weight, 0) || math.IsNaN(weight) {
+			return Boosts{}, fmt.Errorf("invalid weight %q for boost %q", strings.TrimSpace(value), strings.TrimSpace(name))
+		}
+		*target = weight
+	}
+
+	return boosts, nil
+}
+
+// scoreExpression renders the weighted score over the columns of
+// content_with_tags, where $1 is the normalized query. A query made only of
+// field terms or exclusions leaves $1 empty, which every title would otherwise
+// match as an exact or prefix hit, so the bonuses require a non-empty $1.
+// Weights come from configuration rather than requests, so they are inlined as
+// literals. The result is cast to FLOAT8 so the value echoed back in a page
+// token compares exactly against the recomputed score on the next page.
+func (b Boosts) scoreExpression() string {
+	weight := func(w float64) string {
+		return strconv.FormatFloat(w, 'f', -1, 64)
+	}
+
+	return fmt.Sprintf(`(
+					%s * SIMILARITY(title_normalized, $1) +
+					%s * COALESCE(SIMILARITY(description_normalized, $1), 0) +
+					%s * COALESCE(SIMILARITY(platform_name_normalized, $1), 0) +
+					%s * COALESCE(SIMILARITY(tag_text, $1), 0) +
+					CASE
+						WHEN $1 <> '' AND title_normalized = $1 THEN %s
+						WHEN $1 <> '' AND LEFT(title_normalized, LENGTH($1)) = $1 THEN %s
+						ELSE 0
+					END +
+					CASE WHEN $1 <> '' AND $1 = ANY(tag_names) THEN %s ELSE 0 END
+				)::FLOAT8`,
+		weight(b.Title), weight(b.Description), weight(b.PlatformName), weight(b.Tags),
+		weight(b.ExactTitle), weight(b.TitlePrefix), weight(b.ExactTag))
+}
+
+// Searchable fields reported in SearchResult.MatchedFields.
+const (
+	MatchedFieldTitle        = "title"
+	MatchedFieldDescription  = "description"
+	MatchedFieldPlatformName = "platform_name"
+	MatchedFieldTags         = "tags"
+)
+
+// SearchResult is a content matched by a search, along with its relevance
+// score and the fields that satisfied the match condition.
+type SearchResult struct {
+	Content
+	Score         float64
+	MatchedFields []string
+}
+
+// SearchFilters narrows a search to a subset of the catalog. Values within a
+// single field are OR-ed together and the fields themselves are AND-ed, so
+// {Languages: [ar, en], ContentTypes: [podcast]} means "Arabic or English
+// podcasts". Zero values leave the corresponding field unfiltered.
+type SearchFilters struct {
+	ContentTypes       []string
+	Languages          []string
+	Tags               []string
+	PlatformNames      []string
+	MinDurationSeconds int32
+	MaxDurationSeconds int32
+	PublishedAfter     time.Time
+	PublishedBefore    time.Time
+}
+
+// Duration facet bucket values, in display order.
+const (
+	DurationBucketUnder10Minutes = "under_10m"
+	DurationBucket10To30Minutes  = "10m_to_30m"
+	DurationBucket30To60Minutes  = "30m_to_60m"
+	DurationBucketOver60Minutes  = "over_60m"
+)
+
+// maxTagFacetBuckets caps how many of the most frequent tags are returned.
+const maxTagFacetBuckets = 20
+
+type FacetBucket struct {
+	Value string
+	Count int64
+}
+
+// SearchFacets holds per-field counts over the full set of contents matching
+// a search, not just the returned page.
+type SearchFacets struct {
+	ContentTypes  []FacetBucket
+	Languages     []FacetBucket
+	PlatformNames []FacetBucket
+	Tags          []FacetBucket
+	Durations     []FacetBucket
+}
+
+// Suggestion types.
+const (
+	SuggestionTypeTitle    = "title"
+	SuggestionTypeTag      = "tag"
+	SuggestionTypePlatform = "platform"
+)
+
+// SuggestOrder ranks suggestions that match the prefix equally well.
+type SuggestOrder int
+
+const (
+	SuggestByPopularity SuggestOrder = iota
+	SuggestByRecency
+)
+
+// Suggestion is a completion for a search prefix. ContentID is only set for
+// title suggestions, and ContentCount is the number of contents carrying a tag
+// or platform (always 1 for titles).
+type Suggestion struct {
+	Text         string
+	Type         string
+	ContentID    string
+	ContentCount int64
+}
+
 func (cd *ContentData) GetContent(ctx context.Context, id string) (*Content, error) {
 	getContentQuery := `
 		SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name
@@ -46,11 +296,18 @@ func (cd *ContentData) GetContent(ctx context.Context, id string) (*Content, err
 		WHERE id = $1 AND deleted_at IS NULL`
 
 	var content Content
-	var publishedAt, createdAt, updatedAt time.Time
+	var createdAt, updatedAt time.Time
+	var publishedAt sql.NullTime
 	var description, language, url, platformName sql.NullString
 	var durationSeconds sql.NullInt32
 
-	err := cd.db.QueryRowContext(ctx, getContentQuery, id).Scan(
+	q, done, err := cd.reader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	err = q.QueryRowContext(ctx, getContentQuery, id).Scan(
 		&content.ID,
 		&content.Title,
 		&description,
@@ -66,7 +323,7 @@ func (cd *ContentData) GetContent(ctx context.Context, id string) (*Content, err
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("content with ID %s not found", id)
+			return nil, fmt.Errorf("content with ID %s %w", id, ErrNotFound)
 		}
 		return nil, fmt.Errorf("failed to get content: %w", err)
 	}
@@ -76,11 +333,11 @@ func (cd *ContentData) GetContent(ctx context.Context, id string) (*Content, err
 	content.ExternalURL = url.String
 	content.PlatformName = platformName.String
 	content.DurationSeconds = durationSeconds.Int32
-	content.PublishedAt = publishedAt
+	content.PublishedAt = publishedAt.Time
 	content.CreatedAt = createdAt
 	content.UpdatedAt = updatedAt
 
-	tags, err := cd.getContentTags(ctx, id)
+	tags, err := cd.getContentTags(ctx, q, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get content tags: %w", err)
 	}
@@ -89,7 +346,94 @@ func (cd *ContentData) GetContent(ctx context.Context, id string) (*Content, err
 	return &content, nil
 }
 
-func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageToken string) ([]Content, string, error) {
+// BatchGetContents returns the non-deleted contents with the given ids in one
+// query, plus one for their tags. The result is aligned with ids: entry i is
+// the content for ids[i], or nil when it does not exist or was deleted.
+func (cd *ContentData) BatchGetContents(ctx context.Context, ids []string) ([]*Content, error) {
+	if len(ids) == 0 {
+		return []*Content{}, nil
+	}
+
+	batchGetQuery := `
+		SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name
+		FROM contents
+		WHERE id = ANY($1::UUID[]) AND deleted_at IS NULL`
+
+	q, done, err := cd.reader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	rows, err := q.QueryContext(ctx, batchGetQuery, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contents: %w", err)
+	}
+	defer rows.Close()
+
+	found := make(map[string]*Content, len(ids))
+	var page []*Content
+	for rows.Next() {
+		var content Content
+		var createdAt, updatedAt time.Time
+		var publishedAt sql.NullTime
+		var description, language, url, platformName sql.NullString
+		var durationSeconds sql.NullInt32
+
+		err := rows.Scan(
+			&content.ID,
+			&content.Title,
+			&description,
+			&language,
+			&durationSeconds,
+			&publishedAt,
+			&content.ContentType,
+			&createdAt,
+			&updatedAt,
+			&url,
+			&platformName,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan content row: %w", err)
+		}
+
+		content.Description = description.String
+		content.Language = language.String
+		content.ExternalURL = url.String
+		content.PlatformName = platformName.String
+		content.DurationSeconds = durationSeconds.Int32
+		content.PublishedAt = publishedAt.Time
+		content.CreatedAt = createdAt
+		content.UpdatedAt = updatedAt
+
+		found[content.ID] = &content
+		page = append(page, &content)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over contents: %w", err)
+	}
+
+	if err := cd.loadTags(ctx, q, page); err != nil {
+		return nil, err
+	}
+
+	// The database returns ids in canonical lower-case form.
+	contents := make([]*Content, len(ids))
+	for i, id := range ids {
+		contents[i] = found[strings.ToLower(id)]
+	}
+
+	return contents, nil
+}
+
+// GetRelatedContents returns the contents most similar to the one with id,
+// excluding it. Candidates score one point per tag shared with it, half a
+// point each for the same content type and language, plus the trigram
+// similarity of their titles (weighted double) and descriptions. Candidates
+// that score nothing are left out. With samePlatform only contents from the
+// source's platform are considered.
+func (cd *ContentData) GetRelatedContents(ctx context.Context, id string, samePlatform bool, pageSize int32, pageToken string) ([]Content, string, error) {
 	if pageSize <= 0 {
 		pageSize = 10
 	}
@@ -98,39 +442,88 @@ func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageTok
 		pageSize = 100
 	}
 
-	var query string
-	var args []interface{}
+	sourceQuery := `
+		SELECT content_type, language, platform_name,
+			COALESCE(title_normalized, LOWER(title)),
+			COALESCE(description_normalized, LOWER(description))
+		FROM contents
+		WHERE id = $1 AND deleted_at IS NULL`
 
-	if pageToken == "" {
-		query = `
-			SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name
-			FROM contents 
-			WHERE deleted_at IS NULL
-			ORDER BY created_at DESC 
-			LIMIT $1`
-		args = []interface{}{pageSize + 1}
-	} else {
-		query = `
-			SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name
-			FROM contents 
-			WHERE deleted_at IS NULL AND created_at < (SELECT created_at FROM contents WHERE id = $1)
-			ORDER BY created_at DESC 
-			LIMIT $2`
-		args = []interface{}{pageToken, pageSize + 1}
-	}
-
-	rows, err := cd.db.QueryContext(ctx, query, args...)
+	q, done, err := cd.reader(ctx)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to list contents: %w", err)
+		return nil, "", err
+	}
+	defer done()
+
+	var contentType string
+	var language, platformName, titleNormalized, descriptionNormalized sql.NullString
+	err = q.QueryRowContext(ctx, sourceQuery, id).Scan(&contentType, &language, &platformName, &titleNormalized, &descriptionNormalized)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, "", fmt.Errorf("content with ID %s %w", id, ErrNotFound)
+		}
+		return nil, "", fmt.Errorf("failed to get content: %w", err)
+	}
+
+	args := []interface{}{id, contentType, language.String, titleNormalized.String, descriptionNormalized.String}
+
+	var platformClause string
+	if samePlatform {
+		args = append(args, platformName.String)
+		platformClause = fmt.Sprintf(" AND COALESCE(c.platform_name, '') = $%d", len(args))
+	}
+
+	scope := pagination.Scope("discovery.GetRelatedContents", id, strconv.FormatBool(samePlatform))
+
+	var paginationClause string
+	if pageToken != "" {
+		score, createdAt, lastID, err := cd.decodeSearchCursor(pageToken, scope)
+		if err != nil {
+			return nil, "", err
+		}
+		args = append(args, score, createdAt, lastID)
+		paginationClause = fmt.Sprintf(" AND (score, created_at, id) < ($%d, $%d, $%d)", len(args)-2, len(args)-1, len(args))
+	}
+
+	args = append(args, pageSize+1)
+
+	relatedQuery := fmt.Sprintf(`
+		WITH candidates AS (
+			SELECT 
+				c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, c.content_type, c.created_at, c.updated_at, c.url, c.platform_name,
+				(
+					(SELECT COUNT(*) FROM content_tags ct
+						WHERE ct.content_id = c.id
+						AND ct.tag_id IN (SELECT tag_id FROM content_tags WHERE content_id = $1)) +
+					CASE WHEN c.content_type = $2 THEN 0.5 ELSE 0 END +
+					CASE WHEN c.language = $3 THEN 0.5 ELSE 0 END +
+					2 * SIMILARITY(COALESCE(c.title_normalized, LOWER(c.title)), $4) +
+					COALESCE(SIMILARITY(COALESCE(c.description_normalized, LOWER(c.description)), $5), 0)
+				)::FLOAT8 as score
+			FROM contents c
+			WHERE c.deleted_at IS NULL AND c.id <> $1%s
+		)
+		SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, score
+		FROM candidates
+		WHERE score > 0%s
+		ORDER BY score DESC, created_at DESC, id DESC
+		LIMIT $%d`, platformClause, paginationClause, len(args))
+
+	rows, err := q.QueryContext(ctx, relatedQuery, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get related contents: %w", err)
 	}
 	defer rows.Close()
 
 	var contents []Content
+	var scores []float64
 	for rows.Next() {
 		var content Content
-		var publishedAt, createdAt, updatedAt time.Time
+		var createdAt, updatedAt time.Time
+		var publishedAt sql.NullTime
 		var description, language, url, platformName sql.NullString
 		var durationSeconds sql.NullInt32
+		var score float64
 
 		err := rows.Scan(
 			&content.ID,
@@ -144,6 +537,7 @@ func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageTok
 			&updatedAt,
 			&url,
 			&platformName,
+			&score,
 		)
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to scan content row: %w", err)
@@ -154,15 +548,132 @@ func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageTok
 		content.ExternalURL = url.String
 		content.PlatformName = platformName.String
 		content.DurationSeconds = durationSeconds.Int32
-		content.PublishedAt = publishedAt
+		content.PublishedAt = publishedAt.Time
 		content.CreatedAt = createdAt
 		content.UpdatedAt = updatedAt
 
-		tags, err := cd.getContentTags(ctx, content.ID)
+		contents = append(contents, content)
+		scores = append(scores, score)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating over related contents: %w", err)
+	}
+
+	var nextPageToken string
+	if len(contents) > int(pageSize) {
+		contents = contents[:pageSize]
+		last := contents[len(contents)-1]
+		nextPageToken, err = cd.cursors.Encode(scope,
+			strconv.FormatFloat(scores[pageSize-1], 'g', -1, 64),
+			last.CreatedAt.Format(time.RFC3339Nano),
+			last.ID,
+		)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	page := make([]*Content, len(contents))
+	for i := range contents {
+		page[i] = &contents[i]
+	}
+	if err := cd.loadTags(ctx, q, page); err != nil {
+		return nil, "", err
+	}
+
+	return contents, nextPageToken, nil
+}
+
+func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageToken string, orderBy string, filterExpr string) ([]Content, string, error) {
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	orderTerms, err := filter.ParseKeysetOrderBy(orderBy, contentSortFields, defaultListOrder)
+	if err != nil {
+		return nil, "", err
+	}
+
+	conditions := []string{"deleted_at IS NULL"}
+	var args []interface{}
+
+	filterClause, args, err := filter.Translate(filterExpr, contentFilterFields, args)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid filter: %w", err)
+	}
+	if filterClause != "" {
+		conditions = append(conditions, filterClause)
+	}
+
+	scope := listCursorScope(orderTerms, filterExpr)
+
+	if pageToken != "" {
+		keys, err := filter.DecodeKeysetCursor(cd.cursors, pageToken, scope, orderTerms)
 		if err != nil {
-			return nil, "", fmt.Errorf("failed to get content tags: %w", err)
+			return nil, "", err
 		}
-		content.Tags = tags
+		conditions = append(conditions, filter.KeysetCondition(orderTerms, keys, &args))
+	}
+
+	args = append(args, pageSize+1)
+
+	query := fmt.Sprintf(`
+		SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name
+		FROM contents 
+		WHERE %s
+		ORDER BY %s 
+		LIMIT $%d`, strings.Join(conditions, " AND "), filter.KeysetOrderBy(orderTerms), len(args))
+
+	q, done, err := cd.reader(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer done()
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list contents: %w", err)
+	}
+	defer rows.Close()
+
+	var contents []Content
+	for rows.Next() {
+		var content Content
+		var createdAt, updatedAt time.Time
+		var publishedAt sql.NullTime
+		var description, language, url, platformName sql.NullString
+		var durationSeconds sql.NullInt32
+
+		err := rows.Scan(
+			&content.ID,
+			&content.Title,
+			&description,
+			&language,
+			&durationSeconds,
+			&publishedAt,
+			&content.ContentType,
+			&createdAt,
+			&updatedAt,
+			&url,
+			&platformName,
+		)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan content row: %w", err)
+		}
+
+		content.Description = description.String
+		content.Language = language.String
+		content.ExternalURL = url.String
+		content.PlatformName = platformName.String
+		content.DurationSeconds = durationSeconds.Int32
+		content.PublishedAt = publishedAt.Time
+		content.CreatedAt = createdAt
+		content.UpdatedAt = updatedAt
 
 		contents = append(contents, content)
 	}
@@ -174,13 +685,25 @@ func (cd *ContentData) ListContents(ctx context.Context, pageSize int32, pageTok
 	var nextPageToken string
 	if len(contents) > int(pageSize) {
 		contents = contents[:pageSize]
-		nextPageToken = contents[len(contents)-1].ID
+		last := contents[len(contents)-1]
+		nextPageToken, err = cd.cursors.Encode(scope, filter.KeysetCursorKeys(orderTerms, last.ID, last.sortValue)...)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	page := make([]*Content, len(contents))
+	for i := range contents {
+		page[i] = &contents[i]
+	}
+	if err := cd.loadTags(ctx, q, page); err != nil {
+		return nil, "", err
 	}
 
 	return contents, nextPageToken, nil
 }
 
-func (cd *ContentData) SearchContents(ctx context.Context, query string, pageSize int32, pageToken string) ([]Content, string, error) {
+func (cd *ContentData) SearchContents(ctx context.Context, rawQuery string, filters SearchFilters, pageSize int32, pageToken string) ([]SearchResult, string, error) {
 	if pageSize <= 0 {
 		pageSize = 10
 	}
@@ -189,109 +712,91 @@ func (cd *ContentData) SearchContents(ctx context.Context, query string, pageSiz
 		pageSize = 100
 	}
 
-	searchQuery := strings.TrimSpace(query)
+	searchQuery := strings.TrimSpace(rawQuery)
 	if searchQuery == "" {
-		return []Content{}, "", nil
+		return []SearchResult{}, "", nil
+	}
+
+	parsed, err := query.Parse(searchQuery)
+	if err != nil {
+		return nil, "", err
+	}
+
+	q, done, err := cd.reader(ctx)
+	if err != nil {
+		return nil, "", err
 	}
+	defer done()
 
-	_, err := cd.db.ExecContext(ctx, "SET SESSION pg_trgm.similarity_threshold = 0.10")
+	_, err = q.ExecContext(ctx, "SET SESSION pg_trgm.similarity_threshold = 0.10")
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to set similarity threshold: %w", err)
 	}
 
-	var sqlQuery string
-	var args []interface{}
+	// $1 and $2 hold the ranking text, used for scoring and matched_fields.
+	normalizedQuery := cd.normalizers.Query().Normalize(parsed.Text())
+	likeQuery := ""
+	if normalizedQuery != "" {
+		likeQuery = "%" + filter.EscapeLike(normalizedQuery) + "%"
+	}
+	args := []interface{}{normalizedQuery, likeQuery}
 
-	if pageToken == "" {
-		sqlQuery = `
-			WITH content_with_tags AS (
-				SELECT 
-					c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
-					c.content_type, c.created_at, c.updated_at, c.url, c.platform_name,
-					STRING_AGG(t.name, ' ') as tag_text
-				FROM contents c
-				LEFT JOIN content_tags ct ON c.id = ct.content_id
-				LEFT JOIN tags t ON ct.tag_id = t.id
-				WHERE c.deleted_at IS NULL
-				GROUP BY c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
-					c.content_type, c.created_at, c.updated_at, c.url, c.platform_name
-			)
-			SELECT 
-				id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name,
-				GREATEST(
-					SIMILARITY(LOWER(title), LOWER($1)),
-					SIMILARITY(LOWER(description), LOWER($1)),
-					SIMILARITY(LOWER(platform_name), LOWER($1)),
-					COALESCE(SIMILARITY(LOWER(tag_text), LOWER($1)), 0)
-				) as max_similarity
-			FROM content_with_tags
-			WHERE (
-				LOWER(title) % LOWER($1) OR 
-				LOWER(description) % LOWER($1) OR 
-				LOWER(platform_name) % LOWER($1) OR
-				LOWER(tag_text) % LOWER($1) OR
-				title ILIKE $2 OR 
-				description ILIKE $2 OR
-				platform_name ILIKE $2 OR
-				tag_text ILIKE $2
-			)
-			ORDER BY max_similarity DESC, created_at DESC 
-			LIMIT $3`
-		likeQuery := "%" + searchQuery + "%"
-		args = []interface{}{searchQuery, likeQuery, pageSize + 1}
-	} else {
-		sqlQuery = `
-			WITH content_with_tags AS (
-				SELECT 
-					c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
-					c.content_type, c.created_at, c.updated_at, c.url, c.platform_name,
-					STRING_AGG(t.name, ' ') as tag_text
-				FROM contents c
-				LEFT JOIN content_tags ct ON c.id = ct.content_id
-				LEFT JOIN tags t ON ct.tag_id = t.id
-				WHERE c.deleted_at IS NULL
-				GROUP BY c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
-					c.content_type, c.created_at, c.updated_at, c.url, c.platform_name
-			)
+	matchCondition, args := cd.searchCondition(parsed.Expr, false, args)
+	filterClause, args := buildSearchFilterClause(filters, args)
+
+	scope := searchCursorScope(searchQuery, filters)
+
+	var paginationClause string
+	if pageToken != "" {
+		score, createdAt, id, err := cd.decodeSearchCursor(pageToken, scope)
+		if err != nil {
+			return nil, "", err
+		}
+		args = append(args, score, createdAt, id)
+		paginationClause = fmt.Sprintf("WHERE (score, created_at, id) < ($%d, $%d, $%d)", len(args)-2, len(args)-1, len(args))
+	}
+
+	args = append(args, pageSize+1)
+
+	sqlQuery := fmt.Sprintf(`
+		WITH %s,
+		ranked AS (
 			SELECT 
 				id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name,
-				GREATEST(
-					SIMILARITY(LOWER(title), LOWER($1)),
-					SIMILARITY(LOWER(description), LOWER($1)),
-					SIMILARITY(LOWER(platform_name), LOWER($1)),
-					COALESCE(SIMILARITY(LOWER(tag_text), LOWER($1)), 0)
-				) as max_similarity
+				%s as score,
+				ARRAY_REMOVE(ARRAY[
+					CASE WHEN title_normalized %% $1 OR title_normalized LIKE $2 THEN '%s' END,
+					CASE WHEN description_normalized %% $1 OR description_normalized LIKE $2 THEN '%s' END,
+					CASE WHEN platform_name_normalized %% $1 OR platform_name_normalized LIKE $2 THEN '%s' END,
+					CASE WHEN tag_text %% $1 OR tag_text LIKE $2 THEN '%s' END
+				], NULL) as matched_fields
 			FROM content_with_tags
-			WHERE (
-				LOWER(title) % LOWER($1) OR 
-				LOWER(description) % LOWER($1) OR 
-				LOWER(platform_name) % LOWER($1) OR
-				LOWER(tag_text) % LOWER($1) OR
-				title ILIKE $2 OR 
-				description ILIKE $2 OR
-				platform_name ILIKE $2 OR
-				tag_text ILIKE $2
-			)
-			AND created_at < (SELECT created_at FROM content_with_tags WHERE id = $3)
-			ORDER BY max_similarity DESC, created_at DESC 
-			LIMIT $4`
-		likeQuery := "%" + searchQuery + "%"
-		args = []interface{}{searchQuery, likeQuery, pageToken, pageSize + 1}
-	}
-
-	rows, err := cd.db.QueryContext(ctx, sqlQuery, args...)
+			WHERE %s
+		)
+		SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name, score, matched_fields
+		FROM ranked
+		%s
+		ORDER BY score DESC, created_at DESC, id DESC 
+		LIMIT $%d`, buildContentWithTagsCTE(filterClause),
+		cd.boosts.scoreExpression(),
+		MatchedFieldTitle, MatchedFieldDescription, MatchedFieldPlatformName, MatchedFieldTags,
+		matchCondition, paginationClause, len(args))
+
+	rows, err := q.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to search contents: %w", err)
 	}
 	defer rows.Close()
 
-	var contents []Content
+	var results []SearchResult
 	for rows.Next() {
 		var content Content
-		var publishedAt, createdAt, updatedAt time.Time
+		var createdAt, updatedAt time.Time
+		var publishedAt sql.NullTime
 		var description, language, url, platformName sql.NullString
 		var durationSeconds sql.NullInt32
-		var maxSimilarity float64
+		var score float64
+		var matchedFields []string
 
 		err := rows.Scan(
 			&content.ID,
@@ -305,7 +810,8 @@ func (cd *ContentData) SearchContents(ctx context.Context, query string, pageSiz
 			&updatedAt,
 			&url,
 			&platformName,
-			&maxSimilarity,
+			&score,
+			pq.Array(&matchedFields),
 		)
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to scan content row: %w", err)
@@ -316,33 +822,599 @@ func (cd *ContentData) SearchContents(ctx context.Context, query string, pageSiz
 		content.ExternalURL = url.String
 		content.PlatformName = platformName.String
 		content.DurationSeconds = durationSeconds.Int32
-		content.PublishedAt = publishedAt
+		content.PublishedAt = publishedAt.Time
 		content.CreatedAt = createdAt
 		content.UpdatedAt = updatedAt
 
-		tags, err := cd.getContentTags(ctx, content.ID)
+		results = append(results, SearchResult{
+			Content:       content,
+			Score:         score,
+			MatchedFields: matchedFields,
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating over search results: %w", err)
+	}
+
+	var nextPageToken string
+	if len(results) > int(pageSize) {
+		results = results[:pageSize]
+		last := results[len(results)-1]
+		nextPageToken, err = cd.cursors.EncodePage(scope, pagination.PageOf(pageToken)+1,
+			strconv.FormatFloat(last.Score, 'g', -1, 64),
+			last.CreatedAt.Format(time.RFC3339Nano),
+			last.ID,
+		)
 		if err != nil {
-			return nil, "", fmt.Errorf("failed to get content tags: %w", err)
+			return nil, "", err
 		}
-		content.Tags = tags
+	}
 
-		contents = append(contents, content)
+	page := make([]*Content, len(results))
+	for i := range results {
+		page[i] = &results[i].Content
+	}
+	if err := cd.loadTags(ctx, q, page); err != nil {
+		return nil, "", err
+	}
+
+	return results, nextPageToken, nil
+}
+
+func (cd *ContentData) SearchFacets(ctx context.Context, rawQuery string, filters SearchFilters) (*SearchFacets, error) {
+	searchQuery := strings.TrimSpace(rawQuery)
+	if searchQuery == "" {
+		return &SearchFacets{}, nil
+	}
+
+	parsed, err := query.Parse(searchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	q, done, err := cd.reader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	_, err = q.ExecContext(ctx, "SET SESSION pg_trgm.similarity_threshold = 0.10")
+	if err != nil {
+		return nil, fmt.Errorf("failed to set similarity threshold: %w", err)
+	}
+
+	matchCondition, args := cd.searchCondition(parsed.Expr, false, nil)
+	filterClause, args := buildSearchFilterClause(filters, args)
+
+	args = append(args, maxTagFacetBuckets)
+
+	facetQuery := fmt.Sprintf(`
+		WITH %s,
+		matches AS (
+			SELECT id, language, content_type, platform_name, duration_seconds
+			FROM content_with_tags
+			WHERE %s
+		)
+		SELECT 'content_type' AS facet, content_type AS value, COUNT(*) AS count
+		FROM matches
+		GROUP BY content_type
+		UNION ALL
+		SELECT 'language', language, COUNT(*)
+		FROM matches
+		WHERE language IS NOT NULL
+		GROUP BY language
+		UNION ALL
+		SELECT 'platform_name', platform_name, COUNT(*)
+		FROM matches
+		WHERE platform_name IS NOT NULL
+		GROUP BY platform_name
+		UNION ALL
+		SELECT facet, value, count FROM (
+			SELECT 'tag' AS facet, t.name AS value, COUNT(*) AS count
+			FROM matches m
+			INNER JOIN content_tags ct ON m.id = ct.content_id
+			INNER JOIN tags t ON ct.tag_id = t.id
+			GROUP BY t.name
+			ORDER BY count DESC, t.name
+			LIMIT $%d
+		) AS top_tags
+		UNION ALL
+		SELECT 'duration', bucket, COUNT(*) FROM (
+			SELECT CASE
+				WHEN duration_seconds < 600 THEN '%s'
+				WHEN duration_seconds < 1800 THEN '%s'
+				WHEN duration_seconds < 3600 THEN '%s'
+				ELSE '%s'
+			END AS bucket
+			FROM matches
+			WHERE duration_seconds IS NOT NULL
+		) AS durations
+		GROUP BY bucket`,
+		buildContentWithTagsCTE(filterClause), matchCondition, len(args),
+		DurationBucketUnder10Minutes, DurationBucket10To30Minutes, DurationBucket30To60Minutes, DurationBucketOver60Minutes)
+
+	rows, err := q.QueryContext(ctx, facetQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute search facets: %w", err)
+	}
+	defer rows.Close()
+
+	facets := &SearchFacets{}
+	for rows.Next() {
+		var facet, value string
+		var count int64
+		if err := rows.Scan(&facet, &value, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan facet row: %w", err)
+		}
+
+		bucket := FacetBucket{Value: value, Count: count}
+		switch facet {
+		case "content_type":
+			facets.ContentTypes = append(facets.ContentTypes, bucket)
+		case "language":
+			facets.Languages = append(facets.Languages, bucket)
+		case "platform_name":
+			facets.PlatformNames = append(facets.PlatformNames, bucket)
+		case "tag":
+			facets.Tags = append(facets.Tags, bucket)
+		case "duration":
+			facets.Durations = append(facets.Durations, bucket)
+		}
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, "", fmt.Errorf("error iterating over search results: %w", err)
+		return nil, fmt.Errorf("error iterating over facet rows: %w", err)
 	}
 
-	var nextPageToken string
-	if len(contents) > int(pageSize) {
-		contents = contents[:pageSize]
-		nextPageToken = contents[len(contents)-1].ID
+	facets.Sort()
+
+	return facets, nil
+}
+
+// Suggest returns title, tag and platform completions for prefix. Values that
+// start with the prefix rank above values with a later word starting with it;
+// ties are broken by order. Titles, tags and platforms are matched on their
+// normalized forms, served by the trigram indexes on contents.title_normalized,
+// tags.normalized_name and contents.platform_name_normalized.
+func (cd *ContentData) Suggest(ctx context.Context, prefix string, limit int32, order SuggestOrder) ([]Suggestion, error) {
+	if limit <= 0 {
+		limit = 10
 	}
 
-	return contents, nextPageToken, nil
+	if limit > 20 {
+		limit = 20
+	}
+
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return []Suggestion{}, nil
+	}
+
+	escaped := filter.EscapeLike(cd.normalizers.Query().Normalize(prefix))
+	args := []interface{}{escaped + "%", "% " + escaped + "%", limit}
+
+	rankOrder := "content_count DESC, last_published_at DESC NULLS LAST"
+	if order == SuggestByRecency {
+		rankOrder = "last_published_at DESC NULLS LAST, content_count DESC"
+	}
+
+	suggestQuery := fmt.Sprintf(`
+		WITH title_matches AS (
+			SELECT '%[2]s' AS type, title AS text, id::STRING AS content_id, 1 AS content_count,
+				published_at AS last_published_at,
+				CASE WHEN title_normalized LIKE $1 THEN 0 ELSE 1 END AS match_rank
+			FROM contents
+			WHERE deleted_at IS NULL AND (title_normalized LIKE $1 OR title_normalized LIKE $2)
+			ORDER BY match_rank, %[1]s, text
+			LIMIT $3
+		),
+		tag_matches AS (
+			SELECT '%[3]s' AS type, t.name AS text, '' AS content_id, COUNT(c.id) AS content_count,
+				MAX(c.published_at) AS last_published_at,
+				CASE WHEN t.normalized_name LIKE $1 THEN 0 ELSE 1 END AS match_rank
+			FROM tags t
+			LEFT JOIN content_tags ct ON t.id = ct.tag_id
+			LEFT JOIN contents c ON ct.content_id = c.id AND c.deleted_at IS NULL
+			WHERE t.normalized_name LIKE $1 OR t.normalized_name LIKE $2
+			GROUP BY t.name
+			ORDER BY match_rank, %[1]s, text
+			LIMIT $3
+		),
+		platform_matches AS (
+			SELECT '%[4]s' AS type, platform_name AS text, '' AS content_id, COUNT(*) AS content_count,
+				MAX(published_at) AS last_published_at,
+				CASE WHEN platform_name_normalized LIKE $1 THEN 0 ELSE 1 END AS match_rank
+			FROM contents
+			WHERE deleted_at IS NULL AND (platform_name_normalized LIKE $1 OR platform_name_normalized LIKE $2)
+			GROUP BY platform_name, platform_name_normalized
+			ORDER BY match_rank, %[1]s, text
+			LIMIT $3
+		)
+		SELECT type, text, content_id, content_count
+		FROM (
+			SELECT * FROM title_matches
+			UNION ALL
+			SELECT * FROM tag_matches
+			UNION ALL
+			SELECT * FROM platform_matches
+		) AS suggestions
+		ORDER BY match_rank, %[1]s, text
+		LIMIT $3`, rankOrder, SuggestionTypeTitle, SuggestionTypeTag, SuggestionTypePlatform)
+
+	q, done, err := cd.reader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	rows, err := q.QueryContext(ctx, suggestQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suggestions: %w", err)
+	}
+	defer rows.Close()
+
+	suggestions := []Suggestion{}
+	for rows.Next() {
+		var suggestion Suggestion
+		if err := rows.Scan(&suggestion.Type, &suggestion.Text, &suggestion.ContentID, &suggestion.ContentCount); err != nil {
+			return nil, fmt.Errorf("failed to scan suggestion row: %w", err)
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over suggestion rows: %w", err)
+	}
+
+	return suggestions, nil
+}
+
+// contentFilterFields are the fields ListContents accepts in a filter expression.
+var contentFilterFields = filter.Schema{
+	"title":            {Expr: "title", Type: filter.TypeString},
+	"description":      {Expr: "description", Type: filter.TypeString},
+	"content_type":     {Expr: "content_type", Type: filter.TypeString},
+	"language":         {Expr: "language", Type: filter.TypeString},
+	"platform_name":    {Expr: "platform_name", Type: filter.TypeString},
+	"duration_seconds": {Expr: "duration_seconds", Type: filter.TypeInt},
+	"published_at":     {Expr: "published_at", Type: filter.TypeTimestamp},
+	"created_at":       {Expr: "created_at", Type: filter.TypeTimestamp},
+	"updated_at":       {Expr: "updated_at", Type: filter.TypeTimestamp},
+	"tags": {Membership: `id IN (
+				SELECT fct.content_id
+				FROM content_tags fct
+				INNER JOIN tags ft ON fct.tag_id = ft.id
+				WHERE ft.name = %s)`},
+}
+
+// contentSortFields are the fields ListContents accepts in order_by. Nullable
+// columns are coalesced so keyset comparisons never see NULL, to the zero
+// value Content.sortValue encodes into page tokens for them.
+var contentSortFields = filter.Schema{
+	"created_at":       {Expr: "created_at", Type: filter.TypeTimestamp},
+	"updated_at":       {Expr: "updated_at", Type: filter.TypeTimestamp},
+	"published_at":     {Expr: "COALESCE(published_at, '0001-01-01'::TIMESTAMPTZ)", Type: filter.TypeTimestamp},
+	"duration_seconds": {Expr: "COALESCE(duration_seconds, 0)", Type: filter.TypeInt},
+	"title":            {Expr: "title", Type: filter.TypeString},
+}
+
+// defaultListOrder keeps the historical newest-created-first ordering.
+var defaultListOrder = []filter.OrderTerm{
+	{Name: "created_at", Field: contentSortFields["created_at"], Desc: true},
+}
+
+// listCursorScope binds list page tokens to their ordering and filter so they
+// cannot be replayed against a different list or against search.
+func listCursorScope(orderTerms []filter.OrderTerm, filterExpr string) string {
+	return pagination.Scope("discovery.ListContents", filter.FormatOrderBy(orderTerms), filterExpr)
+}
+
+// sortValue returns the value of c's sort field, as listed in
+// contentSortFields.
+func (c Content) sortValue(field string) interface{} {
+	switch field {
+	case "updated_at":
+		return c.UpdatedAt
+	case "published_at":
+		return c.PublishedAt
+	case "duration_seconds":
+		return c.DurationSeconds
+	case "title":
+		return c.Title
+	default:
+		return c.CreatedAt
+	}
+}
+
+func searchCursorScope(query string, filters SearchFilters) string {
+	return pagination.Scope("discovery.SearchContents", query, fmt.Sprintf("%+v", filters))
+}
+
+func (cd *ContentData) decodeSearchCursor(pageToken string, scope string) (float64, time.Time, string, error) {
+	cursor, err := cd.cursors.Decode(pageToken, scope, 3)
+	if err != nil {
+		return 0, time.Time{}, "", err
+	}
+
+	score, err := strconv.ParseFloat(cursor.Keys[0], 64)
+	if err != nil {
+		return 0, time.Time{}, "", fmt.Errorf("%w: bad score", pagination.ErrInvalidToken)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, cursor.Keys[1])
+	if err != nil {
+		return 0, time.Time{}, "", fmt.Errorf("%w: bad created_at", pagination.ErrInvalidToken)
+	}
+
+	return score, createdAt, cursor.Keys[2], nil
+}
+
+// searchTextColumns are the normalized columns of content_with_tags that
+// unscoped query text is matched against.
+var searchTextColumns = []string{"title_normalized", "description_normalized", "platform_name_normalized", "tag_text"}
+
+// searchCondition translates a parsed search query into a predicate over the
+// columns of content_with_tags, appending the bound values to args. A value
+// already present in args reuses its placeholder, so a plain query compiles to
+// the trigram/substring match on the $1 and $2 ranking arguments.
+//
+// Words are matched fuzzily with trigrams or as substrings, phrases only as
+// substrings. Under negation words are matched as substrings too, so that
+// -news excludes contents mentioning news rather than everything vaguely
+// similar to it.
+func (cd *ContentData) searchCondition(expr query.Expr, negated bool, args []interface{}) (string, []interface{}) {
+	switch e := expr.(type) {
+	case query.And:
+		return cd.joinSearchConditions(e.Terms, " AND ", negated, args)
+
+	case query.Or:
+		return cd.joinSearchConditions(e.Terms, " OR ", negated, args)
+
+	case query.Not:
+		inner, args := cd.searchCondition(e.Expr, !negated, args)
+		return "NOT COALESCE(" + inner + ", FALSE)", args
+
+	case query.Term:
+		var placeholder string
+		switch e.Field {
+		case query.FieldTag:
+			placeholder, args = bindSearchArg(args, cd.normalizers.Query().Normalize(e.Value))
+			return fmt.Sprintf("%s = ANY(tag_names)", placeholder), args
+		case query.FieldPlatform:
+			placeholder, args = bindSearchArg(args, cd.normalizers.Query().Normalize(e.Value))
+			return fmt.Sprintf("platform_name_normalized = %s", placeholder), args
+		case query.FieldLanguage:
+			placeholder, args = bindSearchArg(args, strings.ToLower(e.Value))
+			return fmt.Sprintf("language = %s", placeholder), args
+		case query.FieldType:
+			placeholder, args = bindSearchArg(args, strings.ToLower(e.Value))
+			return fmt.Sprintf("content_type = %s", placeholder), args
+		}
+
+		columns := searchTextColumns
+		switch e.Field {
+		case query.FieldTitle:
+			columns = []string{"title_normalized"}
+		case query.FieldDescription:
+			columns = []string{"description_normalized"}
+		}
+
+		value := cd.normalizers.Query().Normalize(e.Value)
+		var conditions []string
+		if !e.Phrase && !negated {
+			placeholder, args = bindSearchArg(args, value)
+			for _, column := range columns {
+				conditions = append(conditions, fmt.Sprintf("%s %% %s", column, placeholder))
+			}
+		}
+		placeholder, args = bindSearchArg(args, "%"+filter.EscapeLike(value)+"%")
+		for _, column := range columns {
+			conditions = append(conditions, fmt.Sprintf("%s LIKE %s", column, placeholder))
+		}
+		return "(" + strings.Join(conditions, " OR ") + ")", args
+
+	default:
+		return "FALSE", args
+	}
+}
+
+func (cd *ContentData) joinSearchConditions(terms []query.Expr, separator string, negated bool, args []interface{}) (string, []interface{}) {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		var part string
+		part, args = cd.searchCondition(term, negated, args)
+		parts = append(parts, part)
+	}
+	return "(" + strings.Join(parts, separator) + ")", args
+}
+
+// bindSearchArg returns the placeholder for value, appending it to args
+// unless an identical string is already bound.
+func bindSearchArg(args []interface{}, value string) (string, []interface{}) {
+	for i, arg := range args {
+		if existing, ok := arg.(string); ok && existing == value {
+			return fmt.Sprintf("$%d", i+1), args
+		}
+	}
+	args = append(args, value)
+	return fmt.Sprintf("$%d", len(args)), args
+}
+
+// buildContentWithTagsCTE returns the content_with_tags CTE definition, which
+// aggregates each non-deleted content's normalized tag names into a single
+// searchable string. Rows written before normalized columns existed fall back
+// to the lower-cased originals. filterClause is appended to the CTE's WHERE clause.
+func buildContentWithTagsCTE(filterClause string) string {
+	return fmt.Sprintf(`content_with_tags AS (
+			SELECT 
+				c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
+				c.content_type, c.created_at, c.updated_at, c.url, c.platform_name,
+				COALESCE(c.title_normalized, LOWER(c.title)) as title_normalized,
+				COALESCE(c.description_normalized, LOWER(c.description)) as description_normalized,
+				COALESCE(c.platform_name_normalized, LOWER(c.platform_name)) as platform_name_normalized,
+				STRING_AGG(COALESCE(t.normalized_name, LOWER(t.name)), ' ') as tag_text,
+				ARRAY_AGG(COALESCE(t.normalized_name, LOWER(t.name))) as tag_names
+			FROM contents c
+			LEFT JOIN content_tags ct ON c.id = ct.content_id
+			LEFT JOIN tags t ON ct.tag_id = t.id
+			WHERE c.deleted_at IS NULL%s
+			GROUP BY c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, 
+				c.content_type, c.created_at, c.updated_at, c.url, c.platform_name,
+				c.title_normalized, c.description_normalized, c.platform_name_normalized
+		)`, filterClause)
+}
+
+// Sort orders every facet by descending count, then value, except durations,
+// which keep their natural bucket order.
+func (f *SearchFacets) Sort() {
+	sortFacetBuckets(f.ContentTypes)
+	sortFacetBuckets(f.Languages)
+	sortFacetBuckets(f.PlatformNames)
+	sortFacetBuckets(f.Tags)
+	sortDurationBuckets(f.Durations)
+}
+
+// DurationBucket returns the duration facet bucket for a length in seconds.
+// It mirrors the CASE expression in SearchFacets.
+func DurationBucket(durationSeconds int32) string {
+	switch {
+	case durationSeconds < 600:
+		return DurationBucketUnder10Minutes
+	case durationSeconds < 1800:
+		return DurationBucket10To30Minutes
+	case durationSeconds < 3600:
+		return DurationBucket30To60Minutes
+	default:
+		return DurationBucketOver60Minutes
+	}
+}
+
+// Matches reports whether content satisfies the filters. It mirrors the SQL
+// built by buildSearchFilterClause for backends that filter in memory.
+func (f SearchFilters) Matches(content Content) bool {
+	if len(f.ContentTypes) > 0 && !containsString(f.ContentTypes, content.ContentType) {
+		return false
+	}
+	if len(f.Languages) > 0 && !containsString(f.Languages, content.Language) {
+		return false
+	}
+	if len(f.PlatformNames) > 0 && !containsString(f.PlatformNames, content.PlatformName) {
+		return false
+	}
+	if len(f.Tags) > 0 {
+		tagged := false
+		for _, tag := range content.Tags {
+			if containsString(f.Tags, tag) {
+				tagged = true
+				break
+			}
+		}
+		if !tagged {
+			return false
+		}
+	}
+	if f.MinDurationSeconds > 0 && content.DurationSeconds < f.MinDurationSeconds {
+		return false
+	}
+	if f.MaxDurationSeconds > 0 && content.DurationSeconds > f.MaxDurationSeconds {
+		return false
+	}
+	if !f.PublishedAfter.IsZero() && content.PublishedAt.Before(f.PublishedAfter) {
+		return false
+	}
+	if !f.PublishedBefore.IsZero() && !content.PublishedAt.Before(f.PublishedBefore) {
+		return false
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortFacetBuckets(buckets []FacetBucket) {
+	sort.SliceStable(buckets, func(i, j int) bool {
+		if buckets[i].Count != buckets[j].Count {
+			return buckets[i].Count > buckets[j].Count
+		}
+		return buckets[i].Value < buckets[j].Value
+	})
 }
 
-func (cd *ContentData) getContentTags(ctx context.Context, contentID string) ([]string, error) {
+func sortDurationBuckets(buckets []FacetBucket) {
+	order := map[string]int{
+		DurationBucketUnder10Minutes: 0,
+		DurationBucket10To30Minutes:  1,
+		DurationBucket30To60Minutes:  2,
+		DurationBucketOver60Minutes:  3,
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		return order[buckets[i].Value] < order[buckets[j].Value]
+	})
+}
+
+// loadTags fills in the tags of contents with a single query, so a page costs
+// the same number of queries whatever its size.
+func (cd *ContentData) loadTags(ctx context.Context, q querier, contents []*Content) error {
+	ids := make([]string, len(contents))
+	for i, content := range contents {
+		ids[i] = content.ID
+	}
+
+	tags, err := cd.getContentsTags(ctx, q, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get content tags: %w", err)
+	}
+
+	for _, content := range contents {
+		content.Tags = tags[content.ID]
+	}
+	return nil
+}
+
+// getContentsTags returns the tag names of each of contentIDs in one query,
+// keyed by content id. Contents without tags are absent from the map.
+func (cd *ContentData) getContentsTags(ctx context.Context, q querier, contentIDs []string) (map[string][]string, error) {
+	tags := make(map[string][]string, len(contentIDs))
+	if len(contentIDs) == 0 {
+		return tags, nil
+	}
+
+	query := `
+		SELECT ct.content_id, t.name
+		FROM tags t
+		INNER JOIN content_tags ct ON t.id = ct.tag_id
+		WHERE ct.content_id = ANY($1::UUID[])
+		ORDER BY ct.content_id, t.name`
+
+	rows, err := q.QueryContext(ctx, query, pq.Array(contentIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query content tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contentID, tagName string
+		if err := rows.Scan(&contentID, &tagName); err != nil {
+			return nil, fmt.Errorf("failed to scan tag name: %w", err)
+		}
+		tags[contentID] = append(tags[contentID], tagName)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over tag rows: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (cd *ContentData) getContentTags(ctx context.Context, q querier, contentID string) ([]string, error) {
 	query := `
 		SELECT t.name 
 		FROM tags t
@@ -350,7 +1422,7 @@ func (cd *ContentData) getContentTags(ctx context.Context, contentID string) ([]
 		WHERE ct.content_id = $1
 		ORDER BY t.name`
 
-	rows, err := cd.db.QueryContext(ctx, query, contentID)
+	rows, err := q.QueryContext(ctx, query, contentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query content tags: %w", err)
 	}
@@ -371,3 +1443,60 @@ func (cd *ContentData) getContentTags(ctx context.Context, contentID string) ([]
 
 	return tags, nil
 }
+
+// buildSearchFilterClause turns filters into predicates on the contents table
+// (aliased c) and appends their values to args. The returned clause is either
+// empty or starts with " AND" so it can be appended to an existing WHERE.
+func buildSearchFilterClause(filters SearchFilters, args []interface{}) (string, []interface{}) {
+	var conditions []string
+
+	if len(filters.ContentTypes) > 0 {
+		args = append(args, pq.Array(filters.ContentTypes))
+		conditions = append(conditions, fmt.Sprintf("c.content_type = ANY($%d)", len(args)))
+	}
+
+	if len(filters.Languages) > 0 {
+		args = append(args, pq.Array(filters.Languages))
+		conditions = append(conditions, fmt.Sprintf("c.language = ANY($%d)", len(args)))
+	}
+
+	if len(filters.PlatformNames) > 0 {
+		args = append(args, pq.Array(filters.PlatformNames))
+		conditions = append(conditions, fmt.Sprintf("c.platform_name = ANY($%d)", len(args)))
+	}
+
+	if len(filters.Tags) > 0 {
+		args = append(args, pq.Array(filters.Tags))
+		conditions = append(conditions, fmt.Sprintf(`c.id IN (
+				SELECT fct.content_id
+				FROM content_tags fct
+				INNER JOIN tags ft ON fct.tag_id = ft.id
+				WHERE ft.name = ANY($%d))`, len(args)))
+	}
+
+	if filters.MinDurationSeconds > 0 {
+		args = append(args, filters.MinDurationSeconds)
+		conditions = append(conditions, fmt.Sprintf("c.duration_seconds >= $%d", len(args)))
+	}
+
+	if filters.MaxDurationSeconds > 0 {
+		args = append(args, filters.MaxDurationSeconds)
+		conditions = append(conditions, fmt.Sprintf("c.duration_seconds <= $%d", len(args)))
+	}
+
+	if !filters.PublishedAfter.IsZero() {
+		args = append(args, filters.PublishedAfter)
+		conditions = append(conditions, fmt.Sprintf("c.published_at >= $%d", len(args)))
+	}
+
+	if !filters.PublishedBefore.IsZero() {
+		args = append(args, filters.PublishedBefore)
+		conditions = append(conditions, fmt.Sprintf("c.published_at < $%d", len(args)))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+
+	return "\n\t\t\t\tAND " + strings.Join(conditions, "\n\t\t\t\tAND "), args
+}
@@ -7,10 +7,17 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/mosaibah/Mawjood/packages/discovery/query"
+	"github.com/mosaibah/Mawjood/packages/filter"
+	"github.com/mosaibah/Mawjood/packages/pagination"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// pageTagsQuery matches the single query that loads the tags of a page.
+const pageTagsQuery = `SELECT ct\.content_id, t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = ANY\(\$1::UUID\[\]\) ORDER BY ct\.content_id, t\.name`
+
 func TestStoreInterface(t *testing.T) {
 	var _ Interface = &ContentData{}
 	assert.True(t, true, "ContentData successfully implements the Interface")
@@ -92,6 +99,55 @@ func TestGetContent_NotFound(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestBatchGetContents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+	ids := []string{
+		"550e8400-e29b-41d4-a716-446655440001",
+		"550e8400-e29b-41d4-a716-446655440999",
+		"550E8400-E29B-41D4-A716-446655440000",
+		"550e8400-e29b-41d4-a716-446655440001",
+	}
+	publishedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	// Rows come back in storage order, not request order.
+	mock.ExpectQuery(`SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name FROM contents WHERE id = ANY\(\$1::UUID\[\]\) AND deleted_at IS NULL`).
+		WithArgs(pq.Array(ids)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name",
+		}).AddRow(
+			"550e8400-e29b-41d4-a716-446655440000", "First", "A description", "en", 3600,
+			publishedAt, "podcast", publishedAt, publishedAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "YouTube",
+		).AddRow(
+			"550e8400-e29b-41d4-a716-446655440001", "Second", nil, nil, nil,
+			publishedAt, "documentary", publishedAt, publishedAt, nil, nil,
+		))
+
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"550e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440001"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow("550e8400-e29b-41d4-a716-446655440000", "science").
+			AddRow("550e8400-e29b-41d4-a716-446655440000", "technology"))
+
+	contents, err := store.BatchGetContents(ctx, ids)
+
+	require.NoError(t, err)
+	require.Len(t, contents, 4)
+	assert.Equal(t, "Second", contents[0].Title)
+	assert.Nil(t, contents[0].Tags)
+	assert.Nil(t, contents[1])
+	assert.Equal(t, "First", contents[2].Title)
+	assert.Equal(t, []string{"science", "technology"}, contents[2].Tags)
+	assert.Equal(t, "Second", contents[3].Title)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestListContents_Success(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -114,21 +170,17 @@ func TestListContents_Success(t *testing.T) {
 		time.Date(2024, 1, 16, 12, 0, 0, 0, time.UTC), "documentary", createdAt2, createdAt2, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "Platform 2",
 	)
 
-	mock.ExpectQuery(`SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name FROM contents WHERE deleted_at IS NULL ORDER BY created_at DESC LIMIT \$1`).
+	mock.ExpectQuery(`SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name FROM contents WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC LIMIT \$1`).
 		WithArgs(11).
 		WillReturnRows(contentRows)
 
-	tagRows1 := sqlmock.NewRows([]string{"name"}).AddRow("tech")
-	mock.ExpectQuery(`SELECT t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = \$1 ORDER BY t\.name`).
-		WithArgs("id1").
-		WillReturnRows(tagRows1)
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1", "id2"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow("id1", "tech").
+			AddRow("id2", "science"))
 
-	tagRows2 := sqlmock.NewRows([]string{"name"}).AddRow("science")
-	mock.ExpectQuery(`SELECT t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = \$1 ORDER BY t\.name`).
-		WithArgs("id2").
-		WillReturnRows(tagRows2)
-
-	contents, nextPageToken, err := store.ListContents(ctx, 10, "")
+	contents, nextPageToken, err := store.ListContents(ctx, 10, "", "", "")
 
 	require.NoError(t, err)
 	assert.Len(t, contents, 2)
@@ -165,22 +217,23 @@ func TestSearchContents_Success(t *testing.T) {
 
 	searchRows := sqlmock.NewRows([]string{
 		"id", "title", "description", "language", "duration_seconds",
-		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "max_similarity",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "matched_fields",
 	}).AddRow(
 		"search-id", "Found Podcast", "A podcast found by search", "en", 2700,
-		time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC), "podcast", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "Search Platform", 0.8,
+		time.Date(2024, 1, 15, 14, 0, 0, 0, time.UTC), "podcast", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "Search Platform", 0.8, "{title,tags}",
 	)
 
-	mock.ExpectQuery(`WITH content_with_tags AS \(.*\) SELECT .* FROM content_with_tags WHERE .* ORDER BY max_similarity DESC, created_at DESC LIMIT \$3`).
+	mock.ExpectQuery(`WITH content_with_tags AS \(.*\) SELECT .* score, matched_fields FROM ranked ORDER BY score DESC, created_at DESC, id DESC LIMIT \$3`).
 		WithArgs(searchQuery, "%"+searchQuery+"%", 11).
 		WillReturnRows(searchRows)
 
-	tagRows := sqlmock.NewRows([]string{"name"}).AddRow("podcast").AddRow("search")
-	mock.ExpectQuery(`SELECT t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = \$1 ORDER BY t\.name`).
-		WithArgs("search-id").
-		WillReturnRows(tagRows)
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"search-id"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow("search-id", "podcast").
+			AddRow("search-id", "search"))
 
-	contents, nextPageToken, err := store.SearchContents(ctx, searchQuery, 10, "")
+	contents, nextPageToken, err := store.SearchContents(ctx, searchQuery, SearchFilters{}, 10, "")
 
 	require.NoError(t, err)
 	assert.Len(t, contents, 1)
@@ -194,6 +247,8 @@ func TestSearchContents_Success(t *testing.T) {
 	assert.Len(t, content.Tags, 2)
 	assert.Contains(t, content.Tags, "podcast")
 	assert.Contains(t, content.Tags, "search")
+	assert.Equal(t, 0.8, content.Score)
+	assert.Equal(t, []string{MatchedFieldTitle, MatchedFieldTags}, content.MatchedFields)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -206,7 +261,7 @@ func TestSearchContents_EmptyQuery(t *testing.T) {
 	store := New(db)
 	ctx := context.Background()
 
-	contents, nextPageToken, err := store.SearchContents(ctx, "", 10, "")
+	contents, nextPageToken, err := store.SearchContents(ctx, "", SearchFilters{}, 10, "")
 
 	require.NoError(t, err)
 	assert.Empty(t, contents)
@@ -239,30 +294,811 @@ func TestListContents_WithPagination(t *testing.T) {
 		time.Date(2024, 1, 17, 8, 0, 0, 0, time.UTC), "podcast", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "Platform 3",
 	)
 
-	mock.ExpectQuery(`SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name FROM contents WHERE deleted_at IS NULL ORDER BY created_at DESC LIMIT \$1`).
+	mock.ExpectQuery(`SELECT id, title, description, language, duration_seconds, published_at, content_type, created_at, updated_at, url, platform_name FROM contents WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC LIMIT \$1`).
 		WithArgs(3).
 		WillReturnRows(contentRows)
 
-	tagRows1 := sqlmock.NewRows([]string{"name"}).AddRow("tag1")
-	mock.ExpectQuery(`SELECT t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = \$1 ORDER BY t\.name`).
-		WithArgs("id1").
-		WillReturnRows(tagRows1)
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1", "id2"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow("id1", "tag1").
+			AddRow("id2", "tag2"))
 
-	tagRows2 := sqlmock.NewRows([]string{"name"}).AddRow("tag2")
-	mock.ExpectQuery(`SELECT t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = \$1 ORDER BY t\.name`).
-		WithArgs("id2").
-		WillReturnRows(tagRows2)
+	contents, nextPageToken, err := store.ListContents(ctx, 2, "", "", "")
 
-	tagRows3 := sqlmock.NewRows([]string{"name"}).AddRow("tag3")
-	mock.ExpectQuery(`SELECT t\.name FROM tags t INNER JOIN content_tags ct ON t\.id = ct\.tag_id WHERE ct\.content_id = \$1 ORDER BY t\.name`).
-		WithArgs("id3").
-		WillReturnRows(tagRows3)
+	require.NoError(t, err)
+	assert.Len(t, contents, 2)
+	require.NotEmpty(t, nextPageToken)
+	assert.NotEqual(t, "id2", nextPageToken)
+
+	cursor, err := store.(*ContentData).cursors.Decode(nextPageToken, listCursorScope(defaultListOrder, ""), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{createdAt.Format(time.RFC3339Nano), "id2"}, cursor.Keys)
 
-	contents, nextPageToken, err := store.ListContents(ctx, 2, "")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
 
+func TestSearchContents_WithFilters(t *testing.T) {
+	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
-	assert.Len(t, contents, 2)
-	assert.Equal(t, "id2", nextPageToken)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+	searchQuery := "planet"
+	publishedAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	filters := SearchFilters{
+		ContentTypes:       []string{"documentary"},
+		Languages:          []string{"en", "ar"},
+		Tags:               []string{"nature"},
+		MinDurationSeconds: 600,
+		MaxDurationSeconds: 3600,
+		PublishedAfter:     publishedAfter,
+	}
+
+	mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	createdAt := time.Now()
+
+	searchRows := sqlmock.NewRows([]string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "matched_fields",
+	}).AddRow(
+		"planet-id", "Planet Earth II", "Wildlife documentary", "en", 3600,
+		time.Date(2024, 1, 14, 18, 0, 0, 0, time.UTC), "documentary", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "YouTube", 0.6, "{title}",
+	)
+
+	mock.ExpectQuery(`WHERE c\.deleted_at IS NULL\s+AND c\.content_type = ANY\(\$3\)\s+AND c\.language = ANY\(\$4\)\s+AND c\.id IN \(.*ft\.name = ANY\(\$5\)\)\s+AND c\.duration_seconds >= \$6\s+AND c\.duration_seconds <= \$7\s+AND c\.published_at >= \$8 GROUP BY .* ORDER BY score DESC, created_at DESC, id DESC LIMIT \$9`).
+		WithArgs(searchQuery, "%"+searchQuery+"%", pq.Array([]string{"documentary"}), pq.Array([]string{"en", "ar"}), pq.Array([]string{"nature"}), int32(600), int32(3600), publishedAfter, 11).
+		WillReturnRows(searchRows)
+
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"planet-id"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow("planet-id", "nature"))
+
+	contents, nextPageToken, err := store.SearchContents(ctx, searchQuery, filters, 10, "")
+
+	require.NoError(t, err)
+	assert.Len(t, contents, 1)
+	assert.Empty(t, nextPageToken)
+	assert.Equal(t, "planet-id", contents[0].ID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchContents_FiltersWithPageToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+	ctx := context.Background()
+	searchQuery := "podcast"
+	filters := SearchFilters{PlatformNames: []string{"YouTube"}}
+	lastCreatedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	pageToken, err := codec.Encode(searchCursorScope(searchQuery, filters), "0.5", lastCreatedAt.Format(time.RFC3339Nano), "last-id")
+	require.NoError(t, err)
+
+	mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	searchRows := sqlmock.NewRows([]string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "matched_fields",
+	})
+
+	mock.ExpectQuery(`AND c\.platform_name = ANY\(\$3\) GROUP BY .* FROM ranked WHERE \(score, created_at, id\) < \(\$4, \$5, \$6\) ORDER BY score DESC, created_at DESC, id DESC LIMIT \$7`).
+		WithArgs(searchQuery, "%"+searchQuery+"%", pq.Array([]string{"YouTube"}), 0.5, lastCreatedAt, "last-id", 6).
+		WillReturnRows(searchRows)
+
+	contents, nextPageToken, err := store.SearchContents(ctx, searchQuery, filters, 5, pageToken)
+
+	require.NoError(t, err)
+	assert.Empty(t, contents)
+	assert.Empty(t, nextPageToken)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchContents_NextPageTokenEncodesSortKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+	ctx := context.Background()
+	searchQuery := "planet"
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 123456000, time.UTC)
+
+	mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	searchRows := sqlmock.NewRows([]string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "matched_fields",
+	}).AddRow(
+		"id1", "Planet Earth II", "Wildlife", "en", 3600,
+		createdAt, "documentary", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "YouTube", 0.75, "{title}",
+	).AddRow(
+		"id2", "The Blue Planet", "Oceans", "en", 3000,
+		createdAt, "documentary", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "YouTube", 0.3333333432674408, "{title}",
+	)
+
+	mock.ExpectQuery(`FROM ranked ORDER BY score DESC, created_at DESC, id DESC LIMIT \$3`).
+		WithArgs(searchQuery, "%"+searchQuery+"%", 2).
+		WillReturnRows(searchRows)
+
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	contents, nextPageToken, err := store.SearchContents(ctx, searchQuery, SearchFilters{}, 1, "")
+
+	require.NoError(t, err)
+	assert.Len(t, contents, 1)
+	require.NotEmpty(t, nextPageToken)
+
+	score, lastCreatedAt, id, err := store.(*ContentData).decodeSearchCursor(nextPageToken, searchCursorScope(searchQuery, SearchFilters{}))
+	require.NoError(t, err)
+	assert.Equal(t, 0.75, score)
+	assert.True(t, createdAt.Equal(lastCreatedAt))
+	assert.Equal(t, "id1", id)
+	assert.Equal(t, 2, pagination.PageOf(nextPageToken))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchContents_InvalidPageToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db, WithCursorCodec(pagination.NewCodec([]byte("test-secret"), time.Hour)))
+	ctx := context.Background()
+
+	otherToken, err := pagination.NewCodec([]byte("other-secret"), time.Hour).Encode(searchCursorScope("podcast", SearchFilters{}), "0.5", time.Now().Format(time.RFC3339Nano), "id1")
+	require.NoError(t, err)
+
+	for _, pageToken := range []string{"550e8400-e29b-41d4-a716-446655440000", otherToken} {
+		mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		contents, nextPageToken, err := store.SearchContents(ctx, "podcast", SearchFilters{}, 10, pageToken)
+
+		assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+		assert.Nil(t, contents)
+		assert.Empty(t, nextPageToken)
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContents_WithPageToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+	ctx := context.Background()
+	lastCreatedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	pageToken, err := codec.Encode(listCursorScope(defaultListOrder, ""), lastCreatedAt.Format(time.RFC3339Nano), "id2")
+	require.NoError(t, err)
+
+	contentRows := sqlmock.NewRows([]string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name",
+	})
+
+	mock.ExpectQuery(`FROM contents WHERE deleted_at IS NULL AND \(created_at, id\) < \(\$1, \$2\) ORDER BY created_at DESC, id DESC LIMIT \$3`).
+		WithArgs(lastCreatedAt, "id2", 11).
+		WillReturnRows(contentRows)
+
+	contents, nextPageToken, err := store.ListContents(ctx, 10, pageToken, "", "")
+
+	require.NoError(t, err)
+	assert.Empty(t, contents)
+	assert.Empty(t, nextPageToken)
+
+	_, _, err = store.ListContents(ctx, 10, "tampered"+pageToken, "", "")
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContents_PagesAcrossNullPublishedAt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db, WithCursorCodec(pagination.NewCodec([]byte("test-secret"), time.Hour)))
+	ctx := context.Background()
+
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	publishedAt := time.Date(2024, 1, 10, 10, 0, 0, 0, time.UTC)
+	columns := []string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name",
+	}
+	row := func(rows *sqlmock.Rows, id string, publishedAt interface{}) *sqlmock.Rows {
+		return rows.AddRow(id, "Title", "Description", "en", 1800, publishedAt, "podcast", createdAt, createdAt, "https://example.com", "YouTube")
+	}
+	const firstPage = `FROM contents WHERE deleted_at IS NULL ORDER BY COALESCE\(published_at, '0001-01-01'::TIMESTAMPTZ\) DESC, id DESC LIMIT \$1`
+	const nextPage = `FROM contents WHERE deleted_at IS NULL AND \(COALESCE\(published_at, '0001-01-01'::TIMESTAMPTZ\), id\) < \(\$1, \$2\) ORDER BY COALESCE\(published_at, '0001-01-01'::TIMESTAMPTZ\) DESC, id DESC LIMIT \$3`
+
+	mock.ExpectQuery(firstPage).
+		WithArgs(2).
+		WillReturnRows(row(row(sqlmock.NewRows(columns), "id3", publishedAt), "id2", nil))
+	mock.ExpectQuery(pageTagsQuery).WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	contents, nextPageToken, err := store.ListContents(ctx, 1, "", "published_at desc", "")
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	require.NotEmpty(t, nextPageToken)
+
+	// id2 and id1 have no published_at; they sort as the zero time the token
+	// encodes, so the comparison neither skips nor repeats them.
+	mock.ExpectQuery(nextPage).
+		WithArgs(publishedAt, "id3", 2).
+		WillReturnRows(row(row(sqlmock.NewRows(columns), "id2", nil), "id1", nil))
+	mock.ExpectQuery(pageTagsQuery).WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	contents, nextPageToken, err = store.ListContents(ctx, 1, nextPageToken, "published_at desc", "")
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	assert.Equal(t, "id2", contents[0].ID)
+	assert.True(t, contents[0].PublishedAt.IsZero())
+	require.NotEmpty(t, nextPageToken)
+
+	mock.ExpectQuery(nextPage).
+		WithArgs(time.Time{}, "id2", 2).
+		WillReturnRows(row(sqlmock.NewRows(columns), "id1", nil))
+	mock.ExpectQuery(pageTagsQuery).WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	contents, nextPageToken, err = store.ListContents(ctx, 1, nextPageToken, "published_at desc", "")
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	assert.Equal(t, "id1", contents[0].ID)
+	assert.Empty(t, nextPageToken)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchFacets_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+	searchQuery := "science"
+
+	mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	facetRows := sqlmock.NewRows([]string{"facet", "value", "count"}).
+		AddRow("content_type", "documentary", 3).
+		AddRow("content_type", "podcast", 5).
+		AddRow("language", "en", 7).
+		AddRow("language", "ar", 1).
+		AddRow("platform_name", "YouTube", 8).
+		AddRow("tag", "science", 8).
+		AddRow("tag", "education", 4).
+		AddRow("duration", DurationBucketOver60Minutes, 2).
+		AddRow("duration", DurationBucketUnder10Minutes, 1).
+		AddRow("duration", DurationBucket30To60Minutes, 5)
+
+	mock.ExpectQuery(`WITH content_with_tags AS \(.*WHERE c\.deleted_at IS NULL\s+AND c\.language = ANY\(\$3\) GROUP BY .*\), matches AS \(.*\) SELECT 'content_type' AS facet.* LIMIT \$4 \) AS top_tags UNION ALL .* GROUP BY bucket`).
+		WithArgs(searchQuery, "%"+searchQuery+"%", pq.Array([]string{"en", "ar"}), maxTagFacetBuckets).
+		WillReturnRows(facetRows)
+
+	facets, err := store.SearchFacets(ctx, searchQuery, SearchFilters{Languages: []string{"en", "ar"}})
+
+	require.NoError(t, err)
+	require.NotNil(t, facets)
+
+	assert.Equal(t, []FacetBucket{{Value: "podcast", Count: 5}, {Value: "documentary", Count: 3}}, facets.ContentTypes)
+	assert.Equal(t, []FacetBucket{{Value: "en", Count: 7}, {Value: "ar", Count: 1}}, facets.Languages)
+	assert.Equal(t, []FacetBucket{{Value: "YouTube", Count: 8}}, facets.PlatformNames)
+	assert.Equal(t, []FacetBucket{{Value: "science", Count: 8}, {Value: "education", Count: 4}}, facets.Tags)
+	assert.Equal(t, []FacetBucket{
+		{Value: DurationBucketUnder10Minutes, Count: 1},
+		{Value: DurationBucket30To60Minutes, Count: 5},
+		{Value: DurationBucketOver60Minutes, Count: 2},
+	}, facets.Durations)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchFacets_EmptyQuery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+
+	facets, err := store.SearchFacets(context.Background(), "  ", SearchFilters{})
+
+	require.NoError(t, err)
+	require.NotNil(t, facets)
+	assert.Empty(t, facets.ContentTypes)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContents_WithFilterAndOrderBy(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+	ctx := context.Background()
+	filterExpr := `content_type = "podcast" AND language = "ar" AND duration_seconds < 1800`
+
+	contentRows := sqlmock.NewRows([]string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name",
+	}).AddRow(
+		"id1", "Short Talk", "Description 1", "ar", 900,
+		time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), "podcast", time.Now(), time.Now(), "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "YouTube",
+	).AddRow(
+		"id2", "Longer Talk", "Description 2", "ar", 1200,
+		time.Date(2024, 1, 16, 10, 0, 0, 0, time.UTC), "podcast", time.Now(), time.Now(), "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "YouTube",
+	)
+
+	mock.ExpectQuery(`FROM contents WHERE deleted_at IS NULL AND \(content_type = \$1 AND language = \$2 AND duration_seconds < \$3\) ORDER BY COALESCE\(duration_seconds, 0\) ASC, id ASC LIMIT \$4`).
+		WithArgs("podcast", "ar", int64(1800), 2).
+		WillReturnRows(contentRows)
+
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	contents, nextPageToken, err := store.ListContents(ctx, 1, "", "duration_seconds asc", filterExpr)
+
+	require.NoError(t, err)
+	assert.Len(t, contents, 1)
+	require.NotEmpty(t, nextPageToken)
+
+	mock.ExpectQuery(`WHERE deleted_at IS NULL AND \(content_type = \$1 AND language = \$2 AND duration_seconds < \$3\) AND \(COALESCE\(duration_seconds, 0\), id\) > \(\$4, \$5\) ORDER BY COALESCE\(duration_seconds, 0\) ASC, id ASC LIMIT \$6`).
+		WithArgs("podcast", "ar", int64(1800), int64(900), "id1", 2).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name",
+		}))
+
+	_, _, err = store.ListContents(ctx, 1, nextPageToken, "duration_seconds asc", filterExpr)
+	require.NoError(t, err)
+
+	// A token is only valid for the ordering and filter it was issued for
+	_, _, err = store.ListContents(ctx, 1, nextPageToken, "title asc", filterExpr)
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContents_InvalidFilterAndOrderBy(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	testCases := []struct {
+		name       string
+		orderBy    string
+		filterExpr string
+		message    string
+	}{
+		{name: "unknown filter field", filterExpr: `rating > 3`, message: `unknown field "rating" at position 1`},
+		{name: "bad syntax", filterExpr: `language = "ar" AND`, message: `at position 20`},
+		{name: "unknown order field", orderBy: "popularity desc", message: `unknown order_by field "popularity"`},
+		{name: "mixed directions", orderBy: "title asc, published_at desc", message: "same direction"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contents, nextPageToken, err := store.ListContents(ctx, 10, "", tc.orderBy, tc.filterExpr)
+
+			assert.ErrorIs(t, err, filter.ErrInvalidFilter)
+			assert.Contains(t, err.Error(), tc.message)
+			assert.Nil(t, contents)
+			assert.Empty(t, nextPageToken)
+		})
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSuggest_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	rows := sqlmock.NewRows([]string{"type", "text", "content_id", "content_count"}).
+		AddRow(SuggestionTypeTag, "science", "", 4).
+		AddRow(SuggestionTypeTitle, "Science Friday", "id1", 1)
+
+	mock.ExpectQuery(`WITH title_matches AS .* tag_matches AS .* platform_matches AS .* ORDER BY match_rank, content_count DESC, last_published_at DESC NULLS LAST, text\s+LIMIT \$3`).
+		WithArgs("sci%", "% sci%", int32(5)).
+		WillReturnRows(rows)
+
+	suggestions, err := store.Suggest(ctx, " sci ", 5, SuggestByPopularity)
+
+	require.NoError(t, err)
+	require.Len(t, suggestions, 2)
+	assert.Equal(t, Suggestion{Text: "science", Type: SuggestionTypeTag, ContentCount: 4}, suggestions[0])
+	assert.Equal(t, "id1", suggestions[1].ContentID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSuggest_RecencyOrderAndEscaping(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`ORDER BY match_rank, last_published_at DESC NULLS LAST, content_count DESC, text\s+LIMIT \$3`).
+		WithArgs(`100\%%`, `% 100\%%`, int32(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"type", "text", "content_id", "content_count"}))
+
+	suggestions, err := store.Suggest(ctx, "100%", 0, SuggestByRecency)
+
+	require.NoError(t, err)
+	assert.Empty(t, suggestions)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSuggest_MatchesNormalizedPlatforms(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`platform_matches AS \(.*WHERE deleted_at IS NULL AND \(platform_name_normalized LIKE \$1 OR platform_name_normalized LIKE \$2\)`).
+		WithArgs("اذاعه%", "% اذاعه%", int32(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"type", "text", "content_id", "content_count"}).
+			AddRow(SuggestionTypePlatform, "إذاعة القرآن", "", 3))
+
+	suggestions, err := store.Suggest(ctx, "إذاعة", 0, SuggestByPopularity)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Suggestion{{Text: "إذاعة القرآن", Type: SuggestionTypePlatform, ContentCount: 3}}, suggestions)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSuggest_EmptyPrefix(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+
+	suggestions, err := store.Suggest(context.Background(), "   ", 5, SuggestByPopularity)
+
+	require.NoError(t, err)
+	assert.Empty(t, suggestions)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchContents_NormalizesArabicQuery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	mock.ExpectQuery(`COALESCE\(c\.title_normalized, LOWER\(c\.title\)\) as title_normalized.*title_normalized % \$1`).
+		WithArgs("الذكاء الاصطناعي", "%الذكاء الاصطناعي%", 11).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "matched_fields",
+		}))
+
+	results, _, err := store.SearchContents(ctx, "الذَّكاءُ الإصطناعـــي", SearchFilters{}, 10, "")
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchFilters_Matches(t *testing.T) {
+	content := Content{
+		Tags:            []string{"science", "nature"},
+		Language:        "en",
+		DurationSeconds: 1800,
+		PublishedAt:     time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		ContentType:     "documentary",
+		PlatformName:    "YouTube",
+	}
+
+	assert.True(t, SearchFilters{}.Matches(content))
+	assert.True(t, SearchFilters{
+		ContentTypes:       []string{"podcast", "documentary"},
+		Languages:          []string{"en"},
+		Tags:               []string{"nature", "space"},
+		PlatformNames:      []string{"YouTube"},
+		MinDurationSeconds: 1800,
+		MaxDurationSeconds: 1800,
+		PublishedAfter:     time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		PublishedBefore:    time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+	}.Matches(content))
+	assert.False(t, SearchFilters{Languages: []string{"ar"}}.Matches(content))
+	assert.False(t, SearchFilters{Tags: []string{"space"}}.Matches(content))
+	assert.False(t, SearchFilters{MinDurationSeconds: 1801}.Matches(content))
+	assert.False(t, SearchFilters{PublishedBefore: content.PublishedAt}.Matches(content))
+}
+
+func TestParseBoosts(t *testing.T) {
+	boosts, err := ParseBoosts("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBoosts(), boosts)
+
+	boosts, err = ParseBoosts(" title=4, description = 0.5 ,exact_tag=0")
+	require.NoError(t, err)
+	expected := DefaultBoosts()
+	expected.Title = 4
+	expected.Description = 0.5
+	expected.ExactTag = 0
+	assert.Equal(t, expected, boosts)
+
+	for _, spec := range []string{"title", "rating=2", "title=abc", "title=-1", "title=NaN", "title=Inf"} {
+		_, err := ParseBoosts(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestSearchContents_WithBoosts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	boosts, err := ParseBoosts("title=4,exact_title=10,exact_tag=0.25")
+	require.NoError(t, err)
+	store := New(db, WithBoosts(boosts))
+	ctx := context.Background()
+
+	mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	mock.ExpectQuery(`4 \* SIMILARITY\(title_normalized, \$1\) \+\s+1 \* COALESCE\(SIMILARITY\(description_normalized, \$1\), 0\).*WHEN \$1 <> '' AND title_normalized = \$1 THEN 10.*WHEN \$1 <> '' AND \$1 = ANY\(tag_names\) THEN 0\.25`).
+		WithArgs("planet", "%planet%", 11).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "matched_fields",
+		}))
+
+	results, _, err := store.SearchContents(ctx, "Planet", SearchFilters{}, 10, "")
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchContents_QuerySyntax(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	mock.ExpectQuery(`WHERE \(\(title_normalized LIKE \$2 OR description_normalized LIKE \$2 OR platform_name_normalized LIKE \$2 OR tag_text LIKE \$2\) AND \$3 = ANY\(tag_names\) AND NOT COALESCE\(language = \$4, FALSE\) AND platform_name_normalized = \$5\) \) SELECT`).
+		WithArgs("planet earth", "%planet earth%", "nature", "en", "youtube", 11).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "matched_fields",
+		}))
+
+	results, _, err := store.SearchContents(ctx, `"Planet Earth" tag:Nature -lang:en platform:YouTube`, SearchFilters{}, 10, "")
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchContents_ScoresNormalizedPlatform(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	mock.ExpectQuery(`COALESCE\(c\.platform_name_normalized, LOWER\(c\.platform_name\)\) as platform_name_normalized.*SIMILARITY\(platform_name_normalized, \$1\).*CASE WHEN platform_name_normalized % \$1 OR platform_name_normalized LIKE \$2 THEN 'platform_name' END.*AND platform_name_normalized = \$3\)`).
+		WithArgs("اذاعه", "%اذاعه%", "اذاعه القران", 11).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score", "matched_fields",
+		}).AddRow(
+			"id1", "Morning recitation", nil, "ar", 1800,
+			nil, "podcast", time.Now(), time.Now(), nil, "إذاعة القرآن", 0.5, "{platform_name}",
+		))
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}))
+
+	results, _, err := store.SearchContents(ctx, `إذاعة platform:"إذاعة القرآن"`, SearchFilters{}, 10, "")
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{MatchedFieldPlatformName}, results[0].MatchedFields)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchFacets_QuerySyntaxNegatesWithSubstrings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+	ctx := context.Background()
+
+	mock.ExpectExec(`SET SESSION pg_trgm\.similarity_threshold = 0\.10`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	mock.ExpectQuery(`WHERE \(\(title_normalized % \$1 OR title_normalized LIKE \$2\) AND NOT COALESCE\(\(title_normalized LIKE \$3 OR description_normalized LIKE \$3 OR platform_name_normalized LIKE \$3 OR tag_text LIKE \$3\), FALSE\)\) \) SELECT 'content_type'`).
+		WithArgs("science", "%science%", `%100\%%`, maxTagFacetBuckets).
+		WillReturnRows(sqlmock.NewRows([]string{"facet", "value", "count"}))
+
+	_, err = store.SearchFacets(ctx, `title:science -100%`, SearchFilters{})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchContents_InvalidQuery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+
+	_, _, err = store.SearchContents(context.Background(), `planet (earth`, SearchFilters{}, 10, "")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, query.ErrInvalidQuery)
+	assert.Contains(t, err.Error(), "position 14")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRelatedContents_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+	ctx := context.Background()
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT content_type, language, platform_name, COALESCE\(title_normalized, LOWER\(title\)\), COALESCE\(description_normalized, LOWER\(description\)\) FROM contents WHERE id = \$1 AND deleted_at IS NULL`).
+		WithArgs("source").
+		WillReturnRows(sqlmock.NewRows([]string{"content_type", "language", "platform_name", "title_normalized", "description_normalized"}).
+			AddRow("documentary", "en", "YouTube", "planet earth", "wildlife"))
+
+	relatedRows := sqlmock.NewRows([]string{
+		"id", "title", "description", "language", "duration_seconds",
+		"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score",
+	}).AddRow(
+		"id1", "Planet Earth II", "Wildlife", "en", 3600,
+		createdAt, "documentary", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "YouTube", 3.5,
+	).AddRow(
+		"id2", "Blue Planet", "Oceans", "en", 3000,
+		createdAt, "documentary", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "YouTube", 1.25,
+	)
+
+	mock.ExpectQuery(`ct\.tag_id IN \(SELECT tag_id FROM content_tags WHERE content_id = \$1\).*WHERE c\.deleted_at IS NULL AND c\.id <> \$1 AND COALESCE\(c\.platform_name, ''\) = \$6 \) SELECT .* FROM candidates WHERE score > 0 ORDER BY score DESC, created_at DESC, id DESC LIMIT \$7`).
+		WithArgs("source", "documentary", "en", "planet earth", "wildlife", "YouTube", 2).
+		WillReturnRows(relatedRows)
+
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow("id1", "nature"))
+
+	contents, nextPageToken, err := store.GetRelatedContents(ctx, "source", true, 1, "")
+
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	assert.Equal(t, "id1", contents[0].ID)
+	assert.Equal(t, []string{"nature"}, contents[0].Tags)
+	require.NotEmpty(t, nextPageToken)
+
+	cursor, err := codec.Decode(nextPageToken, pagination.Scope("discovery.GetRelatedContents", "source", "true"), 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3.5", createdAt.Format(time.RFC3339Nano), "id1"}, cursor.Keys)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRelatedContents_WithPageToken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+	ctx := context.Background()
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	pageToken, err := codec.Encode(pagination.Scope("discovery.GetRelatedContents", "source", "false"), "3.5", createdAt.Format(time.RFC3339Nano), "id1")
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`SELECT content_type, language, platform_name`).
+		WithArgs("source").
+		WillReturnRows(sqlmock.NewRows([]string{"content_type", "language", "platform_name", "title_normalized", "description_normalized"}).
+			AddRow("podcast", nil, nil, "science friday", nil))
+
+	mock.ExpectQuery(`WHERE c\.deleted_at IS NULL AND c\.id <> \$1 \) SELECT .* FROM candidates WHERE score > 0 AND \(score, created_at, id\) < \(\$6, \$7, \$8\) ORDER BY score DESC, created_at DESC, id DESC LIMIT \$9`).
+		WithArgs("source", "podcast", "", "science friday", "", 3.5, createdAt, "id1", 11).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name", "score",
+		}))
+
+	contents, nextPageToken, err := store.GetRelatedContents(ctx, "source", false, 10, pageToken)
+
+	require.NoError(t, err)
+	assert.Empty(t, contents)
+	assert.Empty(t, nextPageToken)
+
+	// A token issued for the same-platform listing is not valid here.
+	otherToken, err := codec.Encode(pagination.Scope("discovery.GetRelatedContents", "source", "true"), "3.5", createdAt.Format(time.RFC3339Nano), "id1")
+	require.NoError(t, err)
+	mock.ExpectQuery(`SELECT content_type, language, platform_name`).
+		WithArgs("source").
+		WillReturnRows(sqlmock.NewRows([]string{"content_type", "language", "platform_name", "title_normalized", "description_normalized"}).
+			AddRow("podcast", nil, nil, "science friday", nil))
+
+	_, _, err = store.GetRelatedContents(ctx, "source", false, 10, otherToken)
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRelatedContents_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := New(db)
+
+	mock.ExpectQuery(`SELECT content_type, language, platform_name`).
+		WithArgs("missing").
+		WillReturnError(sql.ErrNoRows)
+
+	_, _, err = store.GetRelatedContents(context.Background(), "missing", false, 10, "")
 
+	assert.ErrorIs(t, err, ErrNotFound)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
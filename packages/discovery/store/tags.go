@@ -0,0 +1,259 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mosaibah/Mawjood/packages/filter"
+	"github.com/mosaibah/Mawjood/packages/pagination"
+)
+
+// TagOrder sorts ListTags.
+type TagOrder int
+
+const (
+	// TagsByCount lists the most used tags first, then by name.
+	TagsByCount TagOrder = iota
+	// TagsByName lists tags alphabetically.
+	TagsByName
+)
+
+// Tag is a tag with the number of non-deleted contents carrying it.
+type Tag struct {
+	ID           string
+	Name         string
+	ContentCount int64
+}
+
+// ListTags returns the tags carried by at least one non-deleted content. A
+// non-empty prefix keeps tags whose normalized name starts with it.
+func (cd *ContentData) ListTags(ctx context.Context, prefix string, order TagOrder, pageSize int32, pageToken string) ([]Tag, string, error) {
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	prefix = cd.normalizers.Query().Normalize(strings.TrimSpace(prefix))
+	scope := pagination.Scope("discovery.ListTags", prefix, strconv.Itoa(int(order)))
+
+	var args []interface{}
+	var prefixClause string
+	if prefix != "" {
+		args = append(args, filter.EscapeLike(prefix)+"%")
+		prefixClause = fmt.Sprintf("\n\t\t\tWHERE COALESCE(t.normalized_name, LOWER(t.name)) LIKE $%d", len(args))
+	}
+
+	orderClause := "content_count DESC, name ASC"
+	if order == TagsByName {
+		orderClause = "name ASC"
+	}
+
+	var paginationClause string
+	if pageToken != "" {
+		switch order {
+		case TagsByName:
+			cursor, err := cd.cursors.Decode(pageToken, scope, 1)
+			if err != nil {
+				return nil, "", err
+			}
+			args = append(args, cursor.Keys[0])
+			paginationClause = fmt.Sprintf("\n\t\tWHERE name > $%d", len(args))
+		default:
+			cursor, err := cd.cursors.Decode(pageToken, scope, 2)
+			if err != nil {
+				return nil, "", err
+			}
+			count, err := strconv.ParseInt(cursor.Keys[0], 10, 64)
+			if err != nil {
+				return nil, "", fmt.Errorf("%w: bad content count", pagination.ErrInvalidToken)
+			}
+			args = append(args, count, cursor.Keys[1])
+			paginationClause = fmt.Sprintf("\n\t\tWHERE content_count < $%[1]d OR (content_count = $%[1]d AND name > $%[2]d)", len(args)-1, len(args))
+		}
+	}
+
+	args = append(args, pageSize+1)
+
+	tagsQuery := fmt.Sprintf(`
+		WITH tag_counts AS (
+			SELECT t.id, t.name, COUNT(*) as content_count
+			FROM tags t
+			INNER JOIN content_tags ct ON ct.tag_id = t.id
+			INNER JOIN contents c ON c.id = ct.content_id AND c.deleted_at IS NULL%s
+			GROUP BY t.id, t.name
+		)
+		SELECT id, name, content_count
+		FROM tag_counts%s
+		ORDER BY %s
+		LIMIT $%d`, prefixClause, paginationClause, orderClause, len(args))
+
+	q, done, err := cd.reader(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer done()
+
+	rows, err := q.QueryContext(ctx, tagsQuery, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []Tag
+	for rows.Next() {
+		var tag Tag
+		if err := rows.Scan(&tag.ID, &tag.Name, &tag.ContentCount); err != nil {
+			return nil, "", fmt.Errorf("failed to scan tag row: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating over tags: %w", err)
+	}
+
+	var nextPageToken string
+	if len(tags) > int(pageSize) {
+		tags = tags[:pageSize]
+		last := tags[len(tags)-1]
+		if order == TagsByName {
+			nextPageToken, err = cd.cursors.Encode(scope, last.Name)
+		} else {
+			nextPageToken, err = cd.cursors.Encode(scope, strconv.FormatInt(last.ContentCount, 10), last.Name)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	return tags, nextPageToken, nil
+}
+
+// ListContentsByTag returns the non-deleted contents carrying the tag named
+// tag, newest first. It fails with ErrNotFound when no such tag exists.
+func (cd *ContentData) ListContentsByTag(ctx context.Context, tag string, pageSize int32, pageToken string) ([]Content, string, error) {
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	q, done, err := cd.reader(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer done()
+
+	var tagID string
+	err = q.QueryRowContext(ctx, `SELECT id FROM tags WHERE name = $1`, tag).Scan(&tagID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", fmt.Errorf("tag %q %w", tag, ErrNotFound)
+		}
+		return nil, "", fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	scope := pagination.Scope("discovery.ListContentsByTag", tag)
+	args := []interface{}{tagID}
+
+	var paginationClause string
+	if pageToken != "" {
+		cursor, err := cd.cursors.Decode(pageToken, scope, 2)
+		if err != nil {
+			return nil, "", err
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, cursor.Keys[0])
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: bad created_at", pagination.ErrInvalidToken)
+		}
+		args = append(args, createdAt, cursor.Keys[1])
+		paginationClause = fmt.Sprintf(" AND (c.created_at, c.id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+
+	args = append(args, pageSize+1)
+
+	contentsQuery := fmt.Sprintf(`
+		SELECT c.id, c.title, c.description, c.language, c.duration_seconds, c.published_at, c.content_type, c.created_at, c.updated_at, c.url, c.platform_name
+		FROM content_tags ct
+		INNER JOIN contents c ON c.id = ct.content_id
+		WHERE ct.tag_id = $1 AND c.deleted_at IS NULL%s
+		ORDER BY c.created_at DESC, c.id DESC
+		LIMIT $%d`, paginationClause, len(args))
+
+	rows, err := q.QueryContext(ctx, contentsQuery, args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list contents by tag: %w", err)
+	}
+	defer rows.Close()
+
+	var contents []Content
+	for rows.Next() {
+		var content Content
+		var createdAt, updatedAt time.Time
+		var publishedAt sql.NullTime
+		var description, language, url, platformName sql.NullString
+		var durationSeconds sql.NullInt32
+
+		err := rows.Scan(
+			&content.ID,
+			&content.Title,
+			&description,
+			&language,
+			&durationSeconds,
+			&publishedAt,
+			&content.ContentType,
+			&createdAt,
+			&updatedAt,
+			&url,
+			&platformName,
+		)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to scan content row: %w", err)
+		}
+
+		content.Description = description.String
+		content.Language = language.String
+		content.ExternalURL = url.String
+		content.PlatformName = platformName.String
+		content.DurationSeconds = durationSeconds.Int32
+		content.PublishedAt = publishedAt.Time
+		content.CreatedAt = createdAt
+		content.UpdatedAt = updatedAt
+
+		contents = append(contents, content)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("error iterating over contents: %w", err)
+	}
+
+	var nextPageToken string
+	if len(contents) > int(pageSize) {
+		contents = contents[:pageSize]
+		last := contents[len(contents)-1]
+		nextPageToken, err = cd.cursors.Encode(scope, last.CreatedAt.Format(time.RFC3339Nano), last.ID)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	page := make([]*Content, len(contents))
+	for i := range contents {
+		page[i] = &contents[i]
+	}
+	if err := cd.loadTags(ctx, q, page); err != nil {
+		return nil, "", err
+	}
+
+	return contents, nextPageToken, nil
+}
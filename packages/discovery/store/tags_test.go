@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/mosaibah/Mawjood/packages/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTags_ByCount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+
+	mock.ExpectQuery(`WITH tag_counts AS \( SELECT t\.id, t\.name, COUNT\(\*\) as content_count FROM tags t INNER JOIN content_tags ct ON ct\.tag_id = t\.id INNER JOIN contents c ON c\.id = ct\.content_id AND c\.deleted_at IS NULL WHERE COALESCE\(t\.normalized_name, LOWER\(t\.name\)\) LIKE \$1 GROUP BY t\.id, t\.name \) SELECT id, name, content_count FROM tag_counts ORDER BY content_count DESC, name ASC LIMIT \$2`).
+		WithArgs("sci%", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "content_count"}).
+			AddRow("t1", "science", 12).
+			AddRow("t2", "sci-fi", 4).
+			AddRow("t3", "scifi", 4))
+
+	tags, nextPageToken, err := store.ListTags(context.Background(), " Sci", TagsByCount, 2, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, []Tag{{ID: "t1", Name: "science", ContentCount: 12}, {ID: "t2", Name: "sci-fi", ContentCount: 4}}, tags)
+	require.NotEmpty(t, nextPageToken)
+
+	cursor, err := codec.Decode(nextPageToken, pagination.Scope("discovery.ListTags", "sci", "0"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"4", "sci-fi"}, cursor.Keys)
+
+	mock.ExpectQuery(`FROM tag_counts WHERE content_count < \$2 OR \(content_count = \$2 AND name > \$3\) ORDER BY content_count DESC, name ASC LIMIT \$4`).
+		WithArgs("sci%", int64(4), "sci-fi", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "content_count"}).
+			AddRow("t3", "scifi", 4))
+
+	tags, nextPageToken, err = store.ListTags(context.Background(), "sci", TagsByCount, 2, nextPageToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Tag{{ID: "t3", Name: "scifi", ContentCount: 4}}, tags)
+	assert.Empty(t, nextPageToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTags_ByName(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+
+	pageToken, err := codec.Encode(pagination.Scope("discovery.ListTags", "", "1"), "history")
+	require.NoError(t, err)
+
+	mock.ExpectQuery(`INNER JOIN contents c ON c\.id = ct\.content_id AND c\.deleted_at IS NULL GROUP BY t\.id, t\.name \) SELECT id, name, content_count FROM tag_counts WHERE name > \$1 ORDER BY name ASC LIMIT \$2`).
+		WithArgs("history", 11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "content_count"}).
+			AddRow("t4", "music", 2))
+
+	tags, nextPageToken, err := store.ListTags(context.Background(), "", TagsByName, 10, pageToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Tag{{ID: "t4", Name: "music", ContentCount: 2}}, tags)
+	assert.Empty(t, nextPageToken)
+
+	// A token issued for the count order is not valid here.
+	_, _, err = store.ListTags(context.Background(), "", TagsByCount, 10, pageToken)
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContentsByTag(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	store := New(db, WithCursorCodec(codec))
+	createdAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT id FROM tags WHERE name = \$1`).
+		WithArgs("nature").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("t1"))
+
+	mock.ExpectQuery(`FROM content_tags ct INNER JOIN contents c ON c\.id = ct\.content_id WHERE ct\.tag_id = \$1 AND c\.deleted_at IS NULL ORDER BY c\.created_at DESC, c\.id DESC LIMIT \$2`).
+		WithArgs("t1", 2).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name",
+		}).AddRow(
+			"id1", "Planet Earth II", "Wildlife", "en", 3600,
+			createdAt, "documentary", createdAt, createdAt, "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG", "YouTube",
+		).AddRow(
+			"id2", "Blue Planet", nil, nil, nil,
+			createdAt, "documentary", createdAt, createdAt, nil, nil,
+		))
+
+	mock.ExpectQuery(pageTagsQuery).
+		WithArgs(pq.Array([]string{"id1"})).
+		WillReturnRows(sqlmock.NewRows([]string{"content_id", "name"}).
+			AddRow("id1", "nature"))
+
+	contents, nextPageToken, err := store.ListContentsByTag(context.Background(), "nature", 1, "")
+
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	assert.Equal(t, "id1", contents[0].ID)
+	assert.Equal(t, []string{"nature"}, contents[0].Tags)
+
+	cursor, err := codec.Decode(nextPageToken, pagination.Scope("discovery.ListContentsByTag", "nature"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{createdAt.Format(time.RFC3339Nano), "id1"}, cursor.Keys)
+
+	mock.ExpectQuery(`SELECT id FROM tags WHERE name = \$1`).
+		WithArgs("nature").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("t1"))
+
+	mock.ExpectQuery(`WHERE ct\.tag_id = \$1 AND c\.deleted_at IS NULL AND \(c\.created_at, c\.id\) < \(\$2, \$3\) ORDER BY c\.created_at DESC, c\.id DESC LIMIT \$4`).
+		WithArgs("t1", createdAt, "id1", 2).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "title", "description", "language", "duration_seconds",
+			"published_at", "content_type", "created_at", "updated_at", "url", "platform_name",
+		}))
+
+	contents, nextPageToken, err = store.ListContentsByTag(context.Background(), "nature", 1, nextPageToken)
+
+	require.NoError(t, err)
+	assert.Empty(t, contents)
+	assert.Empty(t, nextPageToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListContentsByTag_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT id FROM tags WHERE name = \$1`).
+		WithArgs("missing").
+		WillReturnError(sql.ErrNoRows)
+
+	_, _, err = New(db).ListContentsByTag(context.Background(), "missing", 10, "")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
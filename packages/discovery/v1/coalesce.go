@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+// CoalescingStats counts SearchContents requests since the service started.
+type CoalescingStats struct {
+	// Searches is the number of searches sent to the search index.
+	Searches uint64
+	// Collapsed is the number of requests that waited on an identical search
+	// already in flight instead of sending their own.
+	Collapsed uint64
+}
+
+// searchFlight is a search in flight and, once done is closed, its result.
+type searchFlight struct {
+	done          chan struct{}
+	results       []store.SearchResult
+	nextPageToken string
+	err           error
+}
+
+// searchCoalescer lets concurrent identical searches share one call to the
+// search index, in the manner of singleflight. Waiting requests get the same
+// result slice, which callers must not modify. It is safe for concurrent use.
+type searchCoalescer struct {
+	mu      sync.Mutex
+	flights map[string]*searchFlight
+
+	searches  atomic.Uint64
+	collapsed atomic.Uint64
+}
+
+func newSearchCoalescer() *searchCoalescer {
+	return &searchCoalescer{flights: map[string]*searchFlight{}}
+}
+
+// do returns the result of search, sharing it with concurrent calls for the
+// same key. The search runs detached from ctx, so that a caller giving up does
+// not fail the others waiting on it; each caller still stops waiting when its
+// own ctx is done.
+func (c *searchCoalescer) do(ctx context.Context, key string, search func(ctx context.Context) ([]store.SearchResult, string, error)) ([]store.SearchResult, string, error) {
+	c.mu.Lock()
+	flight, ok := c.flights[key]
+	if ok {
+		c.collapsed.Add(1)
+	} else {
+		flight = &searchFlight{done: make(chan struct{})}
+		c.flights[key] = flight
+		c.searches.Add(1)
+
+		go func() {
+			flight.results, flight.nextPageToken, flight.err = search(context.WithoutCancel(ctx))
+
+			c.mu.Lock()
+			delete(c.flights, key)
+			c.mu.Unlock()
+			close(flight.done)
+		}()
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-flight.done:
+		return flight.results, flight.nextPageToken, flight.err
+	case <-ctx.Done():
+		return nil, "", ctx.Err()
+	}
+}
+
+func (c *searchCoalescer) stats() CoalescingStats {
+	return CoalescingStats{Searches: c.searches.Load(), Collapsed: c.collapsed.Load()}
+}
+
+// SearchCoalescingStats returns how many SearchContents requests were sent to
+// the search index and how many shared an identical search in flight.
+func (ds *DiscoveryService) SearchCoalescingStats() CoalescingStats {
+	return ds.coalescer.stats()
+}
+
+// searchKey identifies a search for coalescing. Runs of whitespace in the
+// query are collapsed, which the query parser does too, so queries that only
+// differ in spacing share a search. Consistent reads never share a stale one.
+func searchKey(ctx context.Context, rawQuery string, filters store.SearchFilters, pageSize int32, pageToken string) string {
+	return strings.Join([]string{
+		strconv.FormatBool(store.IsConsistentRead(ctx)),
+		strconv.Quote(strings.Join(strings.Fields(rawQuery), " ")),
+		fmt.Sprintf("%#v", filters),
+		strconv.Itoa(int(pageSize)),
+		strconv.Quote(pageToken),
+	}, "\x00")
+}
@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
+	"github.com/mosaibah/Mawjood/packages/discovery/mock"
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+// blockingIndex answers every search with one result once release is closed.
+type blockingIndex struct {
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (b *blockingIndex) SearchContents(ctx context.Context, query string, filters store.SearchFilters, pageSize int32, pageToken string) ([]store.SearchResult, string, error) {
+	b.calls.Add(1)
+	<-b.release
+	return []store.SearchResult{{Content: store.Content{ID: "550e8400-e29b-41d4-a716-446655440000", Title: "Planet Earth", ContentType: "documentary"}}}, "", nil
+}
+
+func (b *blockingIndex) SearchFacets(ctx context.Context, query string, filters store.SearchFilters) (*store.SearchFacets, error) {
+	return &store.SearchFacets{}, nil
+}
+
+func TestSearchContents_CoalescesIdenticalSearches(t *testing.T) {
+	index := &blockingIndex{release: make(chan struct{})}
+	service := New(&mock.MockContentData{}, WithSearchIndex(index))
+
+	queries := []string{"planet earth", "  planet   earth ", "planet earth", "planet\tearth", "planet earth"}
+	responses := make([]*mawjoodv1.SearchContentsResponse, len(queries))
+	var wg sync.WaitGroup
+	for i, q := range queries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := service.SearchContents(context.Background(), &mawjoodv1.SearchContentsRequest{Query: q, PageSize: 10})
+			assert.NoError(t, err)
+			responses[i] = resp
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return service.SearchCoalescingStats().Collapsed == uint64(len(queries)-1)
+	}, time.Second, 5*time.Millisecond)
+	close(index.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), index.calls.Load())
+	assert.Equal(t, CoalescingStats{Searches: 1, Collapsed: 4}, service.SearchCoalescingStats())
+	for _, resp := range responses {
+		require.NotNil(t, resp)
+		require.Len(t, resp.Contents, 1)
+		assert.Equal(t, "Planet Earth", resp.Contents[0].Title)
+	}
+}
+
+func TestSearchContents_DoesNotCoalesceDifferentOrFinishedSearches(t *testing.T) {
+	index := &blockingIndex{release: make(chan struct{})}
+	close(index.release)
+	service := New(&mock.MockContentData{}, WithSearchIndex(index))
+
+	requests := []*mawjoodv1.SearchContentsRequest{
+		{Query: "planet earth", PageSize: 10},
+		{Query: "planet earth", PageSize: 10},
+		{Query: "planet earth", PageSize: 20},
+		{Query: "Planet Earth", PageSize: 10},
+	}
+	for _, req := range requests {
+		_, err := service.SearchContents(context.Background(), req)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(4), index.calls.Load())
+	assert.Equal(t, CoalescingStats{Searches: 4}, service.SearchCoalescingStats())
+}
+
+func TestSearchCoalescer_WaiterGivingUpDoesNotCancelSearch(t *testing.T) {
+	c := newSearchCoalescer()
+	release := make(chan struct{})
+	search := func(ctx context.Context) ([]store.SearchResult, string, error) {
+		<-release
+		if err := ctx.Err(); err != nil {
+			return nil, "", err
+		}
+		return []store.SearchResult{{Score: 1}}, "next", nil
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, _, err := c.do(leaderCtx, "key", search)
+		leaderErr <- err
+	}()
+	require.Eventually(t, func() bool { return c.stats().Searches == 1 }, time.Second, 5*time.Millisecond)
+
+	followerDone := make(chan struct{})
+	var results []store.SearchResult
+	var nextPageToken string
+	var followerErr error
+	go func() {
+		defer close(followerDone)
+		results, nextPageToken, followerErr = c.do(context.Background(), "key", search)
+	}()
+	require.Eventually(t, func() bool { return c.stats().Collapsed == 1 }, time.Second, 5*time.Millisecond)
+
+	cancelLeader()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	close(release)
+	<-followerDone
+	require.NoError(t, followerErr)
+	assert.Equal(t, []store.SearchResult{{Score: 1}}, results)
+	assert.Equal(t, "next", nextPageToken)
+}
+
+func TestSearchKey(t *testing.T) {
+	ctx := context.Background()
+	key := searchKey(ctx, " planet  earth", store.SearchFilters{Tags: []string{"a b"}}, 10, "")
+
+	assert.Equal(t, key, searchKey(ctx, "planet earth ", store.SearchFilters{Tags: []string{"a b"}}, 10, ""))
+	assert.NotEqual(t, key, searchKey(ctx, "planet earth", store.SearchFilters{Tags: []string{"a", "b"}}, 10, ""))
+	assert.NotEqual(t, key, searchKey(ctx, "planet earth", store.SearchFilters{Tags: []string{"a b"}}, 10, "token"))
+	assert.NotEqual(t, key, searchKey(ctx, "planet earth", store.SearchFilters{Tags: []string{"a b"}}, 20, ""))
+	assert.NotEqual(t, key, searchKey(store.WithConsistentRead(ctx), "planet earth", store.SearchFilters{Tags: []string{"a b"}}, 10, ""))
+}
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"time"
+
+	"google.golang.org/grpc"
+
+	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
+	"github.com/mosaibah/Mawjood/packages/consistency"
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+// ConsistentReads returns an interceptor under which the named
+// DiscoveryService methods, such as "GetContent", read the latest data even
+// when the store is configured for stale reads. With zero staleness every read
+// is already the latest, so nothing is marked consistent and the methods stay
+// cacheable.
+func ConsistentReads(staleness store.Staleness, methods ...string) (grpc.UnaryServerInterceptor, error) {
+	server := reflect.TypeOf((*mawjoodv1.DiscoveryServiceServer)(nil)).Elem()
+
+	consistent := map[string]bool{}
+	for _, method := range methods {
+		if _, ok := server.MethodByName(method); !ok {
+			return nil, fmt.Errorf("unknown DiscoveryService method %q", method)
+		}
+		consistent[method] = true
+	}
+	if staleness == (store.Staleness{}) {
+		consistent = nil
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if consistent[path.Base(info.FullMethod)] {
+			ctx = store.WithConsistentRead(ctx)
+		}
+		return handler(ctx, req)
+	}, nil
+}
+
+// WithConsistencyHorizon sets how old a min_consistency token may be before
+// the service assumes every read already reflects its write. It must cover the
+// store's staleness, the cache TTLs and the search index refresh interval.
+// Without it, every token makes the read fresh.
+func WithConsistencyHorizon(horizon time.Duration) Option {
+	return func(ds *DiscoveryService) {
+		ds.consistencyHorizon = horizon
+	}
+}
+
+type freshReadKey struct{}
+
+// readContext returns the context to serve a read carrying the min_consistency
+// token under. A token from within the consistency horizon makes the read
+// fresh: consistent at the store, and bypassing the search index and searches
+// in flight, any of which may predate the write.
+func (ds *DiscoveryService) readContext(ctx context.Context, minConsistency string) (context.Context, error) {
+	if minConsistency == "" {
+		return ctx, nil
+	}
+
+	committedAt, err := consistency.Decode(minConsistency)
+	if err != nil {
+		return nil, err
+	}
+	if ds.consistencyHorizon > 0 && time.Since(committedAt) > ds.consistencyHorizon {
+		return ctx, nil
+	}
+	return context.WithValue(store.WithConsistentRead(ctx), freshReadKey{}, true), nil
+}
+
+// isFreshRead reports whether ctx was returned by readContext for a token
+// within the consistency horizon.
+func isFreshRead(ctx context.Context) bool {
+	fresh, _ := ctx.Value(freshReadKey{}).(bool)
+	return fresh
+}
@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
+	"github.com/mosaibah/Mawjood/packages/consistency"
+	"github.com/mosaibah/Mawjood/packages/discovery/cache"
+	"github.com/mosaibah/Mawjood/packages/discovery/mock"
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
+)
+
+func TestConsistentReads(t *testing.T) {
+	interceptor, err := ConsistentReads(store.FollowerReads(), "GetContent")
+	require.NoError(t, err)
+
+	consistent := func(method string) bool {
+		var got bool
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/mawjood.v1.DiscoveryService/" + method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = store.IsConsistentRead(ctx)
+				return nil, nil
+			})
+		require.NoError(t, err)
+		return got
+	}
+
+	assert.True(t, consistent("GetContent"))
+	assert.False(t, consistent("ListContents"))
+}
+
+func TestConsistentReads_UnknownMethod(t *testing.T) {
+	_, err := ConsistentReads(store.Staleness{}, "GetContent", "GetContents")
+
+	assert.EqualError(t, err, `unknown DiscoveryService method "GetContents"`)
+}
+
+func TestConsistentReads_WithoutStalenessKeepsCache(t *testing.T) {
+	staleness, err := store.ParseStaleness("none")
+	require.NoError(t, err)
+	interceptor, err := ConsistentReads(staleness, "GetContent")
+	require.NoError(t, err)
+
+	recorder := &readRecorder{}
+	cached := cache.New(recorder)
+	service := New(cached)
+	info := &grpc.UnaryServerInfo{FullMethod: "/mawjood.v1.DiscoveryService/GetContent"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return service.GetContent(ctx, req.(*mawjoodv1.GetContentRequest))
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := interceptor(context.Background(), &mawjoodv1.GetContentRequest{Id: "550e8400-e29b-41d4-a716-446655440000"}, info, handler)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []bool{false}, recorder.consistent)
+	assert.Equal(t, uint64(1), cached.Stats().GetContent.Hits)
+}
+
+// readRecorder records whether each read reached the store as a consistent
+// read.
+type readRecorder struct {
+	mock.MockContentData
+	consistent []bool
+}
+
+func (r *readRecorder) GetContent(ctx context.Context, id string) (*store.Content, error) {
+	r.consistent = append(r.consistent, store.IsConsistentRead(ctx))
+	return r.MockContentData.GetContent(ctx, id)
+}
+
+func (r *readRecorder) SearchContents(ctx context.Context, query string, filters store.SearchFilters, pageSize int32, pageToken string) ([]store.SearchResult, string, error) {
+	r.consistent = append(r.consistent, store.IsConsistentRead(ctx))
+	return r.MockContentData.SearchContents(ctx, query, filters, pageSize, pageToken)
+}
+
+func TestGetContent_MinConsistency(t *testing.T) {
+	recorder := &readRecorder{}
+	service := New(recorder, WithConsistencyHorizon(time.Minute))
+	contentID := "550e8400-e29b-41d4-a716-446655440000"
+
+	for _, token := range []string{
+		"",
+		consistency.Encode(time.Now()),
+		consistency.Encode(time.Now().Add(-time.Hour)),
+	} {
+		_, err := service.GetContent(context.Background(), &mawjoodv1.GetContentRequest{Id: contentID, MinConsistency: token})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []bool{false, true, false}, recorder.consistent)
+}
+
+func TestGetContent_MinConsistencyWithoutHorizonAlwaysFresh(t *testing.T) {
+	recorder := &readRecorder{}
+	service := New(recorder)
+
+	_, err := service.GetContent(context.Background(), &mawjoodv1.GetContentRequest{
+		Id:             "550e8400-e29b-41d4-a716-446655440000",
+		MinConsistency: consistency.Encode(time.Now().Add(-24 * time.Hour)),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true}, recorder.consistent)
+}
+
+func TestGetContent_InvalidMinConsistency(t *testing.T) {
+	service := New(&mock.MockContentData{})
+
+	_, err := service.GetContent(context.Background(), &mawjoodv1.GetContentRequest{
+		Id:             "550e8400-e29b-41d4-a716-446655440000",
+		MinConsistency: "not-a-token",
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "invalid min_consistency")
+}
+
+func TestSearchContents_FreshReadBypassesIndexAndCoalescing(t *testing.T) {
+	recorder := &readRecorder{}
+	index := &blockingIndex{release: make(chan struct{})}
+	close(index.release)
+	service := New(recorder, WithSearchIndex(index), WithConsistencyHorizon(time.Minute))
+
+	_, err := service.SearchContents(context.Background(), &mawjoodv1.SearchContentsRequest{
+		Query:          "test",
+		PageSize:       10,
+		MinConsistency: consistency.Encode(time.Now()),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []bool{true}, recorder.consistent)
+	assert.Equal(t, int32(0), index.calls.Load())
+	assert.Equal(t, CoalescingStats{}, service.SearchCoalescingStats())
+
+	_, err = service.SearchContents(context.Background(), &mawjoodv1.SearchContentsRequest{Query: "test", PageSize: 10})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), index.calls.Load())
+	assert.Equal(t, CoalescingStats{Searches: 1}, service.SearchCoalescingStats())
+}
@@ -2,23 +2,73 @@ package v1
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
 
+	"github.com/mosaibah/Mawjood/packages/discovery/events"
+	"github.com/mosaibah/Mawjood/packages/discovery/query"
 	"github.com/mosaibah/Mawjood/packages/discovery/store"
+	"github.com/mosaibah/Mawjood/packages/filter"
+	"github.com/mosaibah/Mawjood/packages/highlight"
+	"github.com/mosaibah/Mawjood/packages/pagination"
+	"github.com/mosaibah/Mawjood/packages/textnorm"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type DiscoveryService struct {
 	mawjoodv1.UnimplementedDiscoveryServiceServer
-	store store.Interface
+	store      store.Interface
+	search     store.SearchIndex
+	recorder   *events.Recorder
+	searchLog  *events.SearchLog
+	normalizer *textnorm.Normalizer
+	coalescer  *searchCoalescer
+
+	consistencyHorizon time.Duration
+}
+
+// Option configures a DiscoveryService created by New.
+type Option func(*DiscoveryService)
+
+// WithSearchIndex serves SearchContents from index instead of the store.
+func WithSearchIndex(index store.SearchIndex) Option {
+	return func(ds *DiscoveryService) {
+		ds.search = index
+	}
+}
+
+// WithEventRecorder enables RecordEvent, queueing events on recorder. Without
+// it RecordEvent fails with Unavailable.
+func WithEventRecorder(recorder *events.Recorder) Option {
+	return func(ds *DiscoveryService) {
+		ds.recorder = recorder
+	}
 }
 
-func New(store store.Interface) *DiscoveryService {
-	return &DiscoveryService{store: store}
+// WithSearchLog logs every successful SearchContents call with a query to
+// searchLog, for the CMS search analytics.
+func WithSearchLog(searchLog *events.SearchLog) Option {
+	return func(ds *DiscoveryService) {
+		ds.searchLog = searchLog
+	}
+}
+
+// snippetLength is the maximum number of characters of description returned
+// as a search snippet.
+const snippetLength = 160
+
+func New(store store.Interface, opts ...Option) *DiscoveryService {
+	ds := &DiscoveryService{store: store, search: store, normalizer: textnorm.Default().Query(), coalescer: newSearchCoalescer()}
+	for _, opt := range opts {
+		opt(ds)
+	}
+	return ds
 }
 
 func (ds *DiscoveryService) GetContent(ctx context.Context, req *mawjoodv1.GetContentRequest) (*mawjoodv1.Content, error) {
@@ -28,6 +78,11 @@ func (ds *DiscoveryService) GetContent(ctx context.Context, req *mawjoodv1.GetCo
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
+	ctx, err := ds.readContext(ctx, req.MinConsistency)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_consistency: %v", err)
+	}
+
 	content, err := ds.store.GetContent(ctx, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "failed to get content: %v", err)
@@ -38,6 +93,39 @@ func (ds *DiscoveryService) GetContent(ctx context.Context, req *mawjoodv1.GetCo
 	return ds.storeContentToProto(content), nil
 }
 
+func (ds *DiscoveryService) BatchGetContents(ctx context.Context, req *mawjoodv1.BatchGetContentsRequest) (*mawjoodv1.BatchGetContentsResponse, error) {
+	log.Printf("BatchGetContents started - count: %d", len(req.Ids))
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	ctx, err := ds.readContext(ctx, req.MinConsistency)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_consistency: %v", err)
+	}
+
+	contents, err := ds.store.BatchGetContents(ctx, req.Ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get contents: %v", err)
+	}
+
+	results := make([]*mawjoodv1.BatchGetContentsResult, len(req.Ids))
+	var notFound int
+	for i, id := range req.Ids {
+		if contents[i] == nil {
+			results[i] = &mawjoodv1.BatchGetContentsResult{Id: id, NotFound: true}
+			notFound++
+			continue
+		}
+		results[i] = &mawjoodv1.BatchGetContentsResult{Id: id, Content: ds.storeContentToProto(contents[i])}
+	}
+
+	log.Printf("BatchGetContents completed successfully - found: %d, not found: %d", len(req.Ids)-notFound, notFound)
+
+	return &mawjoodv1.BatchGetContentsResponse{Results: results}, nil
+}
+
 func (ds *DiscoveryService) ListContents(ctx context.Context, req *mawjoodv1.ListContentsRequest) (*mawjoodv1.ListContentsResponse, error) {
 	log.Printf("ListContents started")
 
@@ -45,8 +133,19 @@ func (ds *DiscoveryService) ListContents(ctx context.Context, req *mawjoodv1.Lis
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
-	contents, nextPageToken, err := ds.store.ListContents(ctx, req.PageSize, req.PageToken)
+	ctx, err := ds.readContext(ctx, req.MinConsistency)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_consistency: %v", err)
+	}
+
+	contents, nextPageToken, err := ds.store.ListContents(ctx, req.PageSize, req.PageToken, req.OrderBy, req.Filter)
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+		if errors.Is(err, filter.ErrInvalidFilter) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to list contents: %v", err)
 	}
 
@@ -65,29 +164,424 @@ func (ds *DiscoveryService) ListContents(ctx context.Context, req *mawjoodv1.Lis
 
 func (ds *DiscoveryService) SearchContents(ctx context.Context, req *mawjoodv1.SearchContentsRequest) (*mawjoodv1.SearchContentsResponse, error) {
 	log.Printf("SearchContents started - query: %s", req.Query)
+	start := time.Now()
 
 	if err := req.Validate(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
-	contents, nextPageToken, err := ds.store.SearchContents(ctx, req.Query, req.PageSize, req.PageToken)
+	ctx, err := ds.readContext(ctx, req.MinConsistency)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_consistency: %v", err)
+	}
+
+	parsed, err := query.Parse(req.Query)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+	}
+
+	filters, err := ds.protoSearchFiltersToStore(req.Filters)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filters: %v", err)
+	}
+
+	index, fresh := ds.search, isFreshRead(ctx)
+	if fresh {
+		// The search index and any identical search in flight may predate
+		// the write the caller is waiting for.
+		index = ds.store
+	}
+	search := func(ctx context.Context) ([]store.SearchResult, string, error) {
+		return index.SearchContents(ctx, req.Query, filters, req.PageSize, req.PageToken)
+	}
+
+	var results []store.SearchResult
+	var nextPageToken string
+	if fresh {
+		results, nextPageToken, err = search(ctx)
+	} else {
+		key := searchKey(ctx, req.Query, filters, req.PageSize, req.PageToken)
+		results, nextPageToken, err = ds.coalescer.do(ctx, key, search)
+	}
 	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+		if errors.Is(err, query.ErrInvalidQuery) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to search contents: %v", err)
 	}
 
+	var terms []string
+	for _, term := range parsed.Terms() {
+		terms = append(terms, ds.normalizer.Normalize(term))
+	}
+	protoContents := make([]*mawjoodv1.Content, len(results))
+	protoMatches := make([]*mawjoodv1.SearchMatch, len(results))
+	for i, result := range results {
+		protoContents[i] = ds.storeContentToProto(&result.Content)
+		protoMatches[i] = ds.storeSearchResultToMatch(&result, terms)
+	}
+
+	var protoFacets *mawjoodv1.SearchFacets
+	if req.IncludeFacets {
+		facets, err := index.SearchFacets(ctx, req.Query, filters)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to compute search facets: %v", err)
+		}
+		protoFacets = ds.storeFacetsToProto(facets)
+	}
+
+	ds.logSearch(req, len(results), time.Since(start))
+
+	log.Printf("SearchContents completed successfully - count: %d", len(results))
+
+	return &mawjoodv1.SearchContentsResponse{
+		Contents:      protoContents,
+		NextPageToken: nextPageToken,
+		Facets:        protoFacets,
+		Matches:       protoMatches,
+	}, nil
+}
+
+// logSearch queues a search record for req. Blank queries, such as pure
+// filter browsing, are not logged, and a full buffer only costs the record.
+func (ds *DiscoveryService) logSearch(req *mawjoodv1.SearchContentsRequest, resultCount int, latency time.Duration) {
+	if ds.searchLog == nil {
+		return
+	}
+
+	normalized := strings.Join(strings.Fields(ds.normalizer.Normalize(req.Query)), " ")
+	if normalized == "" {
+		return
+	}
+
+	err := ds.searchLog.Record(store.SearchRecord{
+		Query:       normalized,
+		ResultCount: resultCount,
+		Latency:     latency,
+		PageDepth:   pagination.PageOf(req.PageToken),
+		SearchedAt:  time.Now().UTC(),
+	})
+	if err != nil {
+		log.Printf("failed to log search: %v", err)
+	}
+}
+
+func (ds *DiscoveryService) Suggest(ctx context.Context, req *mawjoodv1.SuggestRequest) (*mawjoodv1.SuggestResponse, error) {
+	log.Printf("Suggest started - prefix: %s", req.Prefix)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	ctx, err := ds.readContext(ctx, req.MinConsistency)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_consistency: %v", err)
+	}
+
+	order := store.SuggestByPopularity
+	if req.Order == mawjoodv1.SuggestOrder_SUGGEST_ORDER_RECENCY {
+		order = store.SuggestByRecency
+	}
+
+	suggestions, err := ds.store.Suggest(ctx, req.Prefix, req.Limit, order)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get suggestions: %v", err)
+	}
+
+	protoSuggestions := make([]*mawjoodv1.Suggestion, len(suggestions))
+	for i, suggestion := range suggestions {
+		protoSuggestions[i] = &mawjoodv1.Suggestion{
+			Text:         suggestion.Text,
+			Type:         storeSuggestionTypeToProto(suggestion.Type),
+			ContentId:    suggestion.ContentID,
+			ContentCount: suggestion.ContentCount,
+		}
+	}
+
+	log.Printf("Suggest completed successfully - count: %d", len(suggestions))
+
+	return &mawjoodv1.SuggestResponse{
+		Suggestions: protoSuggestions,
+	}, nil
+}
+
+func (ds *DiscoveryService) GetRelatedContents(ctx context.Context, req *mawjoodv1.GetRelatedContentsRequest) (*mawjoodv1.GetRelatedContentsResponse, error) {
+	log.Printf("GetRelatedContents started - ID: %s", req.Id)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	ctx, err := ds.readContext(ctx, req.MinConsistency)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_consistency: %v", err)
+	}
+
+	contents, nextPageToken, err := ds.store.GetRelatedContents(ctx, req.Id, req.SamePlatform, req.PageSize, req.PageToken)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "failed to get related contents: %v", err)
+		}
+		if errors.Is(err, pagination.ErrInvalidToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get related contents: %v", err)
+	}
+
 	protoContents := make([]*mawjoodv1.Content, len(contents))
 	for i, content := range contents {
 		protoContents[i] = ds.storeContentToProto(&content)
 	}
 
-	log.Printf("SearchContents completed successfully - count: %d", len(contents))
+	log.Printf("GetRelatedContents completed successfully - count: %d", len(contents))
 
-	return &mawjoodv1.SearchContentsResponse{
+	return &mawjoodv1.GetRelatedContentsResponse{
 		Contents:      protoContents,
 		NextPageToken: nextPageToken,
 	}, nil
 }
 
+func (ds *DiscoveryService) ListTags(ctx context.Context, req *mawjoodv1.ListTagsRequest) (*mawjoodv1.ListTagsResponse, error) {
+	log.Printf("ListTags started - prefix: %s", req.Prefix)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	ctx, err := ds.readContext(ctx, req.MinConsistency)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_consistency: %v", err)
+	}
+
+	order := store.TagsByCount
+	if req.Order == mawjoodv1.TagOrder_TAG_ORDER_NAME {
+		order = store.TagsByName
+	}
+
+	tags, nextPageToken, err := ds.store.ListTags(ctx, req.Prefix, order, req.PageSize, req.PageToken)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+
+	protoTags := make([]*mawjoodv1.Tag, len(tags))
+	for i, tag := range tags {
+		protoTags[i] = &mawjoodv1.Tag{
+			Id:           tag.ID,
+			Name:         tag.Name,
+			ContentCount: tag.ContentCount,
+		}
+	}
+
+	log.Printf("ListTags completed successfully - count: %d", len(tags))
+
+	return &mawjoodv1.ListTagsResponse{
+		Tags:          protoTags,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (ds *DiscoveryService) ListContentsByTag(ctx context.Context, req *mawjoodv1.ListContentsByTagRequest) (*mawjoodv1.ListContentsByTagResponse, error) {
+	log.Printf("ListContentsByTag started - tag: %s", req.Tag)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	ctx, err := ds.readContext(ctx, req.MinConsistency)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_consistency: %v", err)
+	}
+
+	contents, nextPageToken, err := ds.store.ListContentsByTag(ctx, req.Tag, req.PageSize, req.PageToken)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "failed to list contents by tag: %v", err)
+		}
+		if errors.Is(err, pagination.ErrInvalidToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list contents by tag: %v", err)
+	}
+
+	protoContents := make([]*mawjoodv1.Content, len(contents))
+	for i, content := range contents {
+		protoContents[i] = ds.storeContentToProto(&content)
+	}
+
+	log.Printf("ListContentsByTag completed successfully - count: %d", len(contents))
+
+	return &mawjoodv1.ListContentsByTagResponse{
+		Contents:      protoContents,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (ds *DiscoveryService) RecordEvent(ctx context.Context, req *mawjoodv1.RecordEventRequest) (*mawjoodv1.RecordEventResponse, error) {
+	log.Printf("RecordEvent started - content ID: %s, type: %s", req.ContentId, req.Type)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	if ds.recorder == nil {
+		return nil, status.Errorf(codes.Unavailable, "event recording is not enabled")
+	}
+
+	// Client clocks cannot be trusted to be in the past.
+	now := time.Now().UTC()
+	occurredAt := now
+	if req.OccurredAt != "" {
+		parsed, err := time.Parse(time.RFC3339, req.OccurredAt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid occurred_at format: %v", err)
+		}
+		if parsed.Before(now) {
+			occurredAt = parsed
+		}
+	}
+
+	accepted, err := ds.recorder.Record(store.Event{
+		ContentID:  req.ContentId,
+		Type:       protoEventTypeToStore(req.Type),
+		SessionID:  req.SessionId,
+		OccurredAt: occurredAt,
+	})
+	if err != nil {
+		if errors.Is(err, events.ErrBufferFull) {
+			return nil, status.Errorf(codes.ResourceExhausted, "failed to record event: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to record event: %v", err)
+	}
+
+	log.Printf("RecordEvent completed successfully - duplicate: %t", !accepted)
+
+	return &mawjoodv1.RecordEventResponse{Duplicate: !accepted}, nil
+}
+
+func (ds *DiscoveryService) ListTrending(ctx context.Context, req *mawjoodv1.ListTrendingRequest) (*mawjoodv1.ListTrendingResponse, error) {
+	log.Printf("ListTrending started - window: %s", req.Window)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	ctx, err := ds.readContext(ctx, req.MinConsistency)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min_consistency: %v", err)
+	}
+
+	window := store.Trending24Hours
+	switch req.Window {
+	case mawjoodv1.TrendingWindow_TRENDING_WINDOW_7D:
+		window = store.Trending7Days
+	case mawjoodv1.TrendingWindow_TRENDING_WINDOW_30D:
+		window = store.Trending30Days
+	}
+
+	filters := store.TrendingFilters{Languages: req.Languages}
+	for _, contentType := range req.ContentTypes {
+		filters.ContentTypes = append(filters.ContentTypes, ds.protoContentTypeToString(contentType))
+	}
+
+	trending, nextPageToken, err := ds.store.ListTrending(ctx, window, filters, req.PageSize, req.PageToken)
+	if err != nil {
+		if errors.Is(err, pagination.ErrInvalidToken) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list trending contents: %v", err)
+	}
+
+	protoContents := make([]*mawjoodv1.Content, len(trending))
+	protoStats := make([]*mawjoodv1.TrendingStats, len(trending))
+	for i, item := range trending {
+		protoContents[i] = ds.storeContentToProto(&item.Content)
+		protoStats[i] = &mawjoodv1.TrendingStats{
+			ContentId:  item.ID,
+			Score:      item.Score,
+			EventCount: item.EventCount,
+		}
+	}
+
+	log.Printf("ListTrending completed successfully - count: %d", len(trending))
+
+	return &mawjoodv1.ListTrendingResponse{
+		Contents:      protoContents,
+		NextPageToken: nextPageToken,
+		Stats:         protoStats,
+	}, nil
+}
+
+func protoEventTypeToStore(eventType mawjoodv1.EventType) string {
+	switch eventType {
+	case mawjoodv1.EventType_EVENT_TYPE_PLAY_START:
+		return store.EventTypePlayStart
+	case mawjoodv1.EventType_EVENT_TYPE_PLAY_COMPLETE:
+		return store.EventTypePlayComplete
+	case mawjoodv1.EventType_EVENT_TYPE_SHARE:
+		return store.EventTypeShare
+	default:
+		return store.EventTypeView
+	}
+}
+
+func (ds *DiscoveryService) protoSearchFiltersToStore(filters *mawjoodv1.SearchFilters) (store.SearchFilters, error) {
+	var result store.SearchFilters
+	if filters == nil {
+		return result, nil
+	}
+
+	for _, contentType := range filters.ContentTypes {
+		result.ContentTypes = append(result.ContentTypes, ds.protoContentTypeToString(contentType))
+	}
+	result.Languages = filters.Languages
+	result.Tags = filters.Tags
+	result.PlatformNames = filters.PlatformNames
+	result.MinDurationSeconds = filters.MinDurationSeconds
+	result.MaxDurationSeconds = filters.MaxDurationSeconds
+
+	if filters.MaxDurationSeconds > 0 && filters.MinDurationSeconds > filters.MaxDurationSeconds {
+		return result, fmt.Errorf("min_duration_seconds %d is greater than max_duration_seconds %d", filters.MinDurationSeconds, filters.MaxDurationSeconds)
+	}
+
+	var err error
+	if filters.PublishedAfter != "" {
+		result.PublishedAfter, err = time.Parse(time.RFC3339, filters.PublishedAfter)
+		if err != nil {
+			return result, fmt.Errorf("invalid published_after format: %w", err)
+		}
+	}
+
+	if filters.PublishedBefore != "" {
+		result.PublishedBefore, err = time.Parse(time.RFC3339, filters.PublishedBefore)
+		if err != nil {
+			return result, fmt.Errorf("invalid published_before format: %w", err)
+		}
+	}
+
+	if !result.PublishedAfter.IsZero() && !result.PublishedBefore.IsZero() && !result.PublishedAfter.Before(result.PublishedBefore) {
+		return result, fmt.Errorf("published_after must be before published_before")
+	}
+
+	return result, nil
+}
+
+func (ds *DiscoveryService) protoContentTypeToString(contentType mawjoodv1.ContentType) string {
+	switch contentType {
+	case mawjoodv1.ContentType_CONTENT_TYPE_PODCAST:
+		return "podcast"
+	case mawjoodv1.ContentType_CONTENT_TYPE_DOCUMENTARY:
+		return "documentary"
+	default:
+		return "podcast"
+	}
+}
+
 func (ds *DiscoveryService) stringToProtoContentType(contentType string) mawjoodv1.ContentType {
 	switch contentType {
 	case "podcast":
@@ -120,3 +614,63 @@ func (ds *DiscoveryService) storeContentToProto(content *store.Content) *mawjood
 		PlatformName:    content.PlatformName,
 	}
 }
+
+func (ds *DiscoveryService) storeSearchResultToMatch(result *store.SearchResult, terms []string) *mawjoodv1.SearchMatch {
+	snippet, snippetRanges := highlight.Snippet(result.Description, highlight.FindNormalized(result.Description, terms, ds.normalizer.Map), snippetLength)
+
+	snippetHighlights := make([]*mawjoodv1.TextRange, len(snippetRanges))
+	for i, r := range snippetRanges {
+		snippetHighlights[i] = &mawjoodv1.TextRange{Start: int32(r.Start), End: int32(r.End)}
+	}
+
+	return &mawjoodv1.SearchMatch{
+		ContentId:         result.ID,
+		Score:             result.Score,
+		MatchedFields:     result.MatchedFields,
+		HighlightedTitle:  highlight.Highlight(result.Title, highlight.FindNormalized(result.Title, terms, ds.normalizer.Map)),
+		Snippet:           snippet,
+		SnippetHighlights: snippetHighlights,
+	}
+}
+
+func (ds *DiscoveryService) storeFacetsToProto(facets *store.SearchFacets) *mawjoodv1.SearchFacets {
+	contentTypes := make([]store.FacetBucket, len(facets.ContentTypes))
+	for i, bucket := range facets.ContentTypes {
+		contentTypes[i] = store.FacetBucket{
+			Value: ds.stringToProtoContentType(bucket.Value).String(),
+			Count: bucket.Count,
+		}
+	}
+
+	return &mawjoodv1.SearchFacets{
+		ContentTypes:  storeFacetBucketsToProto(contentTypes),
+		Languages:     storeFacetBucketsToProto(facets.Languages),
+		PlatformNames: storeFacetBucketsToProto(facets.PlatformNames),
+		Tags:          storeFacetBucketsToProto(facets.Tags),
+		Durations:     storeFacetBucketsToProto(facets.Durations),
+	}
+}
+
+func storeFacetBucketsToProto(buckets []store.FacetBucket) []*mawjoodv1.FacetBucket {
+	protoBuckets := make([]*mawjoodv1.FacetBucket, len(buckets))
+	for i, bucket := range buckets {
+		protoBuckets[i] = &mawjoodv1.FacetBucket{
+			Value: bucket.Value,
+			Count: bucket.Count,
+		}
+	}
+	return protoBuckets
+}
+
+func storeSuggestionTypeToProto(suggestionType string) mawjoodv1.SuggestionType {
+	switch suggestionType {
+	case store.SuggestionTypeTitle:
+		return mawjoodv1.SuggestionType_SUGGESTION_TYPE_TITLE
+	case store.SuggestionTypeTag:
+		return mawjoodv1.SuggestionType_SUGGESTION_TYPE_TAG
+	case store.SuggestionTypePlatform:
+		return mawjoodv1.SuggestionType_SUGGESTION_TYPE_PLATFORM
+	default:
+		return mawjoodv1.SuggestionType_SUGGESTION_TYPE_UNSPECIFIED
+	}
+}
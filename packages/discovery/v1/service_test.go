@@ -8,7 +8,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
+	"github.com/mosaibah/Mawjood/packages/discovery/events"
+	"github.com/mosaibah/Mawjood/packages/discovery/index"
 	"github.com/mosaibah/Mawjood/packages/discovery/mock"
+	"github.com/mosaibah/Mawjood/packages/discovery/store"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -77,6 +80,53 @@ func TestGetContent_NotFound(t *testing.T) {
 	assert.Equal(t, codes.NotFound, statusErr.Code())
 }
 
+func TestBatchGetContents(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.BatchGetContentsRequest{
+		Ids: []string{
+			"550e8400-e29b-41d4-a716-446655440001",
+			"550e8400-e29b-41d4-a716-446655440999",
+			"550e8400-e29b-41d4-a716-446655440000",
+		},
+	}
+
+	resp, err := service.BatchGetContents(context.Background(), req)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440001", resp.Results[0].Id)
+	assert.Equal(t, "Test Documentary", resp.Results[0].Content.Title)
+	assert.False(t, resp.Results[0].NotFound)
+
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440999", resp.Results[1].Id)
+	assert.Nil(t, resp.Results[1].Content)
+	assert.True(t, resp.Results[1].NotFound)
+
+	assert.Equal(t, "Test Podcast", resp.Results[2].Content.Title)
+}
+
+func TestBatchGetContents_InvalidRequest(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	tooMany := make([]string, 101)
+	for i := range tooMany {
+		tooMany[i] = "550e8400-e29b-41d4-a716-446655440000"
+	}
+
+	for _, ids := range [][]string{nil, {"not-a-uuid"}, tooMany} {
+		resp, err := service.BatchGetContents(context.Background(), &mawjoodv1.BatchGetContentsRequest{Ids: ids})
+
+		assert.Nil(t, resp)
+		statusErr, ok := status.FromError(err)
+		require.True(t, ok, "Expected gRPC status error")
+		assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+	}
+}
+
 func TestListContents(t *testing.T) {
 	mockStore := &mock.MockContentData{}
 	service := New(mockStore)
@@ -222,3 +272,570 @@ func TestSearchContents_MixedResults(t *testing.T) {
 		assert.Equal(t, "Mixed Platform", content.PlatformName)
 	}
 }
+
+func TestSearchContents_WithFilters(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.SearchContentsRequest{
+		Query:    "podcast",
+		PageSize: 10,
+		Filters: &mawjoodv1.SearchFilters{
+			ContentTypes: []mawjoodv1.ContentType{mawjoodv1.ContentType_CONTENT_TYPE_DOCUMENTARY},
+		},
+	}
+
+	resp, err := service.SearchContents(context.Background(), req)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Empty(t, resp.Contents)
+
+	req.Filters = &mawjoodv1.SearchFilters{
+		ContentTypes:   []mawjoodv1.ContentType{mawjoodv1.ContentType_CONTENT_TYPE_PODCAST},
+		Languages:      []string{"en"},
+		PublishedAfter: "2024-01-01T00:00:00Z",
+	}
+
+	resp, err = service.SearchContents(context.Background(), req)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Len(t, resp.Contents, 1)
+}
+
+func TestSearchContents_InvalidFilters(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	testCases := []struct {
+		name    string
+		filters *mawjoodv1.SearchFilters
+	}{
+		{
+			name:    "unspecified content type",
+			filters: &mawjoodv1.SearchFilters{ContentTypes: []mawjoodv1.ContentType{mawjoodv1.ContentType_CONTENT_TYPE_UNSPECIFIED}},
+		},
+		{
+			name:    "inverted duration range",
+			filters: &mawjoodv1.SearchFilters{MinDurationSeconds: 3600, MaxDurationSeconds: 600},
+		},
+		{
+			name:    "inverted published range",
+			filters: &mawjoodv1.SearchFilters{PublishedAfter: "2024-02-01T00:00:00Z", PublishedBefore: "2024-01-01T00:00:00Z"},
+		},
+		{
+			name:    "malformed published date",
+			filters: &mawjoodv1.SearchFilters{PublishedAfter: "2024-01-01"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &mawjoodv1.SearchContentsRequest{
+				Query:    "podcast",
+				PageSize: 10,
+				Filters:  tc.filters,
+			}
+
+			resp, err := service.SearchContents(context.Background(), req)
+
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+
+			statusErr, ok := status.FromError(err)
+			require.True(t, ok, "Expected gRPC status error")
+			assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+		})
+	}
+}
+
+func TestSearchContents_WithFacets(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.SearchContentsRequest{
+		Query:         "podcast",
+		PageSize:      10,
+		IncludeFacets: true,
+	}
+
+	resp, err := service.SearchContents(context.Background(), req)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.NotNil(t, resp.Facets)
+
+	if assert.Len(t, resp.Facets.ContentTypes, 2) {
+		assert.Equal(t, mawjoodv1.ContentType_CONTENT_TYPE_PODCAST.String(), resp.Facets.ContentTypes[0].Value)
+		assert.Equal(t, int64(132), resp.Facets.ContentTypes[0].Count)
+		assert.Equal(t, mawjoodv1.ContentType_CONTENT_TYPE_DOCUMENTARY.String(), resp.Facets.ContentTypes[1].Value)
+		assert.Equal(t, int64(41), resp.Facets.ContentTypes[1].Count)
+	}
+	assert.Len(t, resp.Facets.Languages, 2)
+	assert.Len(t, resp.Facets.PlatformNames, 1)
+	assert.Len(t, resp.Facets.Tags, 2)
+	assert.Len(t, resp.Facets.Durations, 2)
+}
+
+func TestSearchContents_WithoutFacets(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.SearchContentsRequest{
+		Query:    "podcast",
+		PageSize: 10,
+	}
+
+	resp, err := service.SearchContents(context.Background(), req)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Nil(t, resp.Facets)
+}
+
+func TestListContents_InvalidPageToken(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.ListContentsRequest{
+		PageSize:  10,
+		PageToken: mock.InvalidPageToken,
+	}
+
+	resp, err := service.ListContents(context.Background(), req)
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+}
+
+func TestSearchContents_InvalidPageToken(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.SearchContentsRequest{
+		Query:     "podcast",
+		PageSize:  10,
+		PageToken: mock.InvalidPageToken,
+	}
+
+	resp, err := service.SearchContents(context.Background(), req)
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+}
+
+func TestListContents_InvalidFilter(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.ListContentsRequest{
+		PageSize: 10,
+		Filter:   `language = "ar" AND`,
+	}
+
+	resp, err := service.ListContents(context.Background(), req)
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+	assert.Contains(t, statusErr.Message(), "position")
+}
+
+func TestSuggest_Success(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.SuggestRequest{
+		Prefix: "sp",
+		Limit:  5,
+	}
+
+	resp, err := service.Suggest(context.Background(), req)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Suggestions, 2)
+	assert.Equal(t, "space", resp.Suggestions[0].Text)
+	assert.Equal(t, mawjoodv1.SuggestionType_SUGGESTION_TYPE_TAG, resp.Suggestions[0].Type)
+	assert.Equal(t, "Spotify", resp.Suggestions[1].Text)
+	assert.Equal(t, mawjoodv1.SuggestionType_SUGGESTION_TYPE_PLATFORM, resp.Suggestions[1].Type)
+}
+
+func TestSuggest_Arabic(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	resp, err := service.Suggest(context.Background(), &mawjoodv1.SuggestRequest{Prefix: "التاريخ"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Suggestions, 1)
+	assert.Equal(t, mawjoodv1.SuggestionType_SUGGESTION_TYPE_TITLE, resp.Suggestions[0].Type)
+	assert.NotEmpty(t, resp.Suggestions[0].ContentId)
+}
+
+func TestSuggest_ValidationError(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	resp, err := service.Suggest(context.Background(), &mawjoodv1.SuggestRequest{Prefix: "", Limit: 5})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+}
+
+func TestSearchContents_ReturnsMatchMetadata(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	req := &mawjoodv1.SearchContentsRequest{
+		Query:    "podcast",
+		PageSize: 10,
+	}
+
+	resp, err := service.SearchContents(context.Background(), req)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Contents, 1)
+	require.Len(t, resp.Matches, 1)
+
+	match := resp.Matches[0]
+	assert.Equal(t, resp.Contents[0].Id, match.ContentId)
+	assert.Equal(t, 0.8, match.Score)
+	assert.Equal(t, []string{"title", "description"}, match.MatchedFields)
+	assert.Equal(t, "Found <em>Podcast</em>", match.HighlightedTitle)
+	assert.Equal(t, "A podcast that matches the search", match.Snippet)
+	if assert.Len(t, match.SnippetHighlights, 1) {
+		assert.Equal(t, int32(2), match.SnippetHighlights[0].Start)
+		assert.Equal(t, int32(9), match.SnippetHighlights[0].End)
+	}
+}
+
+func TestSearchContents_HighlightsArabicVariants(t *testing.T) {
+	service := New(&mock.MockContentData{})
+
+	match := service.storeSearchResultToMatch(&store.SearchResult{
+		Content: store.Content{
+			ID:          "550e8400-e29b-41d4-a716-446655440001",
+			Title:       "أحكام الصلاة",
+			Description: "شرح مبسط لأحكام الصلاة",
+		},
+	}, []string{service.normalizer.Normalize("احكام")})
+
+	assert.Equal(t, "<em>أحكام</em> الصلاة", match.HighlightedTitle)
+	if assert.Len(t, match.SnippetHighlights, 1) {
+		assert.Equal(t, int32(10), match.SnippetHighlights[0].Start)
+		assert.Equal(t, int32(15), match.SnippetHighlights[0].End)
+	}
+}
+
+func TestSearchContents_UsesSearchIndex(t *testing.T) {
+	memoryIndex := index.NewMemory()
+	memoryIndex.Upsert(store.Content{
+		ID:          "550e8400-e29b-41d4-a716-446655440009",
+		Title:       "Indexed Documentary",
+		Language:    "en",
+		ContentType: "documentary",
+	})
+	service := New(&mock.MockContentData{}, WithSearchIndex(memoryIndex))
+
+	resp, err := service.SearchContents(context.Background(), &mawjoodv1.SearchContentsRequest{
+		Query:    "documentary",
+		PageSize: 10,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Contents, 1)
+	assert.Equal(t, "Indexed Documentary", resp.Contents[0].Title)
+	assert.Equal(t, "Indexed <em>Documentary</em>", resp.Matches[0].HighlightedTitle)
+}
+
+func TestSearchContents_InvalidQuerySyntax(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	resp, err := service.SearchContents(context.Background(), &mawjoodv1.SearchContentsRequest{
+		Query:    `"planet earth tag:nature`,
+		PageSize: 10,
+	})
+
+	assert.Nil(t, resp)
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+	assert.Contains(t, statusErr.Message(), "unterminated phrase at position 1")
+}
+
+func TestSearchContents_QuerySyntaxHighlightsPositiveTerms(t *testing.T) {
+	memoryIndex := index.NewMemory()
+	memoryIndex.Upsert(store.Content{
+		ID:          "550e8400-e29b-41d4-a716-446655440010",
+		Title:       "Planet Earth: Oceans",
+		Tags:        []string{"nature"},
+		Language:    "en",
+		ContentType: "documentary",
+	})
+	memoryIndex.Upsert(store.Content{
+		ID:          "550e8400-e29b-41d4-a716-446655440011",
+		Title:       "Planet Earth: Deserts",
+		Tags:        []string{"nature"},
+		Language:    "ar",
+		ContentType: "documentary",
+	})
+	service := New(&mock.MockContentData{}, WithSearchIndex(memoryIndex))
+
+	resp, err := service.SearchContents(context.Background(), &mawjoodv1.SearchContentsRequest{
+		Query:    `"planet earth" tag:nature -lang:ar -deserts`,
+		PageSize: 10,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Contents, 1)
+	assert.Equal(t, "<em>Planet Earth</em>: Oceans", resp.Matches[0].HighlightedTitle)
+}
+
+func TestGetRelatedContents(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	resp, err := service.GetRelatedContents(context.Background(), &mawjoodv1.GetRelatedContentsRequest{
+		Id:       "550e8400-e29b-41d4-a716-446655440000",
+		PageSize: 10,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Contents, 1)
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440001", resp.Contents[0].Id)
+	assert.Empty(t, resp.NextPageToken)
+}
+
+func TestGetRelatedContents_SamePlatform(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	resp, err := service.GetRelatedContents(context.Background(), &mawjoodv1.GetRelatedContentsRequest{
+		Id:           "550e8400-e29b-41d4-a716-446655440000",
+		SamePlatform: true,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Contents)
+}
+
+func TestGetRelatedContents_Errors(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	cases := []struct {
+		req  *mawjoodv1.GetRelatedContentsRequest
+		code codes.Code
+	}{
+		{&mawjoodv1.GetRelatedContentsRequest{Id: "not-a-uuid"}, codes.InvalidArgument},
+		{&mawjoodv1.GetRelatedContentsRequest{Id: "550e8400-e29b-41d4-a716-446655440999"}, codes.NotFound},
+		{&mawjoodv1.GetRelatedContentsRequest{Id: "550e8400-e29b-41d4-a716-446655440000", PageToken: mock.InvalidPageToken}, codes.InvalidArgument},
+	}
+
+	for _, tc := range cases {
+		resp, err := service.GetRelatedContents(context.Background(), tc.req)
+
+		assert.Nil(t, resp)
+		statusErr, ok := status.FromError(err)
+		require.True(t, ok, "Expected gRPC status error")
+		assert.Equal(t, tc.code, statusErr.Code(), tc.req.Id)
+	}
+}
+
+func TestRecordEvent(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	recorder := events.NewRecorder(mockStore)
+	service := New(mockStore, WithEventRecorder(recorder))
+
+	req := &mawjoodv1.RecordEventRequest{
+		ContentId:  "550e8400-e29b-41d4-a716-446655440000",
+		Type:       mawjoodv1.EventType_EVENT_TYPE_PLAY_START,
+		SessionId:  "session-1",
+		OccurredAt: "2024-01-15T10:00:00Z",
+	}
+
+	resp, err := service.RecordEvent(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, resp.Duplicate)
+
+	resp, err = service.RecordEvent(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, resp.Duplicate)
+
+	assert.Equal(t, 1, recorder.Pending())
+	assert.Equal(t, int64(1), recorder.Flush(context.Background()))
+}
+
+func TestRecordEvent_Errors(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	valid := &mawjoodv1.RecordEventRequest{
+		ContentId: "550e8400-e29b-41d4-a716-446655440000",
+		Type:      mawjoodv1.EventType_EVENT_TYPE_VIEW,
+		SessionId: "session-1",
+	}
+
+	full := events.NewRecorder(mockStore, events.WithBufferSize(1))
+	_, err := full.Record(store.Event{ContentID: "other", Type: store.EventTypeView, SessionID: "session-2"})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		service *DiscoveryService
+		req     *mawjoodv1.RecordEventRequest
+		code    codes.Code
+	}{
+		{"not enabled", New(mockStore), valid, codes.Unavailable},
+		{"missing type", New(mockStore, WithEventRecorder(events.NewRecorder(mockStore))), &mawjoodv1.RecordEventRequest{ContentId: valid.ContentId, SessionId: "session-1"}, codes.InvalidArgument},
+		{"buffer full", New(mockStore, WithEventRecorder(full)), valid, codes.ResourceExhausted},
+	}
+
+	for _, tc := range cases {
+		resp, err := tc.service.RecordEvent(context.Background(), tc.req)
+
+		assert.Nil(t, resp, tc.name)
+		statusErr, ok := status.FromError(err)
+		require.True(t, ok, "Expected gRPC status error")
+		assert.Equal(t, tc.code, statusErr.Code(), tc.name)
+	}
+}
+
+func TestListTrending(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	resp, err := service.ListTrending(context.Background(), &mawjoodv1.ListTrendingRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Contents, 1)
+	assert.Equal(t, "Test Podcast", resp.Contents[0].Title)
+	require.Len(t, resp.Stats, 1)
+	assert.Equal(t, resp.Contents[0].Id, resp.Stats[0].ContentId)
+	assert.Equal(t, 12.5, resp.Stats[0].Score)
+	assert.Equal(t, int64(20), resp.Stats[0].EventCount)
+
+	resp, err = service.ListTrending(context.Background(), &mawjoodv1.ListTrendingRequest{
+		Window:       mawjoodv1.TrendingWindow_TRENDING_WINDOW_7D,
+		ContentTypes: []mawjoodv1.ContentType{mawjoodv1.ContentType_CONTENT_TYPE_DOCUMENTARY},
+		Languages:    []string{"ar"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Contents, 1)
+	assert.Equal(t, "Test Documentary", resp.Contents[0].Title)
+}
+
+func TestListTrending_InvalidPageToken(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	resp, err := service.ListTrending(context.Background(), &mawjoodv1.ListTrendingRequest{PageToken: mock.InvalidPageToken})
+
+	assert.Nil(t, resp)
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+}
+
+type searchSink struct {
+	searches []store.SearchRecord
+}
+
+func (s *searchSink) RecordSearches(ctx context.Context, searches []store.SearchRecord) (int64, error) {
+	s.searches = append(s.searches, searches...)
+	return int64(len(searches)), nil
+}
+
+func TestSearchContents_LogsSearch(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	sink := &searchSink{}
+	searchLog := events.NewSearchLog(sink)
+	service := New(mockStore, WithSearchLog(searchLog))
+
+	_, err := service.SearchContents(context.Background(), &mawjoodv1.SearchContentsRequest{Query: "nonexistent", PageSize: 10})
+	require.NoError(t, err)
+	_, err = service.SearchContents(context.Background(), &mawjoodv1.SearchContentsRequest{Query: "  Planet   EARTH ", PageSize: 10})
+	require.NoError(t, err)
+
+	// Whitespace-only queries are not worth logging.
+	_, err = service.SearchContents(context.Background(), &mawjoodv1.SearchContentsRequest{Query: "   ", PageSize: 10})
+	require.NoError(t, err)
+
+	searchLog.Flush(context.Background())
+
+	require.Len(t, sink.searches, 2)
+	search := sink.searches[0]
+	assert.Equal(t, "nonexistent", search.Query)
+	assert.Zero(t, search.ResultCount)
+	assert.Equal(t, 1, search.PageDepth)
+	assert.False(t, search.SearchedAt.IsZero())
+	assert.Equal(t, "planet earth", sink.searches[1].Query)
+}
+
+func TestListTags(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	resp, err := service.ListTags(context.Background(), &mawjoodv1.ListTagsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Tags, 3)
+	assert.Equal(t, "test", resp.Tags[0].Name)
+	assert.Equal(t, int64(2), resp.Tags[0].ContentCount)
+
+	resp, err = service.ListTags(context.Background(), &mawjoodv1.ListTagsRequest{Order: mawjoodv1.TagOrder_TAG_ORDER_NAME})
+	require.NoError(t, err)
+	require.Len(t, resp.Tags, 3)
+	assert.Equal(t, "documentary", resp.Tags[0].Name)
+
+	resp, err = service.ListTags(context.Background(), &mawjoodv1.ListTagsRequest{Prefix: "Pod"})
+	require.NoError(t, err)
+	require.Len(t, resp.Tags, 1)
+	assert.Equal(t, "podcast", resp.Tags[0].Name)
+
+	_, err = service.ListTags(context.Background(), &mawjoodv1.ListTagsRequest{PageToken: mock.InvalidPageToken})
+	statusErr, ok := status.FromError(err)
+	require.True(t, ok, "Expected gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+}
+
+func TestListContentsByTag(t *testing.T) {
+	mockStore := &mock.MockContentData{}
+	service := New(mockStore)
+
+	resp, err := service.ListContentsByTag(context.Background(), &mawjoodv1.ListContentsByTagRequest{Tag: "documentary"})
+	require.NoError(t, err)
+	require.Len(t, resp.Contents, 1)
+	assert.Equal(t, "Test Documentary", resp.Contents[0].Title)
+
+	cases := []struct {
+		req  *mawjoodv1.ListContentsByTagRequest
+		code codes.Code
+	}{
+		{&mawjoodv1.ListContentsByTagRequest{Tag: "missing"}, codes.NotFound},
+		{&mawjoodv1.ListContentsByTagRequest{}, codes.InvalidArgument},
+		{&mawjoodv1.ListContentsByTagRequest{Tag: "test", PageToken: mock.InvalidPageToken}, codes.InvalidArgument},
+	}
+
+	for _, tc := range cases {
+		resp, err := service.ListContentsByTag(context.Background(), tc.req)
+
+		assert.Nil(t, resp)
+		statusErr, ok := status.FromError(err)
+		require.True(t, ok, "Expected gRPC status error")
+		assert.Equal(t, tc.code, statusErr.Code())
+	}
+}
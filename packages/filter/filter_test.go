@@ -0,0 +1,217 @@
+package filter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosaibah/Mawjood/packages/pagination"
+)
+
+var testSchema = Schema{
+	"content_type":     {Expr: "content_type", Type: TypeString},
+	"language":         {Expr: "language", Type: TypeString},
+	"title":            {Expr: "title", Type: TypeString},
+	"duration_seconds": {Expr: "duration_seconds", Type: TypeInt},
+	"published_at":     {Expr: "published_at", Type: TypeTimestamp},
+	"tags":             {Membership: "id IN (SELECT content_id FROM content_tags WHERE name = %s)"},
+}
+
+func TestTranslate_Conjunction(t *testing.T) {
+	sql, args, err := Translate(`content_type = "podcast" AND language = "ar" AND duration_seconds < 1800`, testSchema, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "(content_type = $1 AND language = $2 AND duration_seconds < $3)", sql)
+	assert.Equal(t, []interface{}{"podcast", "ar", int64(1800)}, args)
+}
+
+func TestTranslate_OrBindsTighterThanAnd(t *testing.T) {
+	sql, args, err := Translate(`language = en AND content_type = podcast OR content_type = documentary`, testSchema, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "(language = $1 AND (content_type = $2 OR content_type = $3))", sql)
+	assert.Equal(t, []interface{}{"en", "podcast", "documentary"}, args)
+}
+
+func TestTranslate_NegationGroupingAndExistingArgs(t *testing.T) {
+	existing := []interface{}{"first"}
+
+	sql, args, err := Translate(`-(language = "en" OR language = "fr") NOT tags:"news"`, testSchema, existing)
+
+	require.NoError(t, err)
+	assert.Equal(t, "(NOT ((language = $2 OR language = $3)) AND NOT (id IN (SELECT content_id FROM content_tags WHERE name = $4)))", sql)
+	assert.Equal(t, []interface{}{"first", "en", "fr", "news"}, args)
+}
+
+func TestTranslate_HasOperatorOnText(t *testing.T) {
+	sql, args, err := Translate(`title:"100%_real"`, testSchema, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "title ILIKE $1", sql)
+	assert.Equal(t, []interface{}{`%100\%\_real%`}, args)
+}
+
+func TestTranslate_Timestamp(t *testing.T) {
+	sql, args, err := Translate(`published_at >= "2024-01-15T00:00:00Z"`, testSchema, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "published_at >= $1", sql)
+	assert.Equal(t, []interface{}{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}, args)
+}
+
+func TestTranslate_Empty(t *testing.T) {
+	sql, args, err := Translate("   ", testSchema, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, sql)
+	assert.Empty(t, args)
+}
+
+func TestTranslate_Errors(t *testing.T) {
+	testCases := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{input: `rating > 3`, pos: 1, msg: `unknown field "rating"`},
+		{input: `language = "ar" AND colour = "red"`, pos: 21, msg: `unknown field "colour"`},
+		{input: `duration_seconds < long`, pos: 20, msg: `invalid value "long"`},
+		{input: `published_at > "yesterday"`, pos: 16, msg: `invalid value "yesterday"`},
+		{input: `duration_seconds : 5`, pos: 1, msg: `only supported for text fields`},
+		{input: `tags > "news"`, pos: 1, msg: `not supported for field "tags"`},
+		{input: `language = "ar`, pos: 12, msg: `unterminated string`},
+		{input: `language "ar"`, pos: 10, msg: `expected a comparison operator`},
+		{input: `language =`, pos: 11, msg: `expected a value`},
+		{input: `(language = ar`, pos: 15, msg: `expected ")"`},
+		{input: `language = ar)`, pos: 14, msg: `unexpected ")"`},
+		{input: `language = ar AND`, pos: 18, msg: `expected a field name`},
+		{input: `language ! ar`, pos: 10, msg: `did you mean "!="`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			_, _, err := Translate(tc.input, testSchema, nil)
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrInvalidFilter))
+
+			var filterErr *Error
+			require.True(t, errors.As(err, &filterErr))
+			assert.Equal(t, tc.pos, filterErr.Pos)
+			assert.Contains(t, filterErr.Msg, tc.msg)
+		})
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	terms, err := ParseOrderBy("published_at desc, title", testSchema)
+
+	require.NoError(t, err)
+	require.Len(t, terms, 2)
+	assert.Equal(t, "published_at", terms[0].Name)
+	assert.True(t, terms[0].Desc)
+	assert.Equal(t, "title", terms[1].Name)
+	assert.False(t, terms[1].Desc)
+	assert.Equal(t, "published_at desc, title asc", FormatOrderBy(terms))
+}
+
+func TestParseOrderBy_Errors(t *testing.T) {
+	testCases := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{input: "rating desc", pos: 1, msg: `unknown order_by field "rating"`},
+		{input: "title, rating", pos: 8, msg: `unknown order_by field "rating"`},
+		{input: "title sideways", pos: 7, msg: `expected "asc" or "desc"`},
+		{input: "title, title desc", pos: 8, msg: `duplicate order_by field`},
+		{input: "title,", pos: 7, msg: `empty order_by term`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := ParseOrderBy(tc.input, testSchema)
+
+			var filterErr *Error
+			require.True(t, errors.As(err, &filterErr))
+			assert.Equal(t, tc.pos, filterErr.Pos)
+			assert.Contains(t, filterErr.Msg, tc.msg)
+			assert.ErrorIs(t, err, ErrInvalidFilter)
+		})
+	}
+}
+
+func TestParseValueFormatValue_RoundTrip(t *testing.T) {
+	ts := time.Date(2024, 1, 15, 10, 0, 0, 123456000, time.UTC)
+
+	value, err := ParseValue(TypeTimestamp, FormatValue(ts))
+	require.NoError(t, err)
+	assert.True(t, ts.Equal(value.(time.Time)))
+
+	value, err = ParseValue(TypeInt, FormatValue(int32(1800)))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1800), value)
+}
+
+func TestParseKeysetOrderBy(t *testing.T) {
+	defaults := []OrderTerm{{Name: "published_at", Field: testSchema["published_at"], Desc: true}}
+
+	terms, err := ParseKeysetOrderBy("", testSchema, defaults)
+	require.NoError(t, err)
+	assert.Equal(t, defaults, terms)
+
+	terms, err = ParseKeysetOrderBy("duration_seconds desc, title desc", testSchema, defaults)
+	require.NoError(t, err)
+	assert.Equal(t, "duration_seconds DESC, title DESC, id DESC", KeysetOrderBy(terms))
+
+	_, err = ParseKeysetOrderBy("duration_seconds desc, title", testSchema, defaults)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+	assert.Contains(t, err.Error(), "all fields must use the same direction")
+
+	_, err = ParseKeysetOrderBy("rating", testSchema, defaults)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+}
+
+func TestKeysetCondition(t *testing.T) {
+	terms, err := ParseOrderBy("duration_seconds, title", testSchema)
+	require.NoError(t, err)
+
+	args := []interface{}{"podcast"}
+	condition := KeysetCondition(terms, []interface{}{int64(1800), "Science Friday", "id1"}, &args)
+
+	assert.Equal(t, "(duration_seconds, title, id) > ($2, $3, $4)", condition)
+	assert.Equal(t, []interface{}{"podcast", int64(1800), "Science Friday", "id1"}, args)
+
+	terms[0].Desc, terms[1].Desc = true, true
+	args = nil
+	assert.Equal(t, "(duration_seconds, title, id) < ($1, $2, $3)", KeysetCondition(terms, []interface{}{int64(1800), "Science Friday", "id1"}, &args))
+}
+
+func TestKeysetCursor_RoundTrip(t *testing.T) {
+	terms, err := ParseOrderBy("published_at desc, duration_seconds desc", testSchema)
+	require.NoError(t, err)
+	publishedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	values := map[string]interface{}{"published_at": publishedAt, "duration_seconds": int32(1800)}
+
+	codec := pagination.NewCodec([]byte("test-secret"), time.Hour)
+	token, err := codec.Encode("scope", KeysetCursorKeys(terms, "id1", func(field string) interface{} { return values[field] })...)
+	require.NoError(t, err)
+
+	keys, err := DecodeKeysetCursor(codec, token, "scope", terms)
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+	assert.True(t, publishedAt.Equal(keys[0].(time.Time)))
+	assert.Equal(t, int64(1800), keys[1])
+	assert.Equal(t, "id1", keys[2])
+
+	_, err = DecodeKeysetCursor(codec, token, "other-scope", terms)
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+
+	badToken, err := codec.Encode("scope", "yesterday", "1800", "id1")
+	require.NoError(t, err)
+	_, err = DecodeKeysetCursor(codec, badToken, "scope", terms)
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+}
@@ -0,0 +1,101 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mosaibah/Mawjood/packages/pagination"
+)
+
+// ParseKeysetOrderBy parses an order_by string for a list paged by keyset:
+// rows resume after the sort key of the last row of the previous page, with
+// id as the final tie-breaker. An empty input yields defaults. The sort key is
+// compared as a single row, so all terms must share a direction.
+func ParseKeysetOrderBy(input string, schema Schema, defaults []OrderTerm) ([]OrderTerm, error) {
+	terms, err := ParseOrderBy(input, schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid order_by: %w", err)
+	}
+
+	if len(terms) == 0 {
+		return defaults, nil
+	}
+
+	for _, term := range terms[1:] {
+		if term.Desc != terms[0].Desc {
+			return nil, fmt.Errorf("invalid order_by: %w: all fields must use the same direction", ErrInvalidFilter)
+		}
+	}
+
+	return terms, nil
+}
+
+// KeysetOrderBy renders terms as an ORDER BY list ending with id.
+func KeysetOrderBy(terms []OrderTerm) string {
+	direction := "ASC"
+	if terms[0].Desc {
+		direction = "DESC"
+	}
+
+	parts := make([]string, 0, len(terms)+1)
+	for _, term := range terms {
+		parts = append(parts, term.Field.Expr+" "+direction)
+	}
+	parts = append(parts, "id "+direction)
+
+	return strings.Join(parts, ", ")
+}
+
+// KeysetCondition renders a SQL predicate that resumes after the row whose
+// sort key is keys, as decoded by DecodeKeysetCursor. Keys are appended to
+// args and referenced as $N placeholders following the existing arguments.
+func KeysetCondition(terms []OrderTerm, keys []interface{}, args *[]interface{}) string {
+	columns := make([]string, 0, len(terms)+1)
+	placeholders := make([]string, 0, len(keys))
+	for _, term := range terms {
+		columns = append(columns, term.Field.Expr)
+	}
+	columns = append(columns, "id")
+
+	for _, key := range keys {
+		*args = append(*args, key)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(*args)))
+	}
+
+	comparison := ">"
+	if terms[0].Desc {
+		comparison = "<"
+	}
+
+	return fmt.Sprintf("(%s) %s (%s)", strings.Join(columns, ", "), comparison, strings.Join(placeholders, ", "))
+}
+
+// KeysetCursorKeys returns the page token keys of a row: the value of each
+// term's field, as returned by value, followed by the row's id.
+func KeysetCursorKeys(terms []OrderTerm, id string, value func(field string) interface{}) []string {
+	keys := make([]string, 0, len(terms)+1)
+	for _, term := range terms {
+		keys = append(keys, FormatValue(value(term.Name)))
+	}
+	return append(keys, id)
+}
+
+// DecodeKeysetCursor verifies pageToken against scope and parses its keys
+// back into the types of terms, ready for KeysetCondition.
+func DecodeKeysetCursor(cursors *pagination.Codec, pageToken string, scope string, terms []OrderTerm) ([]interface{}, error) {
+	cursor, err := cursors.Decode(pageToken, scope, len(terms)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]interface{}, 0, len(cursor.Keys))
+	for i, term := range terms {
+		value, err := ParseValue(term.Field.Type, cursor.Keys[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: bad %s", pagination.ErrInvalidToken, term.Name)
+		}
+		keys = append(keys, value)
+	}
+
+	return append(keys, cursor.Keys[len(terms)]), nil
+}
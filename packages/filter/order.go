@@ -0,0 +1,77 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OrderTerm is one field of a parsed order_by string.
+type OrderTerm struct {
+	Name  string
+	Field Field
+	Desc  bool
+}
+
+// ParseOrderBy parses an AIP-132 style order_by string such as
+// "published_at desc, title". Fields must be present in schema. Terms default
+// to ascending order.
+func ParseOrderBy(input string, schema Schema) ([]OrderTerm, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+
+	var terms []OrderTerm
+	seen := map[string]bool{}
+	offset := 0
+
+	for _, part := range strings.Split(input, ",") {
+		pos := offset + len(part) - len(strings.TrimLeft(part, " \t")) + 1
+		offset += len(part) + 1
+
+		words := strings.Fields(part)
+		if len(words) == 0 {
+			return nil, errorf(pos, "empty order_by term")
+		}
+		if len(words) > 2 {
+			return nil, errorf(pos, "expected \"field [asc|desc]\", got %q", strings.TrimSpace(part))
+		}
+
+		name := words[0]
+		field, ok := schema[name]
+		if !ok {
+			return nil, errorf(pos, "unknown order_by field %q", name)
+		}
+		if seen[name] {
+			return nil, errorf(pos, "duplicate order_by field %q", name)
+		}
+		seen[name] = true
+
+		desc := false
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, errorf(pos+len(name)+1, "expected \"asc\" or \"desc\", got %q", words[1])
+			}
+		}
+
+		terms = append(terms, OrderTerm{Name: name, Field: field, Desc: desc})
+	}
+
+	return terms, nil
+}
+
+// FormatOrderBy renders terms back into canonical order_by form.
+func FormatOrderBy(terms []OrderTerm) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		direction := "asc"
+		if term.Desc {
+			direction = "desc"
+		}
+		parts[i] = fmt.Sprintf("%s %s", term.Name, direction)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,362 @@
+// Package filter parses AIP-160 style filter expressions such as
+//
+//	content_type = "podcast" AND language = "ar" AND duration_seconds < 1800
+//
+// and AIP-132 style order_by strings, and translates them into parameterized
+// SQL for the stores. Only fields declared in a Schema can be referenced.
+//
+// Supported syntax:
+//   - comparisons: =, !=, <, <=, >, >= and : (has / contains)
+//   - AND, OR, NOT and the - prefix for negation
+//   - parentheses for grouping
+//   - "double" or 'single' quoted strings, numbers and bare words as values
+//
+// As in AIP-160, OR binds tighter than AND, so a AND b OR c means a AND (b OR c).
+// Juxtaposed terms (a b) are treated as a AND b.
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidFilter is wrapped by every syntax or validation error returned by
+// this package, so callers can map them to InvalidArgument.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Error describes a problem in a filter or order_by string. Pos is the
+// 1-based character position of the offending token.
+type Error struct {
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos)
+}
+
+func (e *Error) Unwrap() error {
+	return ErrInvalidFilter
+}
+
+func errorf(pos int, format string, args ...interface{}) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Expr is a node of a parsed filter expression.
+type Expr interface {
+	isExpr()
+}
+
+// And matches when all of its terms match.
+type And struct {
+	Terms []Expr
+}
+
+// Or matches when any of its terms match.
+type Or struct {
+	Terms []Expr
+}
+
+// Not matches when its operand does not.
+type Not struct {
+	Expr Expr
+}
+
+// Comparison is a single field comparison like duration_seconds < 1800.
+type Comparison struct {
+	Field    string
+	FieldPos int
+	Op       string
+	Value    string
+	ValuePos int
+}
+
+func (And) isExpr()        {}
+func (Or) isExpr()         {}
+func (Not) isExpr()        {}
+func (Comparison) isExpr() {}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenMinus
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) describe() string {
+	if t.kind == tokenEOF {
+		return "end of input"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+func isOperatorRune(r rune) bool {
+	return r == '=' || r == '!' || r == '<' || r == '>' || r == ':'
+}
+
+func isWordRune(r rune) bool {
+	return !unicode.IsSpace(r) && !isOperatorRune(r) && r != '(' && r != ')' && r != '"' && r != '\''
+}
+
+func tokenize(input string) ([]token, error) {
+	runes := []rune(input)
+	var tokens []token
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: pos})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: pos})
+			i++
+
+		case r == '"' || r == '\'':
+			quote := r
+			var sb strings.Builder
+			i++
+			closed := false
+			for i < len(runes) {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					sb.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if runes[i] == quote {
+					closed = true
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+				i++
+			}
+			if !closed {
+				return nil, errorf(pos, "unterminated string")
+			}
+			tokens = append(tokens, token{kind: tokenString, text: sb.String(), pos: pos})
+
+		case isOperatorRune(r):
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' && r != ':' && r != '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, errorf(pos, "unexpected %q, did you mean \"!=\"", "!")
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op, pos: pos})
+			i += len(op)
+
+		case r == '-' && (len(tokens) == 0 || startsTerm(tokens[len(tokens)-1])) && i+1 < len(runes) && !unicode.IsDigit(runes[i+1]):
+			tokens = append(tokens, token{kind: tokenMinus, text: "-", pos: pos})
+			i++
+
+		default:
+			start := i
+			for i < len(runes) && isWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: string(runes[start:i]), pos: pos})
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(runes) + 1})
+	return tokens, nil
+}
+
+// startsTerm reports whether a token following prev begins a new term, which
+// is where a leading - means negation rather than part of a value.
+func startsTerm(prev token) bool {
+	switch prev.kind {
+	case tokenLParen, tokenMinus:
+		return true
+	case tokenWord:
+		return isKeyword(prev.text)
+	case tokenString, tokenRParen:
+		return true
+	}
+	return false
+}
+
+func isKeyword(word string) bool {
+	return word == "AND" || word == "OR" || word == "NOT"
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses a filter expression. An empty or blank input yields a nil Expr.
+func Parse(input string) (Expr, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, nil
+	}
+
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, errorf(tok.pos, "unexpected %s", tok.describe())
+	}
+
+	return expr, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) peekKeyword(keyword string) bool {
+	tok := p.peek()
+	return tok.kind == tokenWord && tok.text == keyword
+}
+
+// expression = sequence { "AND" sequence }
+// sequence   = factor { factor }
+func (p *parser) parseExpression() (Expr, error) {
+	var terms []Expr
+
+	for {
+		factor, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, factor)
+
+		if p.peekKeyword("AND") {
+			p.next()
+			continue
+		}
+
+		tok := p.peek()
+		if tok.kind == tokenEOF || tok.kind == tokenRParen {
+			break
+		}
+		if tok.kind == tokenWord && tok.text == "OR" {
+			return nil, errorf(tok.pos, "unexpected \"OR\"")
+		}
+	}
+
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return And{Terms: terms}, nil
+}
+
+// factor = term { "OR" term }
+func (p *parser) parseFactor() (Expr, error) {
+	var terms []Expr
+
+	for {
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+
+		if !p.peekKeyword("OR") {
+			break
+		}
+		p.next()
+	}
+
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return Or{Terms: terms}, nil
+}
+
+// term = [ "NOT" | "-" ] simple
+func (p *parser) parseTerm() (Expr, error) {
+	tok := p.peek()
+	if tok.kind == tokenMinus || (tok.kind == tokenWord && tok.text == "NOT") {
+		p.next()
+		expr, err := p.parseSimple()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: expr}, nil
+	}
+	return p.parseSimple()
+}
+
+// simple = "(" expression ")" | field comparator value
+func (p *parser) parseSimple() (Expr, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenLParen:
+		expr, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		closing := p.next()
+		if closing.kind != tokenRParen {
+			return nil, errorf(closing.pos, "expected \")\" to close \"(\" at position %d, got %s", tok.pos, closing.describe())
+		}
+		return expr, nil
+
+	case tokenWord:
+		if isKeyword(tok.text) {
+			return nil, errorf(tok.pos, "expected a field name, got %q", tok.text)
+		}
+
+		op := p.next()
+		if op.kind != tokenOperator {
+			return nil, errorf(op.pos, "expected a comparison operator after field %q, got %s", tok.text, op.describe())
+		}
+
+		value := p.next()
+		if value.kind != tokenWord && value.kind != tokenString {
+			return nil, errorf(value.pos, "expected a value after %q, got %s", op.text, value.describe())
+		}
+
+		return Comparison{
+			Field:    tok.text,
+			FieldPos: tok.pos,
+			Op:       op.text,
+			Value:    value.text,
+			ValuePos: value.pos,
+		}, nil
+
+	default:
+		return nil, errorf(tok.pos, "expected a field name, got %s", tok.describe())
+	}
+}
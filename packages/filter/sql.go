@@ -0,0 +1,162 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValueType is the type a field's values are parsed as.
+type ValueType int
+
+const (
+	TypeString ValueType = iota
+	TypeInt
+	TypeTimestamp
+)
+
+// Field maps a public field name onto SQL.
+type Field struct {
+	// Expr is the SQL expression compared against values.
+	Expr string
+	Type ValueType
+	// Membership, when set, marks a repeated field such as tags. It is a SQL
+	// predicate with a single %s placeholder for the bound value, and only the
+	// =, != and : operators are allowed.
+	Membership string
+}
+
+// Schema lists the fields that may be referenced, keyed by public name.
+type Schema map[string]Field
+
+// Translate parses input and renders it as a SQL predicate. Bound values are
+// appended to args and referenced as $N placeholders following the existing
+// arguments. An empty input yields an empty predicate.
+func Translate(input string, schema Schema, args []interface{}) (string, []interface{}, error) {
+	expr, err := Parse(input)
+	if err != nil {
+		return "", args, err
+	}
+	if expr == nil {
+		return "", args, nil
+	}
+	return ToSQL(expr, schema, args)
+}
+
+// ToSQL renders a parsed expression as a SQL predicate, appending bound
+// values to args.
+func ToSQL(expr Expr, schema Schema, args []interface{}) (string, []interface{}, error) {
+	switch e := expr.(type) {
+	case And:
+		return joinSQL(e.Terms, " AND ", schema, args)
+
+	case Or:
+		return joinSQL(e.Terms, " OR ", schema, args)
+
+	case Not:
+		inner, args, err := ToSQL(e.Expr, schema, args)
+		if err != nil {
+			return "", args, err
+		}
+		return "NOT (" + inner + ")", args, nil
+
+	case Comparison:
+		return comparisonSQL(e, schema, args)
+
+	default:
+		return "", args, fmt.Errorf("%w: unsupported expression %T", ErrInvalidFilter, expr)
+	}
+}
+
+func joinSQL(terms []Expr, separator string, schema Schema, args []interface{}) (string, []interface{}, error) {
+	parts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		part, updatedArgs, err := ToSQL(term, schema, args)
+		if err != nil {
+			return "", args, err
+		}
+		args = updatedArgs
+		parts = append(parts, part)
+	}
+	return "(" + strings.Join(parts, separator) + ")", args, nil
+}
+
+func comparisonSQL(c Comparison, schema Schema, args []interface{}) (string, []interface{}, error) {
+	field, ok := schema[c.Field]
+	if !ok {
+		return "", args, errorf(c.FieldPos, "unknown field %q", c.Field)
+	}
+
+	if field.Membership != "" {
+		switch c.Op {
+		case "=", ":":
+			args = append(args, c.Value)
+			return fmt.Sprintf(field.Membership, fmt.Sprintf("$%d", len(args))), args, nil
+		case "!=":
+			args = append(args, c.Value)
+			return "NOT " + fmt.Sprintf(field.Membership, fmt.Sprintf("$%d", len(args))), args, nil
+		default:
+			return "", args, errorf(c.FieldPos, "operator %q is not supported for field %q", c.Op, c.Field)
+		}
+	}
+
+	if c.Op == ":" {
+		if field.Type != TypeString {
+			return "", args, errorf(c.FieldPos, "operator \":\" is only supported for text fields, not %q", c.Field)
+		}
+		args = append(args, "%"+EscapeLike(c.Value)+"%")
+		return fmt.Sprintf("%s ILIKE $%d", field.Expr, len(args)), args, nil
+	}
+
+	value, err := ParseValue(field.Type, c.Value)
+	if err != nil {
+		return "", args, errorf(c.ValuePos, "invalid value %q for field %q: %v", c.Value, c.Field, err)
+	}
+
+	args = append(args, value)
+	return fmt.Sprintf("%s %s $%d", field.Expr, c.Op, len(args)), args, nil
+}
+
+// ParseValue converts text into the Go value bound for a field of type t.
+func ParseValue(t ValueType, text string) (interface{}, error) {
+	switch t {
+	case TypeInt:
+		n, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected an integer")
+		}
+		return n, nil
+	case TypeTimestamp:
+		ts, err := time.Parse(time.RFC3339Nano, text)
+		if err != nil {
+			return nil, fmt.Errorf("expected an RFC 3339 timestamp")
+		}
+		return ts, nil
+	default:
+		return text, nil
+	}
+}
+
+// FormatValue is the inverse of ParseValue, used to round-trip sort keys
+// through page tokens.
+func FormatValue(v interface{}) string {
+	switch value := v.(type) {
+	case time.Time:
+		return value.Format(time.RFC3339Nano)
+	case int32:
+		return strconv.FormatInt(int64(value), 10)
+	case int64:
+		return strconv.FormatInt(value, 10)
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// EscapeLike escapes the LIKE wildcards in value so it matches literally.
+func EscapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
@@ -0,0 +1,190 @@
+// Package highlight locates query terms in result text so clients can show
+// why a search result matched.
+//
+// All offsets are in Unicode code points (runes), not bytes, so they stay
+// meaningful for Arabic and other non-Latin text.
+package highlight
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// PreTag and PostTag wrap each match in Highlight output.
+	PreTag  = "<em>"
+	PostTag = "</em>"
+
+	// Ellipsis marks a snippet that was cut from a longer text.
+	Ellipsis = "…"
+)
+
+// Range is a half-open [Start, End) span of runes.
+type Range struct {
+	Start int
+	End   int
+}
+
+// FindNormalized returns the occurrences of terms in text, sorted and with
+// overlapping or adjacent spans merged. Terms are matched against text as
+// rewritten by normalize, which returns the normalized runes and, for each of
+// them, the index of the rune of text it came from, so terms must already be
+// normalized. Ranges are reported in offsets of the original text.
+func FindNormalized(text string, terms []string, normalize func(string) ([]rune, []int)) []Range {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	haystack, positions := normalize(text)
+	// A match extends up to the next kept rune so that trailing characters
+	// dropped by normalization, such as diacritics, are highlighted with it.
+	positions = append(positions, utf8.RuneCountInString(text))
+
+	var ranges []Range
+	for _, term := range terms {
+		needle := []rune(term)
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(haystack); i++ {
+			if runesEqual(haystack[i:i+len(needle)], needle) {
+				ranges = append(ranges, Range{Start: positions[i], End: positions[i+len(needle)]})
+			}
+		}
+	}
+
+	return merge(ranges)
+}
+
+// Highlight returns text as an HTML-escaped fragment with every range wrapped
+// in PreTag and PostTag.
+func Highlight(text string, ranges []Range) string {
+	runes := []rune(text)
+
+	var b strings.Builder
+	last := 0
+	for _, r := range ranges {
+		b.WriteString(html.EscapeString(string(runes[last:r.Start])))
+		b.WriteString(PreTag)
+		b.WriteString(html.EscapeString(string(runes[r.Start:r.End])))
+		b.WriteString(PostTag)
+		last = r.End
+	}
+	b.WriteString(html.EscapeString(string(runes[last:])))
+
+	return b.String()
+}
+
+// Snippet cuts a window of at most maxRunes runes out of text, centred on the
+// first range and widened to word boundaries where possible. Cut ends are
+// marked with Ellipsis. The returned ranges are those that fall inside the
+// snippet, rebased onto it. Without any ranges the snippet is the start of text.
+func Snippet(text string, ranges []Range, maxRunes int) (string, []Range) {
+	runes := []rune(text)
+	if maxRunes <= 0 || len(runes) <= maxRunes {
+		return text, ranges
+	}
+
+	start := 0
+	if len(ranges) > 0 {
+		first := ranges[0]
+		start = first.Start - (maxRunes-(first.End-first.Start))/2
+		if start < 0 {
+			start = 0
+		}
+	}
+	end := start + maxRunes
+	if end > len(runes) {
+		end = len(runes)
+		start = end - maxRunes
+	}
+
+	// Avoid cutting words in half: move inwards to the nearest space.
+	if start > 0 {
+		if i := indexSpace(runes[start:end]); i >= 0 && (len(ranges) == 0 || start+i < ranges[0].Start) {
+			start += i + 1
+		}
+	}
+	if end < len(runes) {
+		if i := lastIndexSpace(runes[start:end]); i > 0 && (len(ranges) == 0 || start+i >= ranges[0].End) {
+			end = start + i
+		}
+	}
+
+	var b strings.Builder
+	offset := 0
+	if start > 0 {
+		b.WriteString(Ellipsis)
+		offset = len([]rune(Ellipsis))
+	}
+	b.WriteString(string(runes[start:end]))
+	if end < len(runes) {
+		b.WriteString(Ellipsis)
+	}
+
+	var rebased []Range
+	for _, r := range ranges {
+		if r.Start < start || r.End > end {
+			continue
+		}
+		rebased = append(rebased, Range{Start: r.Start - start + offset, End: r.End - start + offset})
+	}
+
+	return b.String(), rebased
+}
+
+func merge(ranges []Range) []Range {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].Start != ranges[j].Start {
+			return ranges[i].Start < ranges[j].Start
+		}
+		return ranges[i].End > ranges[j].End
+	})
+
+	merged := []Range{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End {
+			if r.End > last.End {
+				last.End = r.End
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func indexSpace(runes []rune) int {
+	for i, r := range runes {
+		if unicode.IsSpace(r) {
+			return i
+		}
+	}
+	return -1
+}
+
+func lastIndexSpace(runes []rune) int {
+	for i := len(runes) - 1; i >= 0; i-- {
+		if unicode.IsSpace(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,95 @@
+package highlight
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// lowerMap normalizes text by lower-casing it, keeping every rune.
+func lowerMap(text string) ([]rune, []int) {
+	runes := []rune(strings.ToLower(text))
+	positions := make([]int, len(runes))
+	for i := range runes {
+		positions[i] = i
+	}
+	return runes, positions
+}
+
+func TestFindNormalized_MergesOverlappingMatches(t *testing.T) {
+	ranges := FindNormalized("The Blue Planet and planets", []string{"planet", "lane", "blue"}, lowerMap)
+
+	assert.Equal(t, []Range{{Start: 4, End: 8}, {Start: 9, End: 15}, {Start: 20, End: 26}}, ranges)
+}
+
+func TestFindNormalized_RuneOffsets(t *testing.T) {
+	text := "بودكاست عن التاريخ"
+
+	ranges := FindNormalized(text, []string{"التاريخ"}, lowerMap)
+
+	assert.Equal(t, []Range{{Start: 11, End: 18}}, ranges)
+	assert.Equal(t, "التاريخ", string([]rune(text)[11:18]))
+}
+
+func TestHighlight_EscapesHTML(t *testing.T) {
+	text := "Tom & Jerry <Live>"
+
+	assert.Equal(t, "<em>Tom</em> &amp; Jerry &lt;<em>Live</em>&gt;", Highlight(text, FindNormalized(text, []string{"tom", "live"}, lowerMap)))
+	assert.Equal(t, "Tom &amp; Jerry &lt;Live&gt;", Highlight(text, nil))
+}
+
+func TestSnippet_ShortTextIsUnchanged(t *testing.T) {
+	ranges := []Range{{Start: 2, End: 5}}
+
+	snippet, rebased := Snippet("a short text", ranges, 50)
+
+	assert.Equal(t, "a short text", snippet)
+	assert.Equal(t, ranges, rebased)
+}
+
+func TestSnippet_CentresOnFirstMatch(t *testing.T) {
+	text := strings.Repeat("lorem ipsum ", 20) + "the hidden brain " + strings.Repeat("dolor sit ", 20)
+	ranges := FindNormalized(text, []string{"brain"}, lowerMap)
+
+	snippet, rebased := Snippet(text, ranges, 40)
+
+	assert.True(t, strings.HasPrefix(snippet, Ellipsis))
+	assert.True(t, strings.HasSuffix(snippet, Ellipsis))
+	assert.LessOrEqual(t, len([]rune(snippet)), 40+2*len([]rune(Ellipsis)))
+	if assert.Len(t, rebased, 1) {
+		assert.Equal(t, "brain", string([]rune(snippet)[rebased[0].Start:rebased[0].End]))
+	}
+}
+
+func TestSnippet_WithoutMatchesUsesStart(t *testing.T) {
+	snippet, rebased := Snippet("first second third fourth", nil, 14)
+
+	assert.Equal(t, "first second"+Ellipsis, snippet)
+	assert.Empty(t, rebased)
+}
+
+func TestFindNormalized_MapsOffsetsBackToOriginal(t *testing.T) {
+	// Drops the combining fatha (U+064E) and folds alef with hamza to a bare alef.
+	normalize := func(text string) ([]rune, []int) {
+		var runes []rune
+		var positions []int
+		for i, r := range []rune(text) {
+			switch r {
+			case 'َ':
+				continue
+			case 'أ':
+				r = 'ا'
+			}
+			runes = append(runes, r)
+			positions = append(positions, i)
+		}
+		return runes, positions
+	}
+	text := "عن أَحمدَ"
+
+	ranges := FindNormalized(text, []string{"احمد"}, normalize)
+
+	assert.Equal(t, []Range{{Start: 3, End: 9}}, ranges)
+	assert.Equal(t, "أَحمدَ", string([]rune(text)[3:9]))
+}
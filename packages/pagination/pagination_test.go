@@ -0,0 +1,116 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	codec := NewCodec([]byte("test-secret"), time.Hour)
+
+	token, err := codec.Encode("search", "0.42", "2024-01-15T10:00:00Z", "id1")
+	require.NoError(t, err)
+	assert.NotContains(t, token, "id1")
+
+	cursor, err := codec.Decode(token, "search", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0.42", "2024-01-15T10:00:00Z", "id1"}, cursor.Keys)
+}
+
+func TestDecode_TamperedPayload(t *testing.T) {
+	codec := NewCodec([]byte("test-secret"), time.Hour)
+
+	token, err := codec.Encode("list", "2024-01-15T10:00:00Z", "id1")
+	require.NoError(t, err)
+
+	body, signature, _ := strings.Cut(token, ".")
+	decoded, err := base64.RawURLEncoding.DecodeString(body)
+	require.NoError(t, err)
+	tampered := strings.Replace(string(decoded), "id1", "id2", 1)
+	forged := base64.RawURLEncoding.EncodeToString([]byte(tampered)) + "." + signature
+
+	_, err = codec.Decode(forged, "list", 2)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestDecode_DifferentSecret(t *testing.T) {
+	token, err := NewCodec([]byte("secret-a"), time.Hour).Encode("list", "a", "b")
+	require.NoError(t, err)
+
+	_, err = NewCodec([]byte("secret-b"), time.Hour).Decode(token, "list", 2)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestDecode_Malformed(t *testing.T) {
+	codec := NewCodec([]byte("test-secret"), time.Hour)
+
+	for _, token := range []string{"", "550e8400-e29b-41d4-a716-446655440000", "abc.def", "!!!.???"} {
+		_, err := codec.Decode(token, "list", 2)
+		assert.ErrorIs(t, err, ErrInvalidToken, "token %q", token)
+	}
+}
+
+func TestDecode_WrongScope(t *testing.T) {
+	codec := NewCodec([]byte("test-secret"), time.Hour)
+
+	token, err := codec.Encode(Scope("search", "podcast"), "0.5", "2024-01-15T10:00:00Z", "id1")
+	require.NoError(t, err)
+
+	_, err = codec.Decode(token, Scope("search", "documentary"), 3)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestDecode_WrongKeyCount(t *testing.T) {
+	codec := NewCodec([]byte("test-secret"), time.Hour)
+
+	token, err := codec.Encode("list", "2024-01-15T10:00:00Z", "id1")
+	require.NoError(t, err)
+
+	_, err = codec.Decode(token, "list", 3)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestDecode_Expired(t *testing.T) {
+	codec := NewCodec([]byte("test-secret"), time.Hour)
+	issuedAt := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	codec.now = func() time.Time { return issuedAt }
+
+	token, err := codec.Encode("list", "2024-01-15T10:00:00Z", "id1")
+	require.NoError(t, err)
+
+	codec.now = func() time.Time { return issuedAt.Add(2 * time.Hour) }
+
+	_, err = codec.Decode(token, "list", 2)
+	assert.True(t, errors.Is(err, ErrExpiredToken))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestScope_DistinguishesPartBoundaries(t *testing.T) {
+	assert.Equal(t, Scope("search", "podcast"), Scope("search", "podcast"))
+	assert.NotEqual(t, Scope("ab", "c"), Scope("a", "bc"))
+}
+
+func TestEncodePage_PageOf(t *testing.T) {
+	codec := NewCodec([]byte("test-secret"), time.Hour)
+
+	token, err := codec.EncodePage("search", 3, "0.42", "id1")
+	require.NoError(t, err)
+
+	cursor, err := codec.Decode(token, "search", 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, cursor.Page)
+	assert.Equal(t, 3, PageOf(token))
+
+	legacy, err := codec.Encode("search", "0.42", "id1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, PageOf(legacy))
+
+	assert.Equal(t, 1, PageOf(""))
+	assert.Equal(t, 0, PageOf("!!!.???"))
+}
@@ -0,0 +1,125 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// maxBodyBytes caps how much of a response Get reads, so a misbehaving server
+// cannot exhaust memory.
+const maxBodyBytes = 5 << 20
+
+// userAgent identifies requests from the importer to the platforms.
+const userAgent = "Mawjood/1.0 (+https://github.com/mosaibah/Mawjood)"
+
+// maxRedirects is how many redirects DefaultClient follows.
+const maxRedirects = 10
+
+// DefaultClient is the HTTP client providers use when none is given. The
+// links it fetches come from editors, so it refuses to connect to addresses
+// on the server's own network, checking each address it dials after DNS
+// resolution and each redirect it follows. It ignores proxy settings, which
+// would otherwise dial the proxy in place of the checked address.
+var DefaultClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 5 * time.Second,
+			Control: checkDial,
+		}).DialContext,
+		ForceAttemptHTTP2:   true,
+		TLSHandshakeTimeout: 5 * time.Second,
+		IdleConnTimeout:     90 * time.Second,
+	},
+	CheckRedirect: checkRedirect,
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which some clouds serve
+// their metadata endpoints from.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// thisNetwork is 0.0.0.0/8, which most systems route to the local host.
+var thisNetwork = netip.MustParsePrefix("0.0.0.0/8")
+
+// allowedAddr reports whether DefaultClient may connect to addr: it must not
+// be a loopback, private, link-local, multicast or unspecified address.
+func allowedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!sharedAddressSpace.Contains(addr) &&
+		!thisNetwork.Contains(addr)
+}
+
+// checkDial rejects connections to addresses allowedAddr refuses. It runs
+// after DNS resolution, so host names that resolve to such addresses are
+// refused too.
+func checkDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !allowedAddr(addr) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+	}
+	return nil
+}
+
+// checkRedirect stops redirects to other schemes and to addresses allowedAddr
+// refuses. Redirects to host names are checked when they are dialed.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("%w: redirect to %q", ErrInvalidURL, req.URL.String())
+	}
+	if addr, err := netip.ParseAddr(req.URL.Hostname()); err == nil && !allowedAddr(addr) {
+		return fmt.Errorf("%w: redirect to %s", ErrForbiddenAddress, addr)
+	}
+	return nil
+}
+
+// Get fetches rawURL with client and returns the response body. Responses
+// with status 404 or 410 fail with ErrNotFound, and any other non-2xx status
+// with an error naming it. Requests DefaultClient refuses fail with
+// ErrForbiddenAddress.
+func Get(ctx context.Context, client *http.Client, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return nil, fmt.Errorf("%w: %s returned %s", ErrNotFound, rawURL, resp.Status)
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return nil, fmt.Errorf("failed to fetch %s: %s", rawURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", rawURL, err)
+	}
+	return body, nil
+}
@@ -0,0 +1,108 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			w.Write([]byte("hello"))
+		case "/gone":
+			w.WriteHeader(http.StatusGone)
+		case "/large":
+			w.Write([]byte(strings.Repeat("a", maxBodyBytes+10)))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	body, err := Get(context.Background(), server.Client(), server.URL+"/ok")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(body))
+
+	_, err = Get(context.Background(), server.Client(), server.URL+"/gone")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = Get(context.Background(), server.Client(), server.URL+"/broken")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "502")
+
+	body, err = Get(context.Background(), server.Client(), server.URL+"/large")
+	require.NoError(t, err)
+	assert.Len(t, body, maxBodyBytes)
+}
+
+func TestGet_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := Get(ctx, server.Client(), server.URL)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDefaultClient_RejectsInternalAddresses(t *testing.T) {
+	for _, rawURL := range []string{
+		"http://127.0.0.1/",
+		"http://localhost:8080/admin",
+		"http://10.0.0.5/",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://[::1]/",
+		"http://0.0.0.0/",
+	} {
+		_, err := Get(context.Background(), DefaultClient, rawURL)
+		assert.ErrorIs(t, err, ErrForbiddenAddress, rawURL)
+	}
+}
+
+func TestAllowedAddr(t *testing.T) {
+	allowed := map[string]bool{
+		"93.184.216.34":      true,
+		"2606:4700::1111":    true,
+		"127.0.0.1":          false,
+		"10.1.2.3":           false,
+		"172.16.0.1":         false,
+		"192.168.1.1":        false,
+		"169.254.169.254":    false,
+		"100.100.100.200":    false,
+		"0.0.0.0":            false,
+		"::":                 false,
+		"::1":                false,
+		"fe80::1":            false,
+		"fd00::1":            false,
+		"::ffff:127.0.0.1":   false,
+		"::ffff:192.168.0.1": false,
+	}
+	for addr, want := range allowed {
+		assert.Equal(t, want, allowedAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestCheckRedirect(t *testing.T) {
+	redirect := func(rawURL string) error {
+		req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+		require.NoError(t, err)
+		return checkRedirect(req, []*http.Request{req})
+	}
+
+	assert.NoError(t, redirect("https://example.com/episodes/1"))
+	assert.ErrorIs(t, redirect("http://169.254.169.254/latest/meta-data/"), ErrForbiddenAddress)
+	assert.ErrorIs(t, redirect("http://[::1]:9003/"), ErrForbiddenAddress)
+	assert.ErrorIs(t, redirect("file:///etc/passwd"), ErrInvalidURL)
+}
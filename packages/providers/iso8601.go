@@ -0,0 +1,59 @@
+package providers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:\.\d+)?S)?)?$`)
+
+// ParseISODuration parses an ISO 8601 duration such as "PT1H2M3S", as used by
+// schema.org and the platforms' page metadata, into seconds. Years, months
+// and weeks are not supported. Durations that cannot be parsed or exceed
+// MaxDurationSeconds are 0.
+func ParseISODuration(s string) int32 {
+	m := isoDurationPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if m == nil {
+		return 0
+	}
+
+	var seconds int
+	for i, unit := range []int{24 * 60 * 60, 60 * 60, 60, 1} {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil || n > MaxDurationSeconds {
+			return 0
+		}
+		seconds += n * unit
+	}
+	if seconds > MaxDurationSeconds {
+		return 0
+	}
+	return int32(seconds)
+}
+
+// isoDateLayouts are the ISO 8601 forms pages use for dates: with an offset,
+// without one, or without a time.
+var isoDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// ParseISODate parses an ISO 8601 date such as "2024-03-01T08:00:00-08:00"
+// into UTC. Dates without an offset are taken to be UTC. Dates that cannot be
+// parsed are zero.
+func ParseISODate(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range isoDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
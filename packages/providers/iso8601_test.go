@@ -0,0 +1,41 @@
+package providers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseISODuration(t *testing.T) {
+	cases := map[string]int32{
+		"PT1H2M3S":  3723,
+		"PT45M":     2700,
+		"PT90S":     90,
+		"PT1M30.5S": 90,
+		"P1D":       86400,
+		"pt10m":     600,
+		"P1DT1S":    0,
+		"PT":        0,
+		"P1Y":       0,
+		"1:02:03":   0,
+		"":          0,
+	}
+	for input, expected := range cases {
+		assert.Equal(t, expected, ParseISODuration(input), input)
+	}
+}
+
+func TestParseISODate(t *testing.T) {
+	cases := map[string]time.Time{
+		"2024-03-01T08:00:00-08:00": time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC),
+		"2024-03-01T08:00:00Z":      time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+		"2024-03-01T08:00:00":       time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC),
+		" 2024-03-01 ":              time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		"March 1, 2024":             {},
+		"":                          {},
+	}
+	for input, expected := range cases {
+		assert.Equal(t, expected, ParseISODate(input), input)
+	}
+}
@@ -0,0 +1,99 @@
+package providers
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Page is the metadata an HTML page declares about itself: its <title>, lang
+// attribute, <meta> tags and JSON-LD scripts.
+type Page struct {
+	Title    string
+	Language string
+	// JSONLD holds the bodies of the page's application/ld+json scripts.
+	JSONLD []string
+
+	meta map[string][]string
+}
+
+// ParsePage parses an HTML document. Meta tags are read from the whole
+// document, as some pages declare microdata itemprops in the body.
+func ParsePage(data []byte) (*Page, error) {
+	root, err := html.Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Page{meta: map[string][]string{}}
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "html":
+				p.Language = strings.TrimSpace(attr(n, "lang"))
+			case "title":
+				if p.Title == "" {
+					p.Title = strings.TrimSpace(text(n))
+				}
+			case "meta":
+				content := strings.TrimSpace(attr(n, "content"))
+				for _, key := range []string{"property", "name", "itemprop"} {
+					if name := strings.ToLower(strings.TrimSpace(attr(n, key))); name != "" && content != "" {
+						p.meta[name] = append(p.meta[name], content)
+					}
+				}
+			case "script":
+				if strings.EqualFold(strings.TrimSpace(attr(n, "type")), "application/ld+json") {
+					p.JSONLD = append(p.JSONLD, text(n))
+				}
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(root)
+	return p, nil
+}
+
+// Meta returns the content of the first meta tag with the given property,
+// name or itemprop, or "" if there is none. Names are case insensitive.
+func (p *Page) Meta(name string) string {
+	if values := p.meta[strings.ToLower(name)]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// MetaAll returns the contents of every meta tag with the given property,
+// name or itemprop, in document order.
+func (p *Page) MetaAll(name string) []string {
+	return p.meta[strings.ToLower(name)]
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// text returns the concatenated text nodes under n.
+func text(n *html.Node) string {
+	var b strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(n)
+	return b.String()
+}
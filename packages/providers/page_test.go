@@ -0,0 +1,28 @@
+package providers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePage(t *testing.T) {
+	page, err := ParsePage([]byte(`<html lang="ar"><head>
+  <title> Episode 1 </title>
+  <meta property="og:title" content="Episode One">
+  <meta property="og:video:tag" content="history">
+  <meta property="OG:video:tag" content="culture">
+  <meta name="description" content="">
+  <script type="application/ld+json">{"@type":"PodcastEpisode"}</script>
+</head><body><span itemprop="duration" content="PT30M"></span><meta itemprop="duration" content="PT45M"></body></html>`))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Episode 1", page.Title)
+	assert.Equal(t, "ar", page.Language)
+	assert.Equal(t, "Episode One", page.Meta("og:title"))
+	assert.Equal(t, []string{"history", "culture"}, page.MetaAll("og:video:tag"))
+	assert.Empty(t, page.Meta("description"))
+	assert.Equal(t, "PT45M", page.Meta("duration"))
+	assert.Equal(t, []string{`{"@type":"PodcastEpisode"}`}, page.JSONLD)
+}
@@ -0,0 +1,326 @@
+package podcast
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+)
+
+// Unqualified field tags match an element in any namespace, so fields for
+// namespaced elements such as itunes:title come first: encoding/xml fills the
+// first field that matches.
+
+type rssFeed struct {
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	ITunesCategories []itunesCategory `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category"`
+	ITunesKeywords   string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd keywords"`
+	Title            string           `xml:"title"`
+	Language         string           `xml:"language"`
+	Categories       []string         `xml:"category"`
+	Items            []rssItem        `xml:"item"`
+}
+
+type rssItem struct {
+	ITunesTitle      string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd title"`
+	ITunesSummary    string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
+	ITunesDuration   string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration"`
+	ITunesKeywords   string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd keywords"`
+	ITunesCategories []itunesCategory `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category"`
+	ContentEncoded   string           `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Title            string           `xml:"title"`
+	Description      string           `xml:"description"`
+	Link             string           `xml:"link"`
+	GUID             string           `xml:"guid"`
+	PubDate          string           `xml:"pubDate"`
+	Categories       []string         `xml:"category"`
+	Enclosure        struct {
+		URL string `xml:"url,attr"`
+	} `xml:"enclosure"`
+}
+
+type itunesCategory struct {
+	Text          string           `xml:"text,attr"`
+	Subcategories []itunesCategory `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category"`
+}
+
+type atomFeed struct {
+	ITunesCategories []itunesCategory `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category"`
+	ITunesKeywords   string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd keywords"`
+	Title            string           `xml:"title"`
+	Lang             string           `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Categories       []atomCategory   `xml:"category"`
+	Entries          []atomEntry      `xml:"entry"`
+}
+
+type atomEntry struct {
+	ITunesTitle      string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd title"`
+	ITunesSummary    string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
+	ITunesDuration   string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration"`
+	ITunesKeywords   string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd keywords"`
+	ITunesCategories []itunesCategory `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd category"`
+	Title            string           `xml:"title"`
+	ID               string           `xml:"id"`
+	Summary          string           `xml:"summary"`
+	Content          string           `xml:"content"`
+	Published        string           `xml:"published"`
+	Updated          string           `xml:"updated"`
+	Links            []atomLink       `xml:"link"`
+	Categories       []atomCategory   `xml:"category"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// feed is an RSS or Atom feed reduced to what imports need.
+type feed struct {
+	title    string
+	language string
+	tags     []string
+	items    []item
+}
+
+// item is one episode of a feed.
+type item struct {
+	guid            string
+	title           string
+	description     string
+	link            string
+	enclosureURL    string
+	durationSeconds int32
+	publishedAt     time.Time
+	tags            []string
+}
+
+var errNotFeed = fmt.Errorf("%w: not an RSS or Atom document", providers.ErrNotFeed)
+
+// parseFeed parses an RSS 2.0 or Atom document.
+func parseFeed(data []byte) (*feed, error) {
+	root, err := rootElement(data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch root.Local {
+	case "rss":
+		var doc rssFeed
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse RSS feed: %w", err)
+		}
+		return fromRSS(&doc.Channel), nil
+	case "feed":
+		var doc atomFeed
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse Atom feed: %w", err)
+		}
+		return fromAtom(&doc), nil
+	default:
+		return nil, fmt.Errorf("%w: root element is <%s>", errNotFeed, root.Local)
+	}
+}
+
+// rootElement returns the name of the first element of data.
+func rootElement(data []byte) (xml.Name, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return xml.Name{}, errNotFeed
+		}
+		if err != nil {
+			return xml.Name{}, fmt.Errorf("%w: %v", errNotFeed, err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name, nil
+		}
+	}
+}
+
+func fromRSS(channel *rssChannel) *feed {
+	f := &feed{
+		title:    strings.TrimSpace(channel.Title),
+		language: channel.Language,
+		tags:     mergeTags(splitKeywords(channel.ITunesKeywords), categoryTexts(channel.ITunesCategories), channel.Categories),
+	}
+	for _, it := range channel.Items {
+		f.items = append(f.items, item{
+			guid:            strings.TrimSpace(it.GUID),
+			title:           firstNonEmpty(it.Title, it.ITunesTitle),
+			description:     plainText(firstNonEmpty(it.ITunesSummary, it.Description, it.ContentEncoded)),
+			link:            strings.TrimSpace(it.Link),
+			enclosureURL:    strings.TrimSpace(it.Enclosure.URL),
+			durationSeconds: parseDuration(it.ITunesDuration),
+			publishedAt:     parseDate(it.PubDate),
+			tags:            mergeTags(splitKeywords(it.ITunesKeywords), categoryTexts(it.ITunesCategories), it.Categories),
+		})
+	}
+	return f
+}
+
+func fromAtom(doc *atomFeed) *feed {
+	f := &feed{
+		title:    strings.TrimSpace(doc.Title),
+		language: doc.Lang,
+		tags:     mergeTags(splitKeywords(doc.ITunesKeywords), categoryTexts(doc.ITunesCategories), atomTerms(doc.Categories)),
+	}
+	for _, entry := range doc.Entries {
+		it := item{
+			guid:            strings.TrimSpace(entry.ID),
+			title:           firstNonEmpty(entry.Title, entry.ITunesTitle),
+			description:     plainText(firstNonEmpty(entry.ITunesSummary, entry.Summary, entry.Content)),
+			durationSeconds: parseDuration(entry.ITunesDuration),
+			publishedAt:     parseDate(firstNonEmpty(entry.Published, entry.Updated)),
+			tags:            mergeTags(splitKeywords(entry.ITunesKeywords), categoryTexts(entry.ITunesCategories), atomTerms(entry.Categories)),
+		}
+		for _, link := range entry.Links {
+			switch link.Rel {
+			case "", "alternate":
+				if it.link == "" {
+					it.link = strings.TrimSpace(link.Href)
+				}
+			case "enclosure":
+				if it.enclosureURL == "" {
+					it.enclosureURL = strings.TrimSpace(link.Href)
+				}
+			}
+		}
+		f.items = append(f.items, it)
+	}
+	return f
+}
+
+// parseDuration parses an itunes:duration, which is either a number of
+// seconds or [[HH:]MM:]SS. Durations that cannot be parsed are 0.
+func parseDuration(s string) int32 {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0
+	}
+	var seconds int
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || n > providers.MaxDurationSeconds {
+			return 0
+		}
+		seconds = seconds*60 + n
+	}
+	if seconds > providers.MaxDurationSeconds {
+		return 0
+	}
+	return int32(seconds)
+}
+
+// dateLayouts are the pubDate and Atom date formats seen in the wild. RFC 822
+// dates come with and without the weekday and with one or two digit days.
+var dateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"Mon, 2 Jan 2006 15:04 -0700",
+	"2 Jan 2006 15:04:05 -0700",
+	"2 Jan 2006 15:04:05 MST",
+	time.RFC3339,
+	"2006-01-02",
+}
+
+// parseDate parses a feed date into UTC. Dates that cannot be parsed are
+// zero.
+func parseDate(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
+
+func splitKeywords(keywords string) []string {
+	return strings.Split(keywords, ",")
+}
+
+// categoryTexts flattens nested iTunes categories, parents first.
+func categoryTexts(categories []itunesCategory) []string {
+	var texts []string
+	for _, category := range categories {
+		texts = append(texts, category.Text)
+		texts = append(texts, categoryTexts(category.Subcategories)...)
+	}
+	return texts
+}
+
+func atomTerms(categories []atomCategory) []string {
+	terms := make([]string, len(categories))
+	for i, category := range categories {
+		terms[i] = category.Term
+	}
+	return terms
+}
+
+// mergeTags returns the trimmed, non-empty tags of all lists with duplicates
+// removed regardless of case, keeping the first spelling.
+func mergeTags(lists ...[]string) []string {
+	var tags []string
+	seen := map[string]bool{}
+	for _, list := range lists {
+		for _, tag := range list {
+			tag = strings.TrimSpace(tag)
+			key := strings.ToLower(tag)
+			if tag == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// plainText strips the HTML markup feeds commonly put in descriptions and
+// collapses whitespace.
+func plainText(s string) string {
+	var b strings.Builder
+	inTag := false
+	for _, r := range s {
+		switch {
+		case r == '<':
+			inTag = true
+			b.WriteRune(' ')
+		case r == '>' && inTag:
+			inTag = false
+		case !inTag:
+			b.WriteRune(r)
+		}
+	}
+	return strings.Join(strings.Fields(html.UnescapeString(b.String())), " ")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
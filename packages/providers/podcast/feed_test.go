@@ -0,0 +1,139 @@
+package podcast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+)
+
+const rssFeedXML = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:content="http://purl.org/rss/1.0/modules/content/">
+  <channel>
+    <title>Hidden Brain</title>
+    <language>en-us</language>
+    <itunes:keywords>psychology, science</itunes:keywords>
+    <itunes:category text="Science">
+      <itunes:category text="Social Sciences"/>
+    </itunes:category>
+    <item>
+      <title>Older Episode</title>
+      <itunes:title>Older Episode (iTunes)</itunes:title>
+      <description><![CDATA[<p>Why we <b>remember</b> &amp; forget.</p>]]></description>
+      <link>https://example.com/episodes/1</link>
+      <guid isPermaLink="false">episode-1</guid>
+      <pubDate>Mon, 8 Jan 2024 09:00:00 GMT</pubDate>
+      <enclosure url="https://cdn.example.com/1.mp3" length="123" type="audio/mpeg"/>
+      <itunes:duration>45:30</itunes:duration>
+    </item>
+    <item>
+      <title>Newer Episode</title>
+      <itunes:summary>The unconscious patterns that drive us.</itunes:summary>
+      <guid>episode-2</guid>
+      <pubDate>Wed, 10 Jan 2024 09:00:00 +0000</pubDate>
+      <enclosure url="https://cdn.example.com/2.mp3" length="456" type="audio/mpeg"/>
+      <itunes:duration>3723</itunes:duration>
+      <itunes:keywords>Behavior, Science</itunes:keywords>
+      <category>Habits</category>
+    </item>
+  </channel>
+</rss>`
+
+const atomFeedXML = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xml:lang="ar">
+  <title>Thmanyah</title>
+  <category term="Culture"/>
+  <entry>
+    <title>Episode One</title>
+    <id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+    <link rel="alternate" href="https://example.com/atom/1"/>
+    <link rel="enclosure" href="https://cdn.example.com/atom/1.mp3" type="audio/mpeg"/>
+    <published>2024-01-15T10:00:00+03:00</published>
+    <summary>First episode.</summary>
+    <itunes:duration>01:00:00</itunes:duration>
+    <category term="History"/>
+  </entry>
+</feed>`
+
+func TestParseFeed_RSS(t *testing.T) {
+	f, err := parseFeed([]byte(rssFeedXML))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Hidden Brain", f.title)
+	assert.Equal(t, "en-us", f.language)
+	assert.Equal(t, []string{"psychology", "science", "Social Sciences"}, f.tags)
+	require.Len(t, f.items, 2)
+
+	older := f.items[0]
+	assert.Equal(t, "Older Episode", older.title)
+	assert.Equal(t, "Why we remember & forget.", older.description)
+	assert.Equal(t, "https://example.com/episodes/1", older.link)
+	assert.Equal(t, "episode-1", older.guid)
+	assert.Equal(t, "https://cdn.example.com/1.mp3", older.enclosureURL)
+	assert.Equal(t, int32(45*60+30), older.durationSeconds)
+	assert.Equal(t, time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC), older.publishedAt)
+
+	newer := f.items[1]
+	assert.Equal(t, "The unconscious patterns that drive us.", newer.description)
+	assert.Equal(t, int32(3723), newer.durationSeconds)
+	assert.Equal(t, []string{"Behavior", "Science", "Habits"}, newer.tags)
+}
+
+func TestParseFeed_Atom(t *testing.T) {
+	f, err := parseFeed([]byte(atomFeedXML))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Thmanyah", f.title)
+	assert.Equal(t, "ar", f.language)
+	assert.Equal(t, []string{"Culture"}, f.tags)
+	require.Len(t, f.items, 1)
+
+	entry := f.items[0]
+	assert.Equal(t, "Episode One", entry.title)
+	assert.Equal(t, "https://example.com/atom/1", entry.link)
+	assert.Equal(t, "https://cdn.example.com/atom/1.mp3", entry.enclosureURL)
+	assert.Equal(t, int32(3600), entry.durationSeconds)
+	assert.Equal(t, time.Date(2024, 1, 15, 7, 0, 0, 0, time.UTC), entry.publishedAt)
+	assert.Equal(t, []string{"History"}, entry.tags)
+}
+
+func TestParseFeed_NotAFeed(t *testing.T) {
+	for _, doc := range []string{"", "<html><body>hi</body></html>", "not xml at all <"} {
+		_, err := parseFeed([]byte(doc))
+		assert.ErrorIs(t, err, providers.ErrNotFeed, doc)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]int32{
+		"":         0,
+		"90":       90,
+		"01:30":    90,
+		"1:02:03":  3723,
+		" 45:00 ":  2700,
+		"1:2:3:4":  0,
+		"abc":      0,
+		"-5":       0,
+		"99:00:00": 0,
+		"NaN":      0,
+	}
+	for input, expected := range cases {
+		assert.Equal(t, expected, parseDuration(input), input)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	expected := time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)
+	for _, input := range []string{
+		"Mon, 08 Jan 2024 09:00:00 +0000",
+		"Mon, 8 Jan 2024 09:00:00 GMT",
+		"8 Jan 2024 11:00:00 +0200",
+		"2024-01-08T09:00:00Z",
+	} {
+		assert.Equal(t, expected, parseDate(input), input)
+	}
+	assert.True(t, parseDate("last tuesday").IsZero())
+}
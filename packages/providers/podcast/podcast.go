@@ -0,0 +1,170 @@
+// Package podcast resolves links to podcast RSS 2.0 and Atom feeds, including
+// the iTunes tags most podcast hosts add, into content metadata.
+//
+// A feed URL on its own resolves to the newest episode. A fragment selects a
+// specific one by guid, link, enclosure URL or title, for example
+// https://feeds.example.com/show.xml#episode-42. FetchAll returns every
+// episode.
+package podcast
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+)
+
+// Provider resolves podcast feed URLs.
+type Provider struct {
+	client *http.Client
+}
+
+// Option configures a Provider created by New.
+type Option func(*Provider)
+
+// WithHTTPClient fetches feeds with client instead of
+// providers.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(p *Provider) {
+		p.client = client
+	}
+}
+
+func New(opts ...Option) *Provider {
+	p := &Provider{client: providers.DefaultClient}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (p *Provider) Name() string {
+	return "podcast"
+}
+
+// Match reports whether u looks like a feed: served from a feeds., feed. or
+// rss. host, with an .xml, .rss or .atom extension, or ending in /rss, /feed
+// or /atom, as the common podcast hosts' feed URLs do.
+func (p *Provider) Match(u *url.URL) bool {
+	for _, prefix := range []string{"feeds.", "feed.", "rss."} {
+		if strings.HasPrefix(u.Host, prefix) {
+			return true
+		}
+	}
+
+	switch strings.ToLower(path.Ext(u.Path)) {
+	case ".xml", ".rss", ".atom":
+		return true
+	}
+	switch strings.ToLower(path.Base(u.Path)) {
+	case "rss", "feed", "atom":
+		return true
+	}
+	return false
+}
+
+// Fetch returns the episode selected by the fragment of u, or the newest one.
+func (p *Provider) Fetch(ctx context.Context, u *url.URL) (*providers.Metadata, error) {
+	f, feedURL, err := p.fetchFeed(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if len(f.items) == 0 {
+		return nil, fmt.Errorf("%w: feed has no episodes", providers.ErrNotFound)
+	}
+
+	items := newestFirst(f.items)
+	if u.Fragment == "" {
+		return f.metadata(&items[0], feedURL), nil
+	}
+	for i := range items {
+		if items[i].selectedBy(u.Fragment) {
+			return f.metadata(&items[i], feedURL), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no episode %q in feed", providers.ErrNotFound, u.Fragment)
+}
+
+// FetchAll returns every episode of the feed, newest first.
+func (p *Provider) FetchAll(ctx context.Context, u *url.URL) ([]providers.Metadata, error) {
+	f, feedURL, err := p.fetchFeed(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	items := newestFirst(f.items)
+	metadata := make([]providers.Metadata, len(items))
+	for i := range items {
+		metadata[i] = *f.metadata(&items[i], feedURL)
+	}
+	return metadata, nil
+}
+
+// fetchFeed fetches and parses the feed at u, and returns it with its URL.
+func (p *Provider) fetchFeed(ctx context.Context, u *url.URL) (*feed, string, error) {
+	feedURL := *u
+	feedURL.Fragment = ""
+
+	body, err := providers.Get(ctx, p.client, feedURL.String())
+	if err != nil {
+		return nil, "", err
+	}
+	f, err := parseFeed(body)
+	if err != nil {
+		return nil, "", err
+	}
+	return f, feedURL.String(), nil
+}
+
+// newestFirst returns items sorted by publish date, newest first, keeping the
+// feed order for items without one.
+func newestFirst(items []item) []item {
+	sorted := append([]item(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].publishedAt.After(sorted[j].publishedAt)
+	})
+	return sorted
+}
+
+// selectedBy reports whether a fragment selects the item.
+func (it *item) selectedBy(fragment string) bool {
+	for _, id := range []string{it.guid, it.link, it.enclosureURL} {
+		if id != "" && id == fragment {
+			return true
+		}
+	}
+	return strings.EqualFold(it.title, fragment)
+}
+
+// metadata maps an episode of f onto content metadata. The show's title
+// stands in for the platform, and episodes without tags of their own get the
+// show's.
+func (f *feed) metadata(it *item, feedURL string) *providers.Metadata {
+	link := it.link
+	if link == "" {
+		link = it.enclosureURL
+	}
+	if link == "" && it.guid != "" {
+		link = feedURL + "#" + url.PathEscape(it.guid)
+	}
+	if link == "" {
+		link = feedURL
+	}
+
+	return &providers.Metadata{
+		Title:           it.title,
+		Description:     it.description,
+		DurationSeconds: it.durationSeconds,
+		PublishedAt:     it.publishedAt,
+		ContentType:     "podcast",
+		PlatformName:    f.title,
+		Language:        providers.NormalizeLanguage(f.language),
+		Tags:            mergeTags(it.tags, f.tags),
+		URL:             link,
+	}
+}
@@ -0,0 +1,133 @@
+package podcast
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+)
+
+func newFeedServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/show.xml":
+			w.Header().Set("Content-Type", "application/rss+xml")
+			w.Write([]byte(rssFeedXML))
+		case "/atom":
+			w.Write([]byte(atomFeedXML))
+		case "/page.rss":
+			w.Write([]byte("<html></html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func mustParse(t *testing.T, rawURL string) *url.URL {
+	u, err := providers.ParseURL(rawURL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestMatch(t *testing.T) {
+	p := New()
+
+	for _, rawURL := range []string{
+		"https://feeds.simplecast.com/abc123",
+		"https://rss.art19.com/hidden-brain",
+		"https://anchor.fm/s/1234/podcast/rss",
+		"https://example.com/podcast.xml",
+		"https://example.com/shows/feed",
+		"https://example.com/index.atom",
+	} {
+		assert.True(t, p.Match(mustParse(t, rawURL)), rawURL)
+	}
+	for _, rawURL := range []string{
+		"https://youtu.be/mcrAH6g7CFk",
+		"https://example.com/episodes/1",
+		"https://example.com/feeds.html",
+	} {
+		assert.False(t, p.Match(mustParse(t, rawURL)), rawURL)
+	}
+}
+
+func TestFetch_NewestEpisode(t *testing.T) {
+	server := newFeedServer(t)
+	p := New(WithHTTPClient(server.Client()))
+
+	metadata, err := p.Fetch(context.Background(), mustParse(t, server.URL+"/show.xml"))
+
+	require.NoError(t, err)
+	assert.Equal(t, &providers.Metadata{
+		Title:           "Newer Episode",
+		Description:     "The unconscious patterns that drive us.",
+		DurationSeconds: 3723,
+		PublishedAt:     time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC),
+		ContentType:     "podcast",
+		PlatformName:    "Hidden Brain",
+		Language:        "en-US",
+		Tags:            []string{"Behavior", "Science", "Habits", "psychology", "Social Sciences"},
+		URL:             "https://cdn.example.com/2.mp3",
+	}, metadata)
+}
+
+func TestFetch_SelectsEpisodeByFragment(t *testing.T) {
+	server := newFeedServer(t)
+	p := New(WithHTTPClient(server.Client()))
+
+	for _, fragment := range []string{"episode-1", "https://example.com/episodes/1", "https://cdn.example.com/1.mp3", "older episode"} {
+		u := mustParse(t, server.URL+"/show.xml")
+		u.Fragment = fragment
+
+		metadata, err := p.Fetch(context.Background(), u)
+
+		require.NoError(t, err, fragment)
+		assert.Equal(t, "Older Episode", metadata.Title, fragment)
+		assert.Equal(t, "https://example.com/episodes/1", metadata.URL, fragment)
+		assert.Equal(t, int32(2730), metadata.DurationSeconds, fragment)
+	}
+
+	_, err := p.Fetch(context.Background(), mustParse(t, server.URL+"/show.xml#episode-3"))
+	assert.ErrorIs(t, err, providers.ErrNotFound)
+}
+
+func TestFetchAll(t *testing.T) {
+	server := newFeedServer(t)
+	p := New(WithHTTPClient(server.Client()))
+
+	metadata, err := p.FetchAll(context.Background(), mustParse(t, server.URL+"/show.xml"))
+
+	require.NoError(t, err)
+	require.Len(t, metadata, 2)
+	assert.Equal(t, "Newer Episode", metadata[0].Title)
+	assert.Equal(t, "Older Episode", metadata[1].Title)
+
+	metadata, err = p.FetchAll(context.Background(), mustParse(t, server.URL+"/atom"))
+
+	require.NoError(t, err)
+	require.Len(t, metadata, 1)
+	assert.Equal(t, "Thmanyah", metadata[0].PlatformName)
+	assert.Equal(t, "ar", metadata[0].Language)
+	assert.Equal(t, []string{"History", "Culture"}, metadata[0].Tags)
+	assert.Equal(t, "https://example.com/atom/1", metadata[0].URL)
+}
+
+func TestFetch_Errors(t *testing.T) {
+	server := newFeedServer(t)
+	p := New(WithHTTPClient(server.Client()))
+
+	_, err := p.Fetch(context.Background(), mustParse(t, server.URL+"/missing.xml"))
+	assert.ErrorIs(t, err, providers.ErrNotFound)
+
+	_, err = p.Fetch(context.Background(), mustParse(t, server.URL+"/page.rss"))
+	assert.ErrorIs(t, err, providers.ErrNotFeed)
+}
@@ -0,0 +1,281 @@
+// Package providers resolves links to external platforms into content
+// metadata, so editors can import a podcast episode or video by pasting its
+// URL instead of typing its details by hand.
+//
+// Each platform is a Provider. A Registry picks the first registered provider
+// that matches a URL and fetches the metadata through it.
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrInvalidURL is returned for URLs that are not absolute http or https
+	// URLs.
+	ErrInvalidURL = errors.New("invalid url")
+
+	// ErrNoProvider is returned when no registered provider matches a URL.
+	ErrNoProvider = errors.New("no provider for url")
+
+	// ErrNotFound is returned when the platform reports that the linked
+	// content does not exist.
+	ErrNotFound = errors.New("content not found")
+
+	// ErrNotFeed is returned for URLs that do not list contents: by feed
+	// providers when the document is not a feed, and by Registry.FetchAll
+	// when the provider only resolves single contents.
+	ErrNotFeed = errors.New("url is not a feed")
+
+	// ErrForbiddenAddress is returned by DefaultClient for URLs, or redirects,
+	// to loopback, private, link-local or unspecified addresses, so importing a
+	// link cannot reach services on the server's own network.
+	ErrForbiddenAddress = errors.New("address not allowed")
+)
+
+// Limits of the content fields metadata is imported into. The registry trims
+// what providers return to fit them.
+const (
+	MaxTitleLength        = 255
+	MaxDescriptionLength  = 5000
+	MaxPlatformNameLength = 100
+	MaxTags               = 50
+	MaxTagLength          = 100
+	MaxDurationSeconds    = 24 * 60 * 60
+)
+
+// Metadata is what a provider could find out about a linked content. Fields
+// the platform does not expose are left zero.
+type Metadata struct {
+	Title           string
+	Description     string
+	DurationSeconds int32
+	PublishedAt     time.Time
+	// ContentType is "podcast" or "documentary".
+	ContentType  string
+	PlatformName string
+	Language     string
+	Tags         []string
+	// URL is the canonical link to the content, which may differ from the one
+	// that was resolved.
+	URL string
+	// Author is the channel, show or person that published the content.
+	Author string
+	// ThumbnailURL links to a preview image of the content.
+	ThumbnailURL string
+}
+
+// normalize trims m to the limits of the content fields. Durations out of
+// range are dropped rather than clamped, as they are more likely wrong than
+// long, and so are tags that repeat an earlier one regardless of case.
+func (m *Metadata) normalize() {
+	m.Title = truncate(m.Title, MaxTitleLength)
+	m.Description = truncate(m.Description, MaxDescriptionLength)
+	m.PlatformName = truncate(m.PlatformName, MaxPlatformNameLength)
+	if m.DurationSeconds < 0 || m.DurationSeconds > MaxDurationSeconds {
+		m.DurationSeconds = 0
+	}
+
+	var tags []string
+	seen := map[string]bool{}
+	for _, tag := range m.Tags {
+		if len(tags) == MaxTags {
+			break
+		}
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] || utf8.RuneCountInString(tag) > MaxTagLength {
+			continue
+		}
+		seen[key] = true
+		tags = append(tags, tag)
+	}
+	m.Tags = tags
+}
+
+// MissingFields returns the content fields m leaves for the editor to fill
+// in, named as in the Content message, such as "duration_seconds".
+func (m *Metadata) MissingFields() []string {
+	var missing []string
+	for _, field := range []struct {
+		name    string
+		missing bool
+	}{
+		{"title", m.Title == ""},
+		{"description", m.Description == ""},
+		{"tags", len(m.Tags) == 0},
+		{"language", m.Language == ""},
+		{"duration_seconds", m.DurationSeconds == 0},
+		{"published_at", m.PublishedAt.IsZero()},
+		{"content_type", m.ContentType != "podcast" && m.ContentType != "documentary"},
+		{"platform_name", m.PlatformName == ""},
+	} {
+		if field.missing {
+			missing = append(missing, field.name)
+		}
+	}
+	return missing
+}
+
+var languagePattern = regexp.MustCompile(`^([a-z]{2,3})(?:[-_]([a-z]{2}))?$`)
+
+// NormalizeLanguage turns a language tag such as "en-us" or the "ar_SA" of an
+// og:locale into the form contents use, "en-US". Languages it cannot read are
+// dropped.
+func NormalizeLanguage(language string) string {
+	m := languagePattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(language)))
+	switch {
+	case m == nil:
+		return ""
+	case m[2] == "":
+		return m[1]
+	default:
+		return m[1] + "-" + strings.ToUpper(m[2])
+	}
+}
+
+// truncate shortens s to at most n runes.
+func truncate(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return strings.TrimSpace(string([]rune(s)[:n]))
+}
+
+// Provider resolves links to one platform.
+type Provider interface {
+	// Name identifies the provider, such as "youtube".
+	Name() string
+	// Match reports whether u links to content the provider can resolve.
+	Match(u *url.URL) bool
+	// Fetch returns the metadata of the content u links to.
+	Fetch(ctx context.Context, u *url.URL) (*Metadata, error)
+}
+
+// FeedProvider is a Provider for URLs that list many contents, such as
+// podcast feeds, which can also be imported all at once.
+type FeedProvider interface {
+	Provider
+	// FetchAll returns the metadata of every content u lists, newest first.
+	FetchAll(ctx context.Context, u *url.URL) ([]Metadata, error)
+}
+
+// Registry looks up the provider for a URL. It is safe for concurrent use.
+type Registry struct {
+	mu        sync.RWMutex
+	providers []Provider
+}
+
+// NewRegistry returns a registry of providers, which are tried in order.
+func NewRegistry(providers ...Provider) *Registry {
+	return &Registry{providers: providers}
+}
+
+// Register adds p after the providers already registered, so it is only used
+// for URLs none of them match.
+func (r *Registry) Register(p Provider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.providers = append(r.providers, p)
+}
+
+// Names returns the names of the registered providers, in the order they are
+// tried.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, len(r.providers))
+	for i, p := range r.providers {
+		names[i] = p.Name()
+	}
+	return names
+}
+
+// Providers returns the registered providers, in the order they are tried.
+func (r *Registry) Providers() []Provider {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]Provider(nil), r.providers...)
+}
+
+// Lookup parses rawURL and returns the first provider that matches it.
+func (r *Registry) Lookup(rawURL string) (Provider, *url.URL, error) {
+	u, err := ParseURL(rawURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, p := range r.providers {
+		if p.Match(u) {
+			return p, u, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("%w: %s", ErrNoProvider, u.Host)
+}
+
+// Fetch returns the metadata of the content rawURL links to, from the first
+// provider that matches it. Metadata without a URL gets rawURL.
+func (r *Registry) Fetch(ctx context.Context, rawURL string) (*Metadata, error) {
+	p, u, err := r.Lookup(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := p.Fetch(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p.Name(), err)
+	}
+	if metadata.URL == "" {
+		metadata.URL = u.String()
+	}
+	metadata.normalize()
+	return metadata, nil
+}
+
+// FetchAll returns the metadata of every content the feed at rawURL lists,
+// from the first provider that matches it.
+func (r *Registry) FetchAll(ctx context.Context, rawURL string) ([]Metadata, error) {
+	p, u, err := r.Lookup(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, ok := p.(FeedProvider)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s links are single contents", ErrNotFeed, p.Name())
+	}
+	items, err := feed.FetchAll(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p.Name(), err)
+	}
+	for i := range items {
+		items[i].normalize()
+	}
+	return items, nil
+}
+
+// ParseURL parses rawURL, which must be an absolute http or https URL. The
+// host is lowercased so providers can match it directly.
+func ParseURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidURL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: %q is not an absolute http(s) url", ErrInvalidURL, rawURL)
+	}
+	u.Host = strings.ToLower(u.Host)
+	return u, nil
+}
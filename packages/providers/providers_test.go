@@ -0,0 +1,144 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvider resolves links to host by fetching their metadata as JSON from
+// a stand-in server at baseURL. The server is local, so it is fetched with
+// http.DefaultClient rather than DefaultClient, which refuses local addresses.
+type fakeProvider struct {
+	name    string
+	host    string
+	baseURL string
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) Match(u *url.URL) bool { return u.Host == p.host }
+
+func (p *fakeProvider) Fetch(ctx context.Context, u *url.URL) (*Metadata, error) {
+	body, err := Get(ctx, http.DefaultClient, p.baseURL+u.Path)
+	if err != nil {
+		return nil, err
+	}
+	var metadata Metadata
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+func newFakeServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/episodes/1" {
+			http.NotFound(w, r)
+			return
+		}
+		assert.Contains(t, r.Header.Get("User-Agent"), "Mawjood")
+		json.NewEncoder(w).Encode(Metadata{Title: "Episode 1", DurationSeconds: 1800, ContentType: "podcast"})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRegistry_Fetch(t *testing.T) {
+	server := newFakeServer(t)
+	registry := NewRegistry(&fakeProvider{name: "fake", host: "podcasts.example.com", baseURL: server.URL})
+
+	metadata, err := registry.Fetch(context.Background(), "https://Podcasts.Example.com/episodes/1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Episode 1", metadata.Title)
+	assert.Equal(t, int32(1800), metadata.DurationSeconds)
+	assert.Equal(t, "https://podcasts.example.com/episodes/1", metadata.URL)
+}
+
+func TestRegistry_FetchNotFound(t *testing.T) {
+	server := newFakeServer(t)
+	registry := NewRegistry(&fakeProvider{name: "fake", host: "podcasts.example.com", baseURL: server.URL})
+
+	_, err := registry.Fetch(context.Background(), "https://podcasts.example.com/episodes/2")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "fake: ")
+}
+
+func TestRegistry_LookupOrder(t *testing.T) {
+	first := &fakeProvider{name: "first", host: "example.com"}
+	registry := NewRegistry(first)
+	registry.Register(&fakeProvider{name: "second", host: "example.com"})
+	registry.Register(&fakeProvider{name: "other", host: "other.example.com"})
+
+	p, u, err := registry.Lookup("https://example.com/a")
+	require.NoError(t, err)
+	assert.Equal(t, first, p)
+	assert.Equal(t, "/a", u.Path)
+
+	p, _, err = registry.Lookup("http://other.example.com/b")
+	require.NoError(t, err)
+	assert.Equal(t, "other", p.Name())
+
+	assert.Equal(t, []string{"first", "second", "other"}, registry.Names())
+}
+
+func TestRegistry_LookupErrors(t *testing.T) {
+	registry := NewRegistry(&fakeProvider{name: "fake", host: "example.com"})
+
+	_, _, err := registry.Lookup("https://unknown.example.org/a")
+	assert.ErrorIs(t, err, ErrNoProvider)
+	assert.Contains(t, err.Error(), "unknown.example.org")
+
+	for _, rawURL := range []string{"", "example.com/a", "ftp://example.com/a", "https:///a", "://bad"} {
+		_, _, err := registry.Lookup(rawURL)
+		assert.ErrorIs(t, err, ErrInvalidURL, rawURL)
+	}
+}
+
+func TestRegistry_FetchNormalizesMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Metadata{
+			Title:           "  " + strings.Repeat("ت", MaxTitleLength+5),
+			DurationSeconds: MaxDurationSeconds + 1,
+			Tags:            []string{" Science ", "science", "", strings.Repeat("x", MaxTagLength+1), "History"},
+		})
+	}))
+	t.Cleanup(server.Close)
+	registry := NewRegistry(&fakeProvider{name: "fake", host: "podcasts.example.com", baseURL: server.URL})
+
+	metadata, err := registry.Fetch(context.Background(), "https://podcasts.example.com/episodes/1")
+
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("ت", MaxTitleLength), metadata.Title)
+	assert.Zero(t, metadata.DurationSeconds)
+	assert.Equal(t, []string{"Science", "History"}, metadata.Tags)
+}
+
+func TestMetadata_MissingFields(t *testing.T) {
+	metadata := Metadata{Title: "Episode 1", Tags: []string{"history"}, ContentType: "video", PlatformName: "Example"}
+
+	assert.Equal(t, []string{"description", "language", "duration_seconds", "published_at", "content_type"}, metadata.MissingFields())
+}
+
+func TestNormalizeLanguage(t *testing.T) {
+	cases := map[string]string{
+		"en-us":  "en-US",
+		"EN_gb":  "en-GB",
+		"ar_SA":  "ar-SA",
+		"ar":     "ar",
+		"":       "",
+		"en-usa": "",
+	}
+	for input, expected := range cases {
+		assert.Equal(t, expected, NormalizeLanguage(input), input)
+	}
+}
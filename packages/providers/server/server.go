@@ -0,0 +1,53 @@
+package main
+
+import (
+	"log"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
+	"github.com/mosaibah/Mawjood/packages/providers"
+	"github.com/mosaibah/Mawjood/packages/providers/podcast"
+	v1 "github.com/mosaibah/Mawjood/packages/providers/v1"
+	"github.com/mosaibah/Mawjood/packages/providers/webpage"
+	"github.com/mosaibah/Mawjood/packages/providers/youtube"
+)
+
+func main() {
+	servicePort := getEnv("SERVICE_PORT", "9003")
+
+	// webpage matches every URL, so it goes last as the fallback.
+	registry := providers.NewRegistry(
+		youtube.New(),
+		podcast.New(),
+		webpage.New(),
+	)
+	log.Printf("providers: %v", registry.Names())
+
+	service := v1.New(registry)
+
+	lis, err := net.Listen("tcp", ":"+servicePort)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+	mawjoodv1.RegisterProvidersServiceServer(grpcServer, service)
+
+	reflection.Register(grpcServer)
+
+	log.Printf("Providers server starting on :%s", servicePort)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %s", err)
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
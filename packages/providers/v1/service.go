@@ -0,0 +1,165 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultMaxItems caps PreviewImport results for all_items when the request
+// does not, matching how many contents CMSService imports at once.
+const defaultMaxItems = 200
+
+type ProvidersService struct {
+	mawjoodv1.UnimplementedProvidersServiceServer
+	registry *providers.Registry
+}
+
+func New(registry *providers.Registry) *ProvidersService {
+	return &ProvidersService{registry: registry}
+}
+
+func (ps *ProvidersService) ResolveURL(ctx context.Context, req *mawjoodv1.ResolveURLRequest) (*mawjoodv1.ResolveURLResponse, error) {
+	log.Printf("ResolveURL started - URL: %s", req.Url)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	provider, u, err := ps.registry.Lookup(req.Url)
+	if err != nil {
+		return nil, ps.fetchError(req.Url, err)
+	}
+
+	log.Printf("ResolveURL completed successfully - provider: %s", provider.Name())
+
+	return &mawjoodv1.ResolveURLResponse{
+		Provider: providerInfo(provider),
+		Url:      u.String(),
+	}, nil
+}
+
+func (ps *ProvidersService) ListProviders(ctx context.Context, req *mawjoodv1.ListProvidersRequest) (*mawjoodv1.ListProvidersResponse, error) {
+	log.Printf("ListProviders started")
+
+	registered := ps.registry.Providers()
+	infos := make([]*mawjoodv1.ProviderInfo, len(registered))
+	for i, provider := range registered {
+		infos[i] = providerInfo(provider)
+	}
+
+	log.Printf("ListProviders completed successfully - count: %d", len(infos))
+
+	return &mawjoodv1.ListProvidersResponse{Providers: infos}, nil
+}
+
+func (ps *ProvidersService) PreviewImport(ctx context.Context, req *mawjoodv1.PreviewImportRequest) (*mawjoodv1.PreviewImportResponse, error) {
+	log.Printf("PreviewImport started - URL: %s, all items: %t", req.Url, req.AllItems)
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	provider, _, err := ps.registry.Lookup(req.Url)
+	if err != nil {
+		return nil, ps.fetchError(req.Url, err)
+	}
+
+	var items []providers.Metadata
+	if req.AllItems {
+		items, err = ps.registry.FetchAll(ctx, req.Url)
+	} else {
+		var metadata *providers.Metadata
+		if metadata, err = ps.registry.Fetch(ctx, req.Url); err == nil {
+			items = []providers.Metadata{*metadata}
+		}
+	}
+	if err != nil {
+		return nil, ps.fetchError(req.Url, err)
+	}
+
+	total := len(items)
+	maxItems := int(req.MaxItems)
+	if maxItems == 0 {
+		maxItems = defaultMaxItems
+	}
+	if len(items) > maxItems {
+		items = items[:maxItems]
+	}
+
+	previews := make([]*mawjoodv1.ImportPreview, len(items))
+	for i := range items {
+		previews[i] = metadataToProto(&items[i])
+	}
+
+	log.Printf("PreviewImport completed successfully - provider: %s, count: %d of %d", provider.Name(), len(previews), total)
+
+	return &mawjoodv1.PreviewImportResponse{
+		Provider:   provider.Name(),
+		Items:      previews,
+		TotalItems: int32(total),
+	}, nil
+}
+
+// fetchError maps a registry error onto a gRPC status. Platform failures
+// other than missing content are Unavailable, as retrying may succeed.
+func (ps *ProvidersService) fetchError(rawURL string, err error) error {
+	switch {
+	case errors.Is(err, providers.ErrNoProvider):
+		return status.Errorf(codes.FailedPrecondition, "cannot import %s: %v; supported providers: %v", rawURL, err, ps.registry.Names())
+	case errors.Is(err, providers.ErrNotFeed):
+		return status.Errorf(codes.FailedPrecondition, "cannot import %s: %v", rawURL, err)
+	case errors.Is(err, providers.ErrInvalidURL), errors.Is(err, providers.ErrForbiddenAddress):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, providers.ErrNotFound):
+		return status.Errorf(codes.NotFound, "failed to fetch metadata: %v", err)
+	case errors.Is(err, context.Canceled):
+		return status.Errorf(codes.Canceled, "%v", err)
+	}
+	return status.Errorf(codes.Unavailable, "failed to fetch metadata: %v", err)
+}
+
+func providerInfo(provider providers.Provider) *mawjoodv1.ProviderInfo {
+	_, feed := provider.(providers.FeedProvider)
+	return &mawjoodv1.ProviderInfo{
+		Name:             provider.Name(),
+		SupportsAllItems: feed,
+	}
+}
+
+func metadataToProto(metadata *providers.Metadata) *mawjoodv1.ImportPreview {
+	var publishedAt string
+	if !metadata.PublishedAt.IsZero() {
+		publishedAt = metadata.PublishedAt.Format(time.RFC3339)
+	}
+
+	contentType := mawjoodv1.ContentType_CONTENT_TYPE_UNSPECIFIED
+	switch metadata.ContentType {
+	case "podcast":
+		contentType = mawjoodv1.ContentType_CONTENT_TYPE_PODCAST
+	case "documentary":
+		contentType = mawjoodv1.ContentType_CONTENT_TYPE_DOCUMENTARY
+	}
+
+	return &mawjoodv1.ImportPreview{
+		Title:           metadata.Title,
+		Description:     metadata.Description,
+		Tags:            metadata.Tags,
+		Language:        metadata.Language,
+		DurationSeconds: metadata.DurationSeconds,
+		PublishedAt:     publishedAt,
+		ContentType:     contentType,
+		Url:             metadata.URL,
+		PlatformName:    metadata.PlatformName,
+		Author:          metadata.Author,
+		ThumbnailUrl:    metadata.ThumbnailURL,
+		MissingFields:   metadata.MissingFields(),
+	}
+}
@@ -0,0 +1,212 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mawjoodv1 "github.com/mosaibah/Mawjood/gen/go/packages/proto/v1"
+	"github.com/mosaibah/Mawjood/packages/providers"
+	"github.com/mosaibah/Mawjood/packages/providers/webpage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubProvider resolves links to example.com into fixed metadata, or fails
+// with err.
+type stubProvider struct {
+	metadata providers.Metadata
+	err      error
+}
+
+func (p *stubProvider) Name() string { return "stub" }
+
+func (p *stubProvider) Match(u *url.URL) bool { return u.Host == "example.com" }
+
+func (p *stubProvider) Fetch(ctx context.Context, u *url.URL) (*providers.Metadata, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	metadata := p.metadata
+	return &metadata, nil
+}
+
+// stubFeedProvider resolves links to feeds.example.com as feeds listing
+// items.
+type stubFeedProvider struct {
+	stubProvider
+	items []providers.Metadata
+}
+
+func (p *stubFeedProvider) Name() string { return "stubfeed" }
+
+func (p *stubFeedProvider) Match(u *url.URL) bool { return u.Host == "feeds.example.com" }
+
+func (p *stubFeedProvider) FetchAll(ctx context.Context, u *url.URL) ([]providers.Metadata, error) {
+	return append([]providers.Metadata(nil), p.items...), nil
+}
+
+func TestResolveURL(t *testing.T) {
+	service := New(providers.NewRegistry(&stubProvider{}, &stubFeedProvider{}))
+
+	resp, err := service.ResolveURL(context.Background(), &mawjoodv1.ResolveURLRequest{Url: "https://Feeds.Example.com/show.xml"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "stubfeed", resp.Provider.Name)
+	assert.True(t, resp.Provider.SupportsAllItems)
+	assert.Equal(t, "https://feeds.example.com/show.xml", resp.Url)
+
+	_, err = service.ResolveURL(context.Background(), &mawjoodv1.ResolveURLRequest{Url: "https://youtu.be/mcrAH6g7CFk"})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "supported providers: [stub stubfeed]")
+}
+
+func TestListProviders(t *testing.T) {
+	service := New(providers.NewRegistry(&stubProvider{}, &stubFeedProvider{}))
+
+	resp, err := service.ListProviders(context.Background(), &mawjoodv1.ListProvidersRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Providers, 2)
+	assert.Equal(t, "stub", resp.Providers[0].Name)
+	assert.False(t, resp.Providers[0].SupportsAllItems)
+	assert.Equal(t, "stubfeed", resp.Providers[1].Name)
+	assert.True(t, resp.Providers[1].SupportsAllItems)
+}
+
+func TestPreviewImport(t *testing.T) {
+	provider := &stubProvider{metadata: providers.Metadata{
+		Title:           "Episode 1",
+		Description:     "The first episode",
+		DurationSeconds: 1800,
+		PublishedAt:     time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		ContentType:     "documentary",
+		PlatformName:    "Example",
+		Language:        "ar",
+		Tags:            []string{"history", "History"},
+		Author:          "Example Channel",
+		ThumbnailURL:    "https://example.com/1.jpg",
+	}}
+	service := New(providers.NewRegistry(provider))
+
+	resp, err := service.PreviewImport(context.Background(), &mawjoodv1.PreviewImportRequest{Url: "https://example.com/episodes/1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "stub", resp.Provider)
+	assert.Equal(t, int32(1), resp.TotalItems)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, &mawjoodv1.ImportPreview{
+		Title:           "Episode 1",
+		Description:     "The first episode",
+		Tags:            []string{"history"},
+		Language:        "ar",
+		DurationSeconds: 1800,
+		PublishedAt:     "2024-01-15T10:00:00Z",
+		ContentType:     mawjoodv1.ContentType_CONTENT_TYPE_DOCUMENTARY,
+		Url:             "https://example.com/episodes/1",
+		PlatformName:    "Example",
+		Author:          "Example Channel",
+		ThumbnailUrl:    "https://example.com/1.jpg",
+	}, resp.Items[0])
+}
+
+func TestPreviewImport_MissingFields(t *testing.T) {
+	service := New(providers.NewRegistry(&stubProvider{metadata: providers.Metadata{Title: "Some page", PlatformName: "example.com"}}))
+
+	resp, err := service.PreviewImport(context.Background(), &mawjoodv1.PreviewImportRequest{Url: "https://example.com/page"})
+
+	require.NoError(t, err)
+	assert.Equal(t, mawjoodv1.ContentType_CONTENT_TYPE_UNSPECIFIED, resp.Items[0].ContentType)
+	assert.Empty(t, resp.Items[0].PublishedAt)
+	assert.Equal(t, []string{"description", "tags", "language", "duration_seconds", "published_at", "content_type"}, resp.Items[0].MissingFields)
+}
+
+func TestPreviewImport_AllItems(t *testing.T) {
+	items := make([]providers.Metadata, 5)
+	for i := range items {
+		items[i] = providers.Metadata{Title: "Episode", ContentType: "podcast"}
+	}
+	service := New(providers.NewRegistry(&stubProvider{}, &stubFeedProvider{items: items}))
+
+	resp, err := service.PreviewImport(context.Background(), &mawjoodv1.PreviewImportRequest{Url: "https://feeds.example.com/show.xml", AllItems: true, MaxItems: 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, "stubfeed", resp.Provider)
+	assert.Len(t, resp.Items, 3)
+	assert.Equal(t, int32(5), resp.TotalItems)
+
+	_, err = service.PreviewImport(context.Background(), &mawjoodv1.PreviewImportRequest{Url: "https://example.com/episodes/1", AllItems: true})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestPreviewImport_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider *stubProvider
+		url      string
+		code     codes.Code
+		message  string
+	}{
+		{
+			name:     "unknown url",
+			provider: &stubProvider{},
+			url:      "https://youtu.be/mcrAH6g7CFk?si=vMHT2MSD6kAPlguG",
+			code:     codes.FailedPrecondition,
+			message:  "supported providers: [stub]",
+		},
+		{
+			name:     "invalid url",
+			provider: &stubProvider{},
+			url:      "ftp://example.com/episodes/1",
+			code:     codes.InvalidArgument,
+		},
+		{
+			name:     "not found",
+			provider: &stubProvider{err: providers.ErrNotFound},
+			url:      "https://example.com/episodes/2",
+			code:     codes.NotFound,
+		},
+		{
+			name:     "upstream failure",
+			provider: &stubProvider{err: errors.New("connection refused")},
+			url:      "https://example.com/episodes/1",
+			code:     codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := New(providers.NewRegistry(tt.provider))
+
+			resp, err := service.PreviewImport(context.Background(), &mawjoodv1.PreviewImportRequest{Url: tt.url})
+
+			assert.Nil(t, resp)
+			statusErr, ok := status.FromError(err)
+			require.True(t, ok, "Expected gRPC status error")
+			assert.Equal(t, tt.code, statusErr.Code())
+			assert.Contains(t, statusErr.Message(), tt.message)
+		})
+	}
+}
+
+func TestPreviewImport_InternalAddress(t *testing.T) {
+	service := New(providers.NewRegistry(webpage.New()))
+
+	for _, rawURL := range []string{
+		"http://127.0.0.1/",
+		"http://localhost:9003/",
+		"http://169.254.169.254/latest/meta-data/",
+	} {
+		resp, err := service.PreviewImport(context.Background(), &mawjoodv1.PreviewImportRequest{Url: rawURL})
+
+		assert.Nil(t, resp, rawURL)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), rawURL)
+	}
+}
@@ -0,0 +1,162 @@
+package webpage
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// mediaTypes are the schema.org types that describe a content, with the
+// content type each implies. Episode pages may be either.
+var mediaTypes = map[string]string{
+	"PodcastEpisode": "podcast",
+	"RadioEpisode":   "podcast",
+	"AudioObject":    "podcast",
+	"VideoObject":    "documentary",
+	"Movie":          "documentary",
+	"TVEpisode":      "documentary",
+	"Episode":        "",
+}
+
+// mediaObject is a schema.org node from a page's JSON-LD. Its accessors
+// return zero values for a nil mediaObject, so pages without one need no
+// special casing.
+type mediaObject map[string]any
+
+// findMediaObject returns the first node of the JSON-LD scripts whose type is
+// one of mediaTypes, or nil. Nodes nested in @graph arrays or in properties,
+// such as the video of a WebPage, are found too.
+func findMediaObject(scripts []string) mediaObject {
+	for _, script := range scripts {
+		var doc any
+		if err := json.Unmarshal([]byte(script), &doc); err != nil {
+			continue
+		}
+		if obj := search(doc); obj != nil {
+			return obj
+		}
+	}
+	return nil
+}
+
+func search(v any) mediaObject {
+	switch v := v.(type) {
+	case []any:
+		for _, item := range v {
+			if obj := search(item); obj != nil {
+				return obj
+			}
+		}
+	case map[string]any:
+		if _, ok := mediaTypes[mediaObject(v).schemaType()]; ok {
+			return v
+		}
+		// Map order is random, so properties are searched in sorted order to
+		// pick the same node every time.
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if obj := search(v[key]); obj != nil {
+				return obj
+			}
+		}
+	}
+	return nil
+}
+
+// schemaType returns the first of the node's types that is one of mediaTypes,
+// or its first type.
+func (o mediaObject) schemaType() string {
+	types := stringList(o["@type"])
+	for _, t := range types {
+		if _, ok := mediaTypes[t]; ok {
+			return t
+		}
+	}
+	if len(types) > 0 {
+		return types[0]
+	}
+	return ""
+}
+
+func (o mediaObject) contentType() string {
+	return mediaTypes[o.schemaType()]
+}
+
+// text returns a string property, or the first of a list of them.
+func (o mediaObject) text(key string) string {
+	if values := stringList(o[key]); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// name returns the name of a Person, Organization or similar property, which
+// pages give either as an object or as a plain string.
+func (o mediaObject) name(key string) string {
+	if node, ok := first(o[key]).(map[string]any); ok {
+		return mediaObject(node).text("name")
+	}
+	return o.text(key)
+}
+
+// url returns an image or similar property, given either as a URL or as an
+// ImageObject.
+func (o mediaObject) url(key string) string {
+	if node, ok := first(o[key]).(map[string]any); ok {
+		return mediaObject(node).text("url")
+	}
+	return o.text(key)
+}
+
+// language returns inLanguage, given either as a language code or as a
+// Language with an alternateName code.
+func (o mediaObject) language() string {
+	if node, ok := first(o["inLanguage"]).(map[string]any); ok {
+		return mediaObject(node).text("alternateName")
+	}
+	return o.text("inLanguage")
+}
+
+// keywords returns the keywords property, given either as a list or as one
+// comma separated string.
+func (o mediaObject) keywords() []string {
+	var tags []string
+	for _, value := range stringList(o["keywords"]) {
+		tags = append(tags, splitKeywords(value)...)
+	}
+	return tags
+}
+
+func first(v any) any {
+	if list, ok := v.([]any); ok {
+		if len(list) == 0 {
+			return nil
+		}
+		return list[0]
+	}
+	return v
+}
+
+// stringList returns v as a list of strings, whether it is one string or a
+// list of them. Other values are skipped.
+func stringList(v any) []string {
+	switch v := v.(type) {
+	case string:
+		if v = strings.TrimSpace(v); v != "" {
+			return []string{v}
+		}
+	case []any:
+		var values []string
+		for _, item := range v {
+			if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+				values = append(values, strings.TrimSpace(s))
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Package webpage resolves links to any web page by scraping the metadata the
+// page declares about itself: schema.org JSON-LD, Open Graph and Twitter card
+// tags, and plain HTML as a last resort.
+//
+// It matches every URL, so it belongs last in a registry, as the fallback for
+// platforms without a dedicated provider. Pages rarely declare everything a
+// content needs; Metadata.MissingFields tells the editor what is left.
+package webpage
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+)
+
+// Provider scrapes content metadata from web pages.
+type Provider struct {
+	client *http.Client
+}
+
+// Option configures a Provider created by New.
+type Option func(*Provider)
+
+// WithHTTPClient fetches pages with client instead of
+// providers.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(p *Provider) {
+		p.client = client
+	}
+}
+
+func New(opts ...Option) *Provider {
+	p := &Provider{client: providers.DefaultClient}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (p *Provider) Name() string {
+	return "webpage"
+}
+
+// Match matches every URL.
+func (p *Provider) Match(u *url.URL) bool {
+	return true
+}
+
+// Fetch scrapes the page at u. Each field comes from the first source that
+// declares it: the page's schema.org media object, then Open Graph, then the
+// Twitter card, then plain HTML.
+func (p *Provider) Fetch(ctx context.Context, u *url.URL) (*providers.Metadata, error) {
+	body, err := providers.Get(ctx, p.client, u.String())
+	if err != nil {
+		return nil, err
+	}
+	page, err := providers.ParsePage(body)
+	if err != nil {
+		return nil, err
+	}
+	return metadata(page, u), nil
+}
+
+// metadata combines what page declares about itself into content metadata.
+func metadata(page *providers.Page, u *url.URL) *providers.Metadata {
+	obj := findMediaObject(page.JSONLD)
+
+	m := &providers.Metadata{
+		Title:        firstNonEmpty(obj.text("name"), obj.text("headline"), page.Meta("og:title"), page.Meta("twitter:title"), page.Title),
+		Description:  firstNonEmpty(obj.text("description"), page.Meta("og:description"), page.Meta("twitter:description"), page.Meta("description")),
+		ContentType:  firstNonEmpty(obj.contentType(), openGraphContentType(page)),
+		PlatformName: firstNonEmpty(page.Meta("og:site_name"), obj.name("publisher"), obj.name("partOfSeries"), siteName(u)),
+		Author:       firstNonEmpty(obj.name("author"), obj.name("creator"), obj.name("partOfSeries"), page.Meta("author")),
+		ThumbnailURL: firstNonEmpty(obj.text("thumbnailUrl"), obj.url("image"), page.Meta("og:image"), page.Meta("twitter:image")),
+		URL:          canonicalURL(firstNonEmpty(obj.text("url"), page.Meta("og:url")), u),
+	}
+
+	m.DurationSeconds = providers.ParseISODuration(obj.text("duration"))
+	if m.DurationSeconds == 0 {
+		m.DurationSeconds = parseSeconds(firstNonEmpty(page.Meta("video:duration"), page.Meta("og:video:duration"), page.Meta("music:duration")))
+	}
+	m.PublishedAt = providers.ParseISODate(firstNonEmpty(
+		obj.text("datePublished"), obj.text("uploadDate"),
+		page.Meta("video:release_date"), page.Meta("article:published_time"), page.Meta("datePublished"),
+	))
+
+	for _, language := range []string{obj.language(), page.Meta("og:locale"), page.Language} {
+		if m.Language = providers.NormalizeLanguage(language); m.Language != "" {
+			break
+		}
+	}
+
+	m.Tags = append(m.Tags, obj.keywords()...)
+	for _, name := range []string{"video:tag", "og:video:tag", "article:tag"} {
+		m.Tags = append(m.Tags, page.MetaAll(name)...)
+	}
+	if len(m.Tags) == 0 {
+		m.Tags = splitKeywords(page.Meta("keywords"))
+	}
+	return m
+}
+
+// openGraphContentType infers the content type from the og:type and media
+// tags: video pages are documentaries and audio pages podcasts.
+func openGraphContentType(page *providers.Page) string {
+	switch {
+	case strings.HasPrefix(page.Meta("og:type"), "video."), page.Meta("og:video") != "":
+		return "documentary"
+	case page.Meta("og:audio") != "":
+		return "podcast"
+	}
+	return ""
+}
+
+// siteName names the platform after the host of u, without a www. prefix.
+func siteName(u *url.URL) string {
+	return strings.TrimPrefix(u.Hostname(), "www.")
+}
+
+// canonicalURL returns the page's own link if it declares a valid one, and u
+// otherwise.
+func canonicalURL(declared string, u *url.URL) string {
+	if declared != "" {
+		if parsed, err := u.Parse(declared); err == nil {
+			if canonical, err := providers.ParseURL(parsed.String()); err == nil {
+				return canonical.String()
+			}
+		}
+	}
+	return u.String()
+}
+
+// parseSeconds parses a duration in whole seconds, as Open Graph declares
+// them. Durations that cannot be parsed are 0.
+func parseSeconds(s string) int32 {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || n < 0 || n > providers.MaxDurationSeconds {
+		return 0
+	}
+	return int32(n)
+}
+
+func splitKeywords(keywords string) []string {
+	var tags []string
+	for _, keyword := range strings.Split(keywords, ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			tags = append(tags, keyword)
+		}
+	}
+	return tags
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package webpage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+)
+
+const jsonLDPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <title>Episode 12 | Fnjan</title>
+  <meta property="og:title" content="Open Graph title">
+  <meta property="og:site_name" content="Thmanyah">
+  <script type="application/ld+json">{"@context":"https://schema.org","@type":"Organization","name":"Thmanyah"}</script>
+  <script type="application/ld+json">
+  {
+    "@context": "https://schema.org",
+    "@graph": [
+      {"@type": "WebPage", "name": "Episode page"},
+      {
+        "@type": ["PodcastEpisode", "CreativeWork"],
+        "name": "The History of Coffee",
+        "description": "Where coffee comes from.",
+        "duration": "PT1H5M",
+        "datePublished": "2024-02-10T18:00:00+03:00",
+        "inLanguage": {"@type": "Language", "name": "Arabic", "alternateName": "ar"},
+        "keywords": "coffee, history",
+        "image": {"@type": "ImageObject", "url": "https://cdn.example.com/12.jpg"},
+        "partOfSeries": {"@type": "PodcastSeries", "name": "Fnjan"},
+        "url": "/episodes/12"
+      }
+    ]
+  }
+  </script>
+</head>
+<body></body>
+</html>`
+
+const openGraphPage = `<html>
+<head>
+  <title>Plain title</title>
+  <meta property="og:type" content="video.other">
+  <meta property="og:title" content="Deep Sea">
+  <meta property="og:description" content="Life at the bottom of the ocean.">
+  <meta property="og:locale" content="en_GB">
+  <meta property="og:image" content="https://cdn.example.com/deep-sea.jpg">
+  <meta property="video:duration" content="2940">
+  <meta property="video:tag" content="ocean">
+  <meta property="video:tag" content="nature">
+  <meta name="twitter:description" content="Twitter description">
+</head>
+</html>`
+
+const plainPage = `<html><head><title>Just a page</title><meta name="description" content="Nothing structured here."></head></html>`
+
+func newPageServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/episodes/12":
+			w.Write([]byte(jsonLDPage))
+		case "/films/deep-sea":
+			w.Write([]byte(openGraphPage))
+		case "/plain":
+			w.Write([]byte(plainPage))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func fetch(t *testing.T, server *httptest.Server, path string) (*providers.Metadata, error) {
+	u, err := url.Parse(server.URL + path)
+	require.NoError(t, err)
+	return New(WithHTTPClient(server.Client())).Fetch(context.Background(), u)
+}
+
+func TestFetch_JSONLD(t *testing.T) {
+	server := newPageServer(t)
+
+	metadata, err := fetch(t, server, "/episodes/12")
+
+	require.NoError(t, err)
+	assert.Equal(t, &providers.Metadata{
+		Title:           "The History of Coffee",
+		Description:     "Where coffee comes from.",
+		DurationSeconds: 3900,
+		PublishedAt:     time.Date(2024, 2, 10, 15, 0, 0, 0, time.UTC),
+		ContentType:     "podcast",
+		PlatformName:    "Thmanyah",
+		Language:        "ar",
+		Tags:            []string{"coffee", "history"},
+		URL:             server.URL + "/episodes/12",
+		Author:          "Fnjan",
+		ThumbnailURL:    "https://cdn.example.com/12.jpg",
+	}, metadata)
+	assert.Empty(t, metadata.MissingFields())
+}
+
+func TestFetch_OpenGraph(t *testing.T) {
+	server := newPageServer(t)
+
+	metadata, err := fetch(t, server, "/films/deep-sea")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Deep Sea", metadata.Title)
+	assert.Equal(t, "Life at the bottom of the ocean.", metadata.Description)
+	assert.Equal(t, int32(2940), metadata.DurationSeconds)
+	assert.Equal(t, "documentary", metadata.ContentType)
+	assert.Equal(t, "en-GB", metadata.Language)
+	assert.Equal(t, []string{"ocean", "nature"}, metadata.Tags)
+	assert.Equal(t, "https://cdn.example.com/deep-sea.jpg", metadata.ThumbnailURL)
+	assert.Equal(t, "127.0.0.1", metadata.PlatformName)
+	assert.Equal(t, []string{"published_at"}, metadata.MissingFields())
+}
+
+func TestFetch_PlainHTML(t *testing.T) {
+	server := newPageServer(t)
+
+	metadata, err := fetch(t, server, "/plain")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Just a page", metadata.Title)
+	assert.Equal(t, "Nothing structured here.", metadata.Description)
+	assert.Equal(t, server.URL+"/plain", metadata.URL)
+	assert.Equal(t, []string{"tags", "language", "duration_seconds", "published_at", "content_type"}, metadata.MissingFields())
+}
+
+func TestFetch_NotFound(t *testing.T) {
+	server := newPageServer(t)
+
+	_, err := fetch(t, server, "/missing")
+
+	assert.ErrorIs(t, err, providers.ErrNotFound)
+}
+
+func TestFindMediaObject(t *testing.T) {
+	obj := findMediaObject([]string{
+		`not json`,
+		`{"@type":"WebPage","video":{"@type":"VideoObject","name":"Nested","keywords":["a","b, c"],"author":"Someone"}}`,
+	})
+
+	require.NotNil(t, obj)
+	assert.Equal(t, "Nested", obj.text("name"))
+	assert.Equal(t, "documentary", obj.contentType())
+	assert.Equal(t, []string{"a", "b", "c"}, obj.keywords())
+	assert.Equal(t, "Someone", obj.name("author"))
+
+	assert.Nil(t, findMediaObject([]string{`{"@type":"Organization"}`}))
+}
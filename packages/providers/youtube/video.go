@@ -0,0 +1,157 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+)
+
+// oembed is the part of an oEmbed response imports use.
+type oembed struct {
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// fetchVideo combines the oEmbed response and the watch page of a video. A
+// video oEmbed reports missing fails with providers.ErrNotFound; if only one
+// of the two can be fetched, the other's fields are left zero.
+func (p *Provider) fetchVideo(ctx context.Context, id string) (*providers.Metadata, error) {
+	embed, embedErr := p.fetchOEmbed(ctx, id)
+	if errors.Is(embedErr, providers.ErrNotFound) {
+		return nil, embedErr
+	}
+	body, pageErr := providers.Get(ctx, p.client, p.baseURL+"/watch?v="+id)
+	if embedErr != nil && pageErr != nil {
+		return nil, fmt.Errorf("failed to fetch video %s: %w", id, pageErr)
+	}
+
+	metadata := &providers.Metadata{
+		PlatformName: "YouTube",
+		URL:          watchURL(id),
+	}
+	if pageErr == nil {
+		if err := fromWatchPage(metadata, body); err != nil {
+			return nil, fmt.Errorf("failed to parse watch page of %s: %w", id, err)
+		}
+	}
+	if embedErr == nil {
+		metadata.Title = firstNonEmpty(embed.Title, metadata.Title)
+		metadata.Author = firstNonEmpty(embed.AuthorName, metadata.Author)
+		metadata.ThumbnailURL = firstNonEmpty(embed.ThumbnailURL, metadata.ThumbnailURL)
+	}
+	return metadata, nil
+}
+
+func (p *Provider) fetchOEmbed(ctx context.Context, id string) (*oembed, error) {
+	query := url.Values{"format": {"json"}, "url": {watchURL(id)}}
+	body, err := providers.Get(ctx, p.client, p.baseURL+"/oembed?"+query.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var embed oembed
+	if err := json.Unmarshal(body, &embed); err != nil {
+		return nil, fmt.Errorf("failed to parse oEmbed response: %w", err)
+	}
+	return &embed, nil
+}
+
+var (
+	// The watch page embeds the player response as a script. Its description
+	// keeps the line breaks the og:description drops, and lengthSeconds is
+	// there when the microdata duration is not.
+	shortDescriptionPattern = regexp.MustCompile(`"shortDescription":("(?:[^"\\]|\\.)*")`)
+	lengthSecondsPattern    = regexp.MustCompile(`"lengthSeconds":"(\d+)"`)
+	authorPattern           = regexp.MustCompile(`"ownerChannelName":("(?:[^"\\]|\\.)*")`)
+)
+
+// fromWatchPage fills metadata from the microdata, Open Graph tags and
+// player response of a watch page.
+func fromWatchPage(metadata *providers.Metadata, body []byte) error {
+	page, err := providers.ParsePage(body)
+	if err != nil {
+		return err
+	}
+
+	metadata.Title = firstNonEmpty(page.Meta("og:title"), page.Meta("title"), strings.TrimSuffix(page.Title, " - YouTube"))
+	metadata.Description = firstNonEmpty(jsonString(shortDescriptionPattern, body), page.Meta("og:description"), page.Meta("description"))
+	metadata.Author = jsonString(authorPattern, body)
+	metadata.ThumbnailURL = page.Meta("og:image")
+
+	metadata.DurationSeconds = providers.ParseISODuration(page.Meta("duration"))
+	if metadata.DurationSeconds == 0 {
+		if m := lengthSecondsPattern.FindSubmatch(body); m != nil {
+			if n, err := strconv.Atoi(string(m[1])); err == nil && n <= providers.MaxDurationSeconds {
+				metadata.DurationSeconds = int32(n)
+			}
+		}
+	}
+	metadata.PublishedAt = providers.ParseISODate(firstNonEmpty(page.Meta("datePublished"), page.Meta("uploadDate")))
+
+	for _, keyword := range strings.Split(page.Meta("keywords"), ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			metadata.Tags = append(metadata.Tags, keyword)
+		}
+	}
+	metadata.Tags = append(metadata.Tags, page.MetaAll("og:video:tag")...)
+	return nil
+}
+
+// jsonString returns the JSON string literal pattern captures in body,
+// decoded, or "".
+func jsonString(pattern *regexp.Regexp, body []byte) string {
+	m := pattern.FindSubmatch(body)
+	if m == nil {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(m[1], &s); err != nil {
+		return ""
+	}
+	return s
+}
+
+// playlistFeed is the Atom feed YouTube serves for a playlist.
+type playlistFeed struct {
+	Entries []struct {
+		VideoID string `xml:"http://www.youtube.com/xml/schemas/2015 videoId"`
+	} `xml:"entry"`
+}
+
+// playlistVideoIDs returns the IDs of the videos the playlist's feed lists.
+func (p *Provider) playlistVideoIDs(ctx context.Context, list string) ([]string, error) {
+	body, err := providers.Get(ctx, p.client, p.baseURL+"/feeds/videos.xml?playlist_id="+url.QueryEscape(list))
+	if err != nil {
+		return nil, err
+	}
+
+	var feed playlistFeed
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		return nil, fmt.Errorf("failed to parse playlist feed: %w", err)
+	}
+	var ids []string
+	for _, entry := range feed.Entries {
+		if id := strings.TrimSpace(entry.VideoID); videoIDPattern.MatchString(id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
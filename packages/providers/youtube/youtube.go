@@ -0,0 +1,167 @@
+// Package youtube resolves links to YouTube videos and playlists into content
+// metadata.
+//
+// The title, channel and thumbnail come from YouTube's oEmbed endpoint, and
+// the duration, publish date, description and tags from the structured
+// metadata of the watch page. A playlist link resolves to its first video,
+// and FetchAll returns the videos YouTube lists in the playlist's feed.
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+)
+
+// DefaultBaseURL is where YouTube serves oEmbed, watch pages and feeds.
+const DefaultBaseURL = "https://www.youtube.com"
+
+// Provider resolves YouTube video and playlist URLs.
+type Provider struct {
+	client  *http.Client
+	baseURL string
+}
+
+// Option configures a Provider created by New.
+type Option func(*Provider)
+
+// WithHTTPClient fetches metadata with client instead of
+// providers.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(p *Provider) {
+		p.client = client
+	}
+}
+
+// WithBaseURL fetches oEmbed, watch pages and feeds from baseURL instead of
+// DefaultBaseURL, such as a local stand-in in tests.
+func WithBaseURL(baseURL string) Option {
+	return func(p *Provider) {
+		p.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+func New(opts ...Option) *Provider {
+	p := &Provider{client: providers.DefaultClient, baseURL: DefaultBaseURL}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (p *Provider) Name() string {
+	return "youtube"
+}
+
+var (
+	videoIDPattern    = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+	playlistIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{2,64}$`)
+)
+
+// Match reports whether u links to a YouTube video or playlist: youtu.be/ID,
+// youtube.com/watch?v=ID, /shorts/ID, /live/ID, /embed/ID or
+// /playlist?list=ID.
+func (p *Provider) Match(u *url.URL) bool {
+	return videoID(u) != "" || playlistID(u) != ""
+}
+
+// Fetch returns the video u links to, or the first video of the playlist.
+func (p *Provider) Fetch(ctx context.Context, u *url.URL) (*providers.Metadata, error) {
+	if id := videoID(u); id != "" {
+		return p.fetchVideo(ctx, id)
+	}
+
+	ids, err := p.playlistVideoIDs(ctx, playlistID(u))
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: playlist has no videos", providers.ErrNotFound)
+	}
+	return p.fetchVideo(ctx, ids[0])
+}
+
+// FetchAll returns the videos of the playlist u links to, in playlist order.
+// YouTube's playlist feed lists at most the first 15. Videos that have been
+// removed or made private since are skipped.
+func (p *Provider) FetchAll(ctx context.Context, u *url.URL) ([]providers.Metadata, error) {
+	list := playlistID(u)
+	if list == "" {
+		return nil, fmt.Errorf("%w: not a playlist", providers.ErrNotFeed)
+	}
+
+	ids, err := p.playlistVideoIDs(ctx, list)
+	if err != nil {
+		return nil, err
+	}
+	var videos []providers.Metadata
+	for _, id := range ids {
+		metadata, err := p.fetchVideo(ctx, id)
+		if errors.Is(err, providers.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		videos = append(videos, *metadata)
+	}
+	return videos, nil
+}
+
+// isYouTubeHost reports whether host serves YouTube videos.
+func isYouTubeHost(host string) bool {
+	switch strings.TrimPrefix(host, "www.") {
+	case "youtube.com", "m.youtube.com", "music.youtube.com", "youtube-nocookie.com":
+		return true
+	}
+	return false
+}
+
+// videoID returns the ID of the video u links to, or "".
+func videoID(u *url.URL) string {
+	var id string
+	switch {
+	case u.Host == "youtu.be":
+		id = strings.Trim(u.Path, "/")
+	case !isYouTubeHost(u.Host):
+		return ""
+	case u.Path == "/watch":
+		id = u.Query().Get("v")
+	default:
+		dir, base := path.Split(strings.TrimSuffix(u.Path, "/"))
+		switch dir {
+		case "/shorts/", "/live/", "/embed/", "/v/":
+			id = base
+		}
+	}
+
+	if !videoIDPattern.MatchString(id) {
+		return ""
+	}
+	return id
+}
+
+// playlistID returns the ID of the playlist u links to, or "". Watch URLs
+// that play a video within a playlist link to both.
+func playlistID(u *url.URL) string {
+	if !isYouTubeHost(u.Host) && u.Host != "youtu.be" {
+		return ""
+	}
+	id := u.Query().Get("list")
+	if !playlistIDPattern.MatchString(id) {
+		return ""
+	}
+	return id
+}
+
+// watchURL is the canonical link to a video.
+func watchURL(id string) string {
+	return "https://www.youtube.com/watch?v=" + id
+}
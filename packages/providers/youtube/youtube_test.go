@@ -0,0 +1,189 @@
+package youtube
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mosaibah/Mawjood/packages/providers"
+)
+
+const watchPageHTML = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <title>Why We Sleep - YouTube</title>
+  <meta name="title" content="Why We Sleep">
+  <meta name="description" content="A short summary.">
+  <meta name="keywords" content="sleep, science, health">
+  <meta property="og:image" content="https://i.ytimg.com/vi/dQw4w9WgXcQ/maxresdefault.jpg">
+  <meta property="og:video:tag" content="Science">
+  <meta property="og:video:tag" content="neuroscience">
+</head>
+<body>
+  <div itemscope itemtype="http://schema.org/VideoObject">
+    <meta itemprop="duration" content="PT1H2M3S">
+    <meta itemprop="datePublished" content="2024-03-01T08:00:00-08:00">
+  </div>
+  <script>var ytInitialPlayerResponse = {"videoDetails":{"lengthSeconds":"3723","shortDescription":"Line one.\nLine \"two\".","ownerChannelName":"Page Channel"}};</script>
+</body>
+</html>`
+
+const playlistFeedXML = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns:yt="http://www.youtube.com/xml/schemas/2015" xmlns="http://www.w3.org/2005/Atom">
+  <title>Sleep Series</title>
+  <entry><yt:videoId>dQw4w9WgXcQ</yt:videoId><title>Why We Sleep</title></entry>
+  <entry><yt:videoId>removed0000</yt:videoId><title>Removed</title></entry>
+  <entry><yt:videoId>aaaaaaaaaaa</yt:videoId><title>Dreams</title></entry>
+</feed>`
+
+func newYouTubeServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/oembed":
+			assert.Equal(t, "json", query.Get("format"))
+			switch query.Get("url") {
+			case "https://www.youtube.com/watch?v=dQw4w9WgXcQ":
+				w.Write([]byte(`{"title":"Why We Sleep (oEmbed)","author_name":"Science Channel","thumbnail_url":"https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg"}`))
+			case "https://www.youtube.com/watch?v=aaaaaaaaaaa":
+				w.WriteHeader(http.StatusUnauthorized)
+			default:
+				http.NotFound(w, r)
+			}
+		case "/watch":
+			switch query.Get("v") {
+			case "dQw4w9WgXcQ", "aaaaaaaaaaa":
+				w.Write([]byte(watchPageHTML))
+			default:
+				http.NotFound(w, r)
+			}
+		case "/feeds/videos.xml":
+			if query.Get("playlist_id") != "PLsleep" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write([]byte(playlistFeedXML))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestProvider(t *testing.T) *Provider {
+	server := newYouTubeServer(t)
+	return New(WithHTTPClient(server.Client()), WithBaseURL(server.URL))
+}
+
+func mustParse(t *testing.T, rawURL string) *url.URL {
+	u, err := providers.ParseURL(rawURL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestMatch(t *testing.T) {
+	p := New()
+
+	videos := map[string]string{
+		"https://youtu.be/dQw4w9WgXcQ":                       "dQw4w9WgXcQ",
+		"https://youtu.be/dQw4w9WgXcQ?t=42":                  "dQw4w9WgXcQ",
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ":        "dQw4w9WgXcQ",
+		"https://m.youtube.com/watch?v=dQw4w9WgXcQ&t=1s":     "dQw4w9WgXcQ",
+		"https://youtube.com/shorts/dQw4w9WgXcQ":             "dQw4w9WgXcQ",
+		"https://www.youtube.com/live/dQw4w9WgXcQ":           "dQw4w9WgXcQ",
+		"https://www.youtube.com/embed/dQw4w9WgXcQ":          "dQw4w9WgXcQ",
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ&list=P": "dQw4w9WgXcQ",
+	}
+	for rawURL, id := range videos {
+		u := mustParse(t, rawURL)
+		assert.True(t, p.Match(u), rawURL)
+		assert.Equal(t, id, videoID(u), rawURL)
+	}
+
+	u := mustParse(t, "https://www.youtube.com/playlist?list=PLsleep")
+	assert.True(t, p.Match(u))
+	assert.Empty(t, videoID(u))
+	assert.Equal(t, "PLsleep", playlistID(u))
+
+	for _, rawURL := range []string{
+		"https://www.youtube.com/",
+		"https://www.youtube.com/@channel",
+		"https://www.youtube.com/watch?v=short",
+		"https://youtu.be/",
+		"https://vimeo.com/123456",
+		"https://notyoutube.com/watch?v=dQw4w9WgXcQ",
+	} {
+		assert.False(t, p.Match(mustParse(t, rawURL)), rawURL)
+	}
+}
+
+func TestFetch_Video(t *testing.T) {
+	p := newTestProvider(t)
+
+	metadata, err := p.Fetch(context.Background(), mustParse(t, "https://youtu.be/dQw4w9WgXcQ"))
+
+	require.NoError(t, err)
+	assert.Equal(t, &providers.Metadata{
+		Title:           "Why We Sleep (oEmbed)",
+		Description:     "Line one.\nLine \"two\".",
+		DurationSeconds: 3723,
+		PublishedAt:     time.Date(2024, 3, 1, 16, 0, 0, 0, time.UTC),
+		PlatformName:    "YouTube",
+		Tags:            []string{"sleep", "science", "health", "Science", "neuroscience"},
+		URL:             "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+		Author:          "Science Channel",
+		ThumbnailURL:    "https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg",
+	}, metadata)
+}
+
+func TestFetch_FallsBackToWatchPage(t *testing.T) {
+	p := newTestProvider(t)
+
+	metadata, err := p.Fetch(context.Background(), mustParse(t, "https://www.youtube.com/shorts/aaaaaaaaaaa"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Why We Sleep", metadata.Title)
+	assert.Equal(t, "Page Channel", metadata.Author)
+	assert.Equal(t, "https://i.ytimg.com/vi/dQw4w9WgXcQ/maxresdefault.jpg", metadata.ThumbnailURL)
+	assert.Equal(t, "https://www.youtube.com/watch?v=aaaaaaaaaaa", metadata.URL)
+}
+
+func TestFetch_Playlist(t *testing.T) {
+	p := newTestProvider(t)
+
+	metadata, err := p.Fetch(context.Background(), mustParse(t, "https://www.youtube.com/playlist?list=PLsleep"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.youtube.com/watch?v=dQw4w9WgXcQ", metadata.URL)
+}
+
+func TestFetchAll(t *testing.T) {
+	p := newTestProvider(t)
+
+	videos, err := p.FetchAll(context.Background(), mustParse(t, "https://www.youtube.com/playlist?list=PLsleep"))
+
+	require.NoError(t, err)
+	require.Len(t, videos, 2)
+	assert.Equal(t, "https://www.youtube.com/watch?v=dQw4w9WgXcQ", videos[0].URL)
+	assert.Equal(t, "https://www.youtube.com/watch?v=aaaaaaaaaaa", videos[1].URL)
+
+	_, err = p.FetchAll(context.Background(), mustParse(t, "https://youtu.be/dQw4w9WgXcQ"))
+	assert.ErrorIs(t, err, providers.ErrNotFeed)
+}
+
+func TestFetch_Errors(t *testing.T) {
+	p := newTestProvider(t)
+
+	_, err := p.Fetch(context.Background(), mustParse(t, "https://youtu.be/missing0000"))
+	assert.ErrorIs(t, err, providers.ErrNotFound)
+
+	_, err = p.Fetch(context.Background(), mustParse(t, "https://www.youtube.com/playlist?list=PLmissing"))
+	assert.ErrorIs(t, err, providers.ErrNotFound)
+}
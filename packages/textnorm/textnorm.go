@@ -0,0 +1,119 @@
+// Package textnorm folds text into the form used for search matching, so that
+// spelling variants of the same word compare equal.
+//
+// Normalization is built from rune-level folds, which keeps it cheap and lets
+// callers map every normalized rune back to its position in the original text
+// (see Normalizer.Map). A Registry holds the one pipeline of folds that search
+// text, both stored and queried, goes through (see Registry.Query). There is
+// no per-language pipeline: a query's language is unknown, and a content's
+// text may mix languages whatever language it is filed under, so the folds of
+// every supported language apply to all text.
+package textnorm
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Fold maps a single rune to its normalized form. Returning false drops the
+// rune from the output.
+type Fold func(r rune) (rune, bool)
+
+// Lower folds letters to lower case.
+func Lower(r rune) (rune, bool) {
+	return unicode.ToLower(r), true
+}
+
+// Arabic removes tashkeel and tatweel and unifies the letters that are commonly
+// written interchangeably: alef with hamza or madda becomes a bare alef, taa
+// marbuta becomes haa, and alef maqsura becomes yaa.
+func Arabic(r rune) (rune, bool) {
+	switch {
+	case r >= '\u064B' && r <= '\u065F', // tashkeel: fathatan through wavy hamza below
+		r == '\u0670', // superscript alef
+		r == '\u0640': // tatweel
+		return 0, false
+	}
+
+	switch r {
+	case 'أ', 'إ', 'آ', 'ٱ':
+		return 'ا', true
+	case 'ة':
+		return 'ه', true
+	case 'ى':
+		return 'ي', true
+	}
+
+	return r, true
+}
+
+// Normalizer applies a fixed sequence of folds.
+type Normalizer struct {
+	folds []Fold
+}
+
+// New returns a Normalizer applying folds in order.
+func New(folds ...Fold) *Normalizer {
+	return &Normalizer{folds: folds}
+}
+
+// Normalize returns the normalized form of s.
+func (n *Normalizer) Normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if folded, ok := n.fold(r); ok {
+			b.WriteRune(folded)
+		}
+	}
+	return b.String()
+}
+
+// Map normalizes s and also returns, for each normalized rune, the index of
+// the rune of s it came from. It is used to translate match offsets found in
+// normalized text back onto the original.
+func (n *Normalizer) Map(s string) ([]rune, []int) {
+	runes := make([]rune, 0, len(s))
+	positions := make([]int, 0, len(s))
+	i := 0
+	for _, r := range s {
+		if folded, ok := n.fold(r); ok {
+			runes = append(runes, folded)
+			positions = append(positions, i)
+		}
+		i++
+	}
+	return runes, positions
+}
+
+func (n *Normalizer) fold(r rune) (rune, bool) {
+	for _, f := range n.folds {
+		var ok bool
+		if r, ok = f(r); !ok {
+			return 0, false
+		}
+	}
+	return r, true
+}
+
+// Registry holds the folds applied to all search text.
+type Registry struct {
+	folds []Fold
+}
+
+// NewRegistry returns a Registry applying folds in order.
+func NewRegistry(folds ...Fold) *Registry {
+	return &Registry{folds: folds}
+}
+
+// Default returns the registry used by the stores: lower-casing followed by
+// Arabic folding.
+func Default() *Registry {
+	return NewRegistry(Lower, Arabic)
+}
+
+// Query returns the normalizer for search input and the stored text it is
+// matched against.
+func (r *Registry) Query() *Normalizer {
+	return New(r.folds...)
+}
@@ -0,0 +1,54 @@
+package textnorm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArabic(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "tashkeel", input: "مُحَمَّدٌ", want: "محمد"},
+		{name: "tatweel", input: "العـــربية", want: "العربيه"},
+		{name: "alef variants", input: "أحمد إسلام آمن ٱلله", want: "احمد اسلام امن الله"},
+		{name: "taa marbuta", input: "مدرسة", want: "مدرسه"},
+		{name: "alef maqsura", input: "مستشفى", want: "مستشفي"},
+		{name: "latin untouched", input: "Arabic Tech Talk", want: "Arabic Tech Talk"},
+	}
+
+	n := New(Arabic)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, n.Normalize(tc.input))
+		})
+	}
+}
+
+func TestNormalizer_Map(t *testing.T) {
+	n := New(Lower, Arabic)
+
+	runes, positions := n.Map("Aَb")
+
+	assert.Equal(t, []rune("ab"), runes)
+	assert.Equal(t, []int{0, 2}, positions)
+}
+
+func TestRegistry(t *testing.T) {
+	r := Default()
+
+	assert.Equal(t, "مدرسه tech", r.Query().Normalize("مَدرسة Tech"))
+	assert.Equal(t, "straße", r.Query().Normalize("Straße"))
+
+	r = NewRegistry(Lower, func(r rune) (rune, bool) {
+		if r == 'ß' {
+			return 's', true
+		}
+		return r, true
+	})
+	assert.Equal(t, "strase", r.Query().Normalize("Straße"))
+	assert.Equal(t, "مدرسة", r.Query().Normalize("مدرسة"))
+}